@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io/fs"
+	"log"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"github.com/devifyX/go-back-transaction-service/internal/audit"
+	"github.com/devifyX/go-back-transaction-service/internal/config"
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+)
+
+// auditverify recomputes the audit log hash chain and exits non-zero if any
+// row was altered, removed or reordered. The chain heads of the last clean
+// run are kept in the -heads file, outside the database, so that deleting the
+// newest rows is caught too; keep that file somewhere the database's writers
+// cannot reach.
+func main() {
+	// Load .env if present (non-fatal if missing)
+	_ = godotenv.Load()
+
+	headsPath := flag.String("heads", "audit-heads.json", "file holding the chain heads recorded by the last clean run")
+	flag.Parse()
+
+	known, err := readHeads(*headsPath)
+	if err != nil {
+		log.Fatalf("failed to read heads: %v", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	pool, err := db.NewPool(ctx, cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("failed to connect to db: %v", err)
+	}
+	defer pool.Close()
+
+	res, err := audit.NewLog(pool).Verify(ctx, known)
+	if err != nil {
+		log.Fatalf("verify failed: %v", err)
+	}
+	if res.BrokenAt != 0 {
+		log.Printf("audit chain BROKEN at seq %d after %d rows: %s", res.BrokenAt, res.Checked, res.Reason)
+		os.Exit(1)
+	}
+	if err := writeHeads(*headsPath, res.Heads); err != nil {
+		log.Fatalf("failed to write heads: %v", err)
+	}
+	log.Printf("audit chain intact (%d rows)", res.Checked)
+}
+
+// readHeads loads the heads recorded at path; a missing file means no run has
+// recorded any yet.
+func readHeads(path string) (map[string]audit.Head, error) {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var heads map[string]audit.Head
+	if err := json.Unmarshal(b, &heads); err != nil {
+		return nil, err
+	}
+	return heads, nil
+}
+
+func writeHeads(path string, heads map[string]audit.Head) error {
+	b, err := json.MarshalIndent(heads, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0o600)
+}
@@ -9,9 +9,18 @@ import (
 
 	"github.com/joho/godotenv"
 
+	"github.com/devifyX/go-back-transaction-service/internal/archive"
+	"github.com/devifyX/go-back-transaction-service/internal/audit"
+	"github.com/devifyX/go-back-transaction-service/internal/clientip"
 	"github.com/devifyX/go-back-transaction-service/internal/config"
 	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/fraud"
 	"github.com/devifyX/go-back-transaction-service/internal/grpcapi"
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/devifyX/go-back-transaction-service/internal/quota"
+	"github.com/devifyX/go-back-transaction-service/internal/ratelimit"
+	"github.com/devifyX/go-back-transaction-service/internal/reconcile"
+	"github.com/devifyX/go-back-transaction-service/internal/rules"
 	transactionsv1 "github.com/devifyX/go-back-transaction-service/proto"
 
 	"google.golang.org/grpc"
@@ -37,18 +46,94 @@ func main() {
 	}
 	defer pool.Close()
 
+	if err := pool.Migrate(ctx); err != nil {
+		log.Fatalf("failed to migrate db: %v", err)
+	}
+	engine, err := rules.Load(cfg.RulesFile)
+	if err != nil {
+		log.Fatalf("failed to load rules: %v", err)
+	}
+	if engine != nil {
+		pool.AddHook(engine)
+	}
+	screener, err := fraud.Load(cfg.FraudRulesFile)
+	if err != nil {
+		log.Fatalf("failed to load fraud rules: %v", err)
+	}
+	if screener != nil {
+		pool.SetScreener(screener)
+	}
+
+	// Erasure rewrites archive files too
+	if cfg.ArchiveDir != "" {
+		pool.AddEraser(archive.NewArchiver(pool, archive.DirStore{Root: cfg.ArchiveDir}))
+	}
+
 	// Repo + gRPC service
 	repo := db.NewTransactionRepo(pool)
-	svc := grpcapi.NewServer(repo, cfg.IPRatePerMinute, cfg.UserRatePerMinute)
+	repo.HoldTimeout = cfg.HoldTimeout
+	auditRec := &audit.Recorder{Log: audit.NewLog(pool), Reads: cfg.AuditReads, UserKey: []byte(cfg.AuditUserKey)}
+	tenants := db.NewTenantRepo(pool)
+	reconciler := reconcile.NewReconciler(db.NewReconciliationRepo(pool), reconcile.Tolerance{
+		Amount: cfg.ReconcileAmountTolerance,
+		Time:   cfg.ReconcileTimeTolerance,
+	})
+	svc := &grpcapi.Server{
+		Repo:          repo,
+		Caps:          db.NewCapRepo(pool),
+		Coins:         db.NewCoinRepo(pool),
+		Platforms:     db.NewPlatformRepo(pool),
+		Rates:         db.NewRateRepo(pool),
+		Ledger:        db.NewLedgerRepo(pool),
+		Reconcile:     reconciler,
+		Subscriptions: db.NewSubscriptionRepo(pool),
+		Scheduled:     db.NewScheduledRepo(pool),
+		FraudReviews:  db.NewFraudReviewRepo(pool),
+		Tenants:       tenants,
+		Audit:         auditRec,
+	}
+
+	// Rate limiting (shared with the HTTP server when the backend is postgres)
+	backend, err := ratelimit.New(cfg.RateLimitBackend, pool, cfg.RateLimitMaxKeys)
+	if err != nil {
+		log.Fatalf("failed to init rate limiter: %v", err)
+	}
+	go ratelimit.RunJanitor(context.Background(), backend, cfg.RateLimitIdleTTL)
+	limiter := grpcapi.NewRateLimiter(backend, cfg.IPRatePerMinute, cfg.UserRatePerMinute)
+	quotas, err := quota.Load(cfg.QuotaPolicyFile, backend)
+	if err != nil {
+		log.Fatalf("failed to load quota policy: %v", err)
+	}
+
+	// Client address resolution behind proxies
+	ips, err := clientip.NewResolver(cfg.TrustedProxies)
+	if err != nil {
+		log.Fatalf("invalid TRUSTED_PROXIES: %v", err)
+	}
 
 	// Start listener
 	lis, err := net.Listen("tcp", cfg.GRPCAddr)
 	if err != nil {
 		log.Fatalf("failed to listen on %s: %v", cfg.GRPCAddr, err)
 	}
+	if cfg.ProxyProtocol {
+		lis = clientip.NewProxyListener(lis, ips)
+	}
 
 	// gRPC server
-	gs := grpc.NewServer()
+	gs := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		grpcapi.Identify(&middleware.Identifier{
+			IPs:           ips,
+			AdminToken:    cfg.AdminToken,
+			Tenants:       tenants,
+			DefaultTenant: cfg.DefaultTenant,
+			Limiter:       backend,
+			AuthLimit:     ratelimit.Limit{PerMinute: cfg.AuthRatePerMinute, Burst: 20},
+		}),
+		limiter.Unary,
+		grpcapi.QuotaInterceptor(quotas),
+		grpcapi.AuditInterceptor(auditRec),
+	))
 	transactionsv1.RegisterTransactionsServer(gs, svc)
 
 	log.Printf("gRPC listening on %s", cfg.GRPCAddr)
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"github.com/devifyX/go-back-transaction-service/internal/config"
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/rates"
+)
+
+// importrates loads exchange rates from a CSV file (header
+// base,quote,rate,effective_at) into one tenant:
+//
+//	importrates -tenant acme rates.csv
+func main() {
+	// Load .env if present (non-fatal if missing)
+	_ = godotenv.Load()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+	tenantID := flag.String("tenant", cfg.DefaultTenant, "tenant owning the rates")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		log.Fatalf("usage: importrates [-tenant id] rates.csv")
+	}
+
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		log.Fatalf("failed to open rates file: %v", err)
+	}
+	defer f.Close()
+	rs, err := rates.ParseCSV(f)
+	if err != nil {
+		log.Fatalf("failed to parse rates file: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	pool, err := db.NewPool(ctx, cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("failed to connect to db: %v", err)
+	}
+	defer pool.Close()
+
+	tenant, err := db.NewTenantRepo(pool).Get(ctx, *tenantID)
+	if err != nil {
+		log.Fatalf("failed to load tenant: %v", err)
+	}
+	if tenant == nil {
+		log.Fatalf("unknown tenant %q", *tenantID)
+	}
+
+	n, err := db.NewRateRepo(pool).Import(db.WithTenant(ctx, tenant), rs)
+	if err != nil {
+		log.Fatalf("import failed: %v", err)
+	}
+	log.Printf("imported %d exchange rates for tenant %s", n, tenant.ID)
+}
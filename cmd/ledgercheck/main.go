@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"github.com/devifyX/go-back-transaction-service/internal/config"
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+)
+
+// ledgercheck verifies the double-entry journal against transactions and
+// exits non-zero if they disagree.
+func main() {
+	// Load .env if present (non-fatal if missing)
+	_ = godotenv.Load()
+
+	since := flag.Duration("since", 0, "only check transactions newer than this (e.g. 720h); 0 checks all history")
+	flag.Parse()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	pool, err := db.NewPool(ctx, cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("failed to connect to db: %v", err)
+	}
+	defer pool.Close()
+
+	var from time.Time
+	if *since > 0 {
+		from = time.Now().Add(-*since)
+	}
+	rep, err := pool.CheckLedger(ctx, from)
+	if err != nil {
+		log.Fatalf("check failed: %v", err)
+	}
+
+	log.Printf("checked %d transactions and %d journal entries", rep.Transactions, rep.Entries)
+	for _, t := range rep.Totals {
+		mark := "ok"
+		if t.Transactions != t.Ledger {
+			mark = "MISMATCH"
+		}
+		log.Printf("  %s: transactions %g, ledger %g %s", t.CoinID, t.Transactions, t.Ledger, mark)
+	}
+	report := func(what string, ids []string) {
+		if len(ids) > 0 {
+			log.Printf("  %s: %v", what, ids[:min(len(ids), 5)])
+		}
+	}
+	report("unbalanced journal entries", rep.Unbalanced)
+	report("transactions without a journal entry", rep.MissingEntries)
+	report("journal entries without a transaction", rep.Orphaned)
+	report("transactions whose postings differ", rep.Mismatched)
+
+	if !rep.OK() {
+		log.Printf("ledger INCONSISTENT")
+		os.Exit(1)
+	}
+	log.Printf("ledger consistent")
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"github.com/devifyX/go-back-transaction-service/internal/archive"
+	"github.com/devifyX/go-back-transaction-service/internal/config"
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+)
+
+// maintenance voids expired holds, creates upcoming monthly partitions of
+// transactions, archives old rows to files when ARCHIVE_DIR is set and
+// applies retention. It runs once (e.g. from cron) unless
+// MAINTENANCE_INTERVAL is set.
+func main() {
+	// Load .env if present (non-fatal if missing)
+	_ = godotenv.Load()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := db.NewPool(ctx, cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("failed to connect to db: %v", err)
+	}
+	defer pool.Close()
+
+	if err := pool.Migrate(ctx); err != nil {
+		log.Fatalf("failed to migrate db: %v", err)
+	}
+
+	parts := db.NewPartitionManager(pool)
+	var archiver *archive.Archiver
+	if cfg.ArchiveDir != "" && cfg.ArchiveAfterDays > 0 {
+		archiver = archive.NewArchiver(pool, archive.DirStore{Root: cfg.ArchiveDir})
+		archiver.BatchSize = cfg.ArchiveBatchSize
+	}
+	for {
+		if err := run(ctx, pool, parts, archiver, cfg); err != nil {
+			if cfg.MaintenanceInterval == 0 {
+				log.Fatalf("maintenance failed: %v", err)
+			}
+			log.Printf("maintenance failed: %v", err)
+		}
+		if cfg.MaintenanceInterval == 0 {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(cfg.MaintenanceInterval):
+		}
+	}
+}
+
+func run(ctx context.Context, pool *db.Pool, parts *db.PartitionManager, archiver *archive.Archiver, cfg *config.Config) error {
+	// Expired holds no longer count against caps and cannot be captured;
+	// voiding them makes that visible and lets them be archived.
+	voided, err := pool.VoidExpiredHolds(ctx)
+	if err != nil {
+		return err
+	}
+	if err := parts.EnsureFuture(ctx, cfg.PartitionMonthsAhead); err != nil {
+		return err
+	}
+	// Archive before retention so nothing is dropped unarchived.
+	var archived int
+	if archiver != nil {
+		files, err := archiver.Run(ctx, time.Now().AddDate(0, 0, -cfg.ArchiveAfterDays))
+		if err != nil {
+			return err
+		}
+		for _, f := range files {
+			archived += f.Rows
+		}
+	}
+	retired, err := parts.ApplyRetention(ctx, cfg.RetentionMonths, cfg.RetentionMode)
+	if err != nil {
+		return err
+	}
+	deleted, err := parts.ApplyTenantRetention(ctx)
+	if err != nil {
+		return err
+	}
+	log.Printf("maintenance done: %d holds voided, %d rows archived, %d partitions retired, %d rows past tenant retention deleted", voided, archived, len(retired), deleted)
+	return nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"github.com/devifyX/go-back-transaction-service/internal/config"
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/reconcile"
+)
+
+// reconcile checks a platform settlement file (header
+// dataid,userid,coinused,timestamp) against stored transactions and
+// records the run. It exits 1 when discrepancies were found:
+//
+//	reconcile -tenant acme -platform netflix settlement-2024-05-01.csv
+func main() {
+	// Load .env if present (non-fatal if missing)
+	_ = godotenv.Load()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+	tenantID := flag.String("tenant", cfg.DefaultTenant, "tenant owning the transactions")
+	platform := flag.String("platform", "", "platform that sent the settlement file")
+	amountTol := flag.Float64("amount-tolerance", cfg.ReconcileAmountTolerance, "coin amount difference still counted as a match")
+	timeTol := flag.Duration("time-tolerance", cfg.ReconcileTimeTolerance, "timestamp difference still counted as a match")
+	flag.Parse()
+	if flag.NArg() != 1 || *platform == "" {
+		log.Fatalf("usage: reconcile [-tenant id] -platform name [-amount-tolerance x] [-time-tolerance d] settlement.csv")
+	}
+
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		log.Fatalf("failed to open settlement file: %v", err)
+	}
+	defer f.Close()
+	lines, err := reconcile.ParseCSV(f)
+	if err != nil {
+		log.Fatalf("failed to parse settlement file: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	pool, err := db.NewPool(ctx, cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("failed to connect to db: %v", err)
+	}
+	defer pool.Close()
+
+	tenant, err := db.NewTenantRepo(pool).Get(ctx, *tenantID)
+	if err != nil {
+		log.Fatalf("failed to load tenant: %v", err)
+	}
+	if tenant == nil {
+		log.Fatalf("unknown tenant %q", *tenantID)
+	}
+
+	r := reconcile.NewReconciler(db.NewReconciliationRepo(pool), reconcile.Tolerance{Amount: *amountTol, Time: *timeTol})
+	run, err := r.Run(db.WithTenant(ctx, tenant), reconcile.Request{
+		Platform:  *platform,
+		FileName:  filepath.Base(flag.Arg(0)),
+		Lines:     lines,
+		Tolerance: r.Defaults,
+		CreatedBy: "cmd/reconcile",
+	})
+	if err != nil {
+		log.Fatalf("reconciliation failed: %v", err)
+	}
+	log.Printf("run %s: %d matched, %d missing on our side, %d missing on theirs, %d amount mismatches",
+		run.ID, run.Matched, run.MissingOurs, run.MissingTheirs, run.AmountMismatches)
+	if run.MissingOurs+run.MissingTheirs+run.AmountMismatches > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/joho/godotenv"
+
+	"github.com/devifyX/go-back-transaction-service/internal/config"
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/fraud"
+	"github.com/devifyX/go-back-transaction-service/internal/rules"
+	"github.com/devifyX/go-back-transaction-service/internal/worker"
+)
+
+// worker executes scheduled spends, charges subscriptions and delivers
+// webhooks. It runs once (e.g. from cron) when WORKER_INTERVAL is 0.
+func main() {
+	// Load .env if present (non-fatal if missing)
+	_ = godotenv.Load()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := db.NewPool(ctx, cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("failed to connect to db: %v", err)
+	}
+	defer pool.Close()
+
+	if err := pool.Migrate(ctx); err != nil {
+		log.Fatalf("failed to migrate db: %v", err)
+	}
+	// Scheduled spends and subscription charges obey the same rules and
+	// fraud screening as API inserts.
+	engine, err := rules.Load(cfg.RulesFile)
+	if err != nil {
+		log.Fatalf("failed to load rules: %v", err)
+	}
+	if engine != nil {
+		pool.AddHook(engine)
+	}
+	screener, err := fraud.Load(cfg.FraudRulesFile)
+	if err != nil {
+		log.Fatalf("failed to load fraud rules: %v", err)
+	}
+	if screener != nil {
+		pool.SetScreener(screener)
+	}
+
+	w := worker.New(pool, db.RetryPolicy{
+		RetryInterval: cfg.SubscriptionRetryInterval,
+		GracePeriod:   cfg.SubscriptionGracePeriod,
+	})
+	if cfg.WorkerInterval == 0 {
+		st, err := w.RunOnce(ctx)
+		if err != nil {
+			log.Fatalf("worker failed: %v", err)
+		}
+		log.Printf("worker done: %d scheduled executed, %d rejected, %d charged, %d failed, %d lapsed, %d events delivered",
+			st.Executed, st.Rejected, st.Charged, st.Failed, st.Lapsed, st.Delivered)
+		return
+	}
+	_ = w.Run(ctx, cfg.WorkerInterval)
+}
@@ -0,0 +1,271 @@
+// Package archive moves old transactions out of Postgres into compressed
+// NDJSON files and reads them back for single-row lookups.
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// Format identifies the encoding of archive files.
+const Format = "ndjson+gzip"
+
+// Manifest is written next to every archive file as <file>.manifest.json.
+type Manifest struct {
+	File      string    `json:"file"`
+	Format    string    `json:"format"`
+	Rows      int       `json:"rows"`
+	SHA256    string    `json:"sha256"` // of the compressed file
+	From      time.Time `json:"from"`   // oldest transactionTimestamp
+	To        time.Time `json:"to"`     // newest transactionTimestamp
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Encode writes txs as gzip compressed NDJSON, one transaction per line.
+func Encode(txs []models.Transaction) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	enc := json.NewEncoder(zw)
+	for _, t := range txs {
+		if err := enc.Encode(t); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Decode calls fn for every transaction in an archive file.
+func Decode(r io.Reader, fn func(models.Transaction) error) error {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+	sc := bufio.NewScanner(zr)
+	sc.Buffer(make([]byte, 64*1024), 1024*1024)
+	for sc.Scan() {
+		var t models.Transaction
+		if err := json.Unmarshal(sc.Bytes(), &t); err != nil {
+			return err
+		}
+		if err := fn(t); err != nil {
+			return err
+		}
+	}
+	return sc.Err()
+}
+
+func checksum(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// Archiver exports transactions to a Store and looks them up again.
+type Archiver struct {
+	pool      *db.Pool
+	store     Store
+	BatchSize int // rows per file
+}
+
+func NewArchiver(pool *db.Pool, store Store) *Archiver {
+	return &Archiver{pool: pool, store: store, BatchSize: 10000}
+}
+
+const columns = "id, coinid, userid, dataid, coinused, transactionTimestamp, expiryDate, platformName, tenant_id, deleted_at, status, renews_id, version, created_at, metadata, tags"
+
+// Run archives every transaction older than before, one file per batch.
+// Open holds stay in Postgres until they are captured or voided.
+func (a *Archiver) Run(ctx context.Context, before time.Time) ([]Manifest, error) {
+	var out []Manifest
+	for {
+		m, err := a.batch(ctx, before)
+		if err != nil {
+			return out, err
+		}
+		if m == nil {
+			return out, nil
+		}
+		log.Printf("archive: wrote %s (%d rows)", m.File, m.Rows)
+		out = append(out, *m)
+		if m.Rows < a.BatchSize {
+			return out, nil
+		}
+	}
+}
+
+// batch exports the oldest rows, reads the file back to verify it, and
+// only then deletes the rows. Rows stay locked for the whole batch; if
+// anything fails the transaction rolls back and the rows stay in Postgres.
+func (a *Archiver) batch(ctx context.Context, before time.Time) (*Manifest, error) {
+	var m *Manifest
+	err := a.pool.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `
+			SELECT `+columns+`
+			FROM transactions
+			WHERE transactionTimestamp < $1 AND status IN ('captured', 'voided')
+			ORDER BY transactionTimestamp, id
+			LIMIT $2
+			FOR UPDATE`, before, a.BatchSize)
+		if err != nil {
+			return err
+		}
+		txs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.Transaction, error) {
+			var t models.Transaction
+			err := row.Scan(&t.ID, &t.CoinID, &t.UserID, &t.DataID, &t.CoinUsed,
+				&t.TransactionTimestamp, &t.ExpiryDate, &t.PlatformName, &t.TenantID, &t.DeletedAt, &t.Status, &t.RenewsID, &t.Version, &t.CreatedAt, &t.Metadata, &t.Tags)
+			return t, err
+		})
+		if err != nil || len(txs) == 0 {
+			return err
+		}
+
+		data, err := Encode(txs)
+		if err != nil {
+			return err
+		}
+		now := time.Now().UTC()
+		first, last := txs[0].TransactionTimestamp.UTC(), txs[len(txs)-1].TransactionTimestamp.UTC()
+		m = &Manifest{
+			File:      fmt.Sprintf("transactions/%s/%s.ndjson.gz", first.Format("2006/01"), now.Format("20060102T150405.000000000Z")),
+			Format:    Format,
+			Rows:      len(txs),
+			SHA256:    checksum(data),
+			From:      first,
+			To:        last,
+			CreatedAt: now,
+		}
+		if err := a.store.Put(ctx, m.File, data); err != nil {
+			return err
+		}
+		if err := a.verify(ctx, *m, txs); err != nil {
+			return err
+		}
+		manifest, err := json.MarshalIndent(m, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := a.store.Put(ctx, m.File+".manifest.json", manifest); err != nil {
+			return err
+		}
+
+		ids := make([]string, len(txs))
+		tenants := make([]string, len(txs))
+		for i, t := range txs {
+			ids[i], tenants[i] = t.ID, t.TenantID
+		}
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO archive_files (name, row_count, sha256, from_ts, to_ts, created_at)
+			VALUES ($1,$2,$3,$4,$5,$6)`,
+			m.File, m.Rows, m.SHA256, m.From, m.To, m.CreatedAt); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO archive_index (id, tenant_id, file)
+			SELECT id, tenant, $3 FROM unnest($1::uuid[], $2::text[]) AS u(id, tenant)`,
+			ids, tenants, m.File); err != nil {
+			return err
+		}
+		tag, err := tx.Exec(ctx, "DELETE FROM transactions WHERE id = ANY($1::uuid[]) AND transactionTimestamp < $2", ids, before)
+		if err != nil {
+			return err
+		}
+		if int(tag.RowsAffected()) != len(txs) {
+			return fmt.Errorf("archive: deleted %d rows, expected %d", tag.RowsAffected(), len(txs))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// verify reads the stored file back and checks its checksum and contents.
+func (a *Archiver) verify(ctx context.Context, m Manifest, want []models.Transaction) error {
+	rc, err := a.store.Get(ctx, m.File)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return err
+	}
+	if got := checksum(data); got != m.SHA256 {
+		return fmt.Errorf("archive: %s checksum %s, expected %s", m.File, got, m.SHA256)
+	}
+	n := 0
+	err = Decode(bytes.NewReader(data), func(t models.Transaction) error {
+		if n >= len(want) || t.ID != want[n].ID {
+			return fmt.Errorf("archive: %s row %d does not match the exported data", m.File, n)
+		}
+		n++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if n != m.Rows {
+		return fmt.Errorf("archive: %s has %d rows, expected %d", m.File, n, m.Rows)
+	}
+	return nil
+}
+
+var errStop = errors.New("stop")
+
+// GetByID finds an archived transaction of the tenant in ctx. It returns
+// pgx.ErrNoRows when the id was never archived (or was soft-deleted and
+// includeDeleted is false), like the live repository.
+func (a *Archiver) GetByID(ctx context.Context, id string, includeDeleted bool) (*models.Transaction, error) {
+	tenant := db.TenantFromContext(ctx)
+	if tenant == nil || tenant.ID == "" {
+		return nil, db.ErrNoTenant
+	}
+	var file string
+	err := a.pool.QueryRow(ctx,
+		"SELECT file FROM archive_index WHERE id = $1 AND tenant_id = $2", id, tenant.ID).Scan(&file)
+	if err != nil {
+		return nil, err
+	}
+	rc, err := a.store.Get(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var found *models.Transaction
+	err = Decode(rc, func(t models.Transaction) error {
+		if t.ID == id && t.TenantID == tenant.ID {
+			found = &t
+			return errStop
+		}
+		return nil
+	})
+	if found != nil {
+		if found.DeletedAt != nil && !includeDeleted {
+			return nil, pgx.ErrNoRows
+		}
+		return found, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("archive: %s does not contain transaction %s", file, id)
+}
@@ -0,0 +1,59 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	ts := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	in := []models.Transaction{
+		{ID: "a", CoinID: "gold", UserID: "u1", CoinUsed: 1.5, TransactionTimestamp: ts, ExpiryDate: ts.Add(time.Hour), TenantID: "default"},
+		{ID: "b", CoinID: "gold", UserID: "u2", CoinUsed: 2, TransactionTimestamp: ts, ExpiryDate: ts, TenantID: "acme",
+			Version: 3, CreatedAt: ts.Add(time.Minute), Metadata: map[string]string{"order": "o-1"}, Tags: []string{"promo"}},
+	}
+	data, err := Encode(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out []models.Transaction
+	if err := Decode(bytes.NewReader(data), func(tx models.Transaction) error {
+		out = append(out, tx)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(out) != len(in) {
+		t.Fatalf("decoded %d rows, want %d", len(out), len(in))
+	}
+	for i := range in {
+		if !reflect.DeepEqual(out[i], in[i]) {
+			t.Errorf("row %d = %+v, want %+v", i, out[i], in[i])
+		}
+	}
+}
+
+func TestDirStore(t *testing.T) {
+	ctx := context.Background()
+	s := DirStore{Root: t.TempDir()}
+	if err := s.Put(ctx, "transactions/2023/05/x.ndjson.gz", []byte("data")); err != nil {
+		t.Fatal(err)
+	}
+	rc, err := s.Get(ctx, "transactions/2023/05/x.ndjson.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	if b, _ := io.ReadAll(rc); string(b) != "data" {
+		t.Errorf("read %q", b)
+	}
+	if err := s.Put(ctx, "../escape", nil); err == nil {
+		t.Error("expected an error for a name outside the root")
+	}
+}
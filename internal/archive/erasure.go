@@ -0,0 +1,97 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// EraseUser implements db.Eraser: every archive file holding transactions
+// of the tenant is rewritten in place with userID replaced by pseudonym
+// and the metadata of those rows cleared, and its manifest and checksum
+// are updated. Files are written before the erasure commits; a rolled back
+// erasure leaves them pseudonymized, which a retry tolerates.
+func (a *Archiver) EraseUser(ctx context.Context, tx pgx.Tx, tenant, userID, pseudonym string) error {
+	rows, err := tx.Query(ctx, `
+		SELECT f.name, f.row_count, f.from_ts, f.to_ts, f.created_at
+		FROM archive_files f
+		WHERE EXISTS (SELECT 1 FROM archive_index i WHERE i.file = f.name AND i.tenant_id = $1)
+		ORDER BY f.name`, tenant)
+	if err != nil {
+		return err
+	}
+	files, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Manifest, error) {
+		m := Manifest{Format: Format}
+		err := row.Scan(&m.File, &m.Rows, &m.From, &m.To, &m.CreatedAt)
+		return m, err
+	})
+	if err != nil {
+		return err
+	}
+	for _, m := range files {
+		changed, err := a.rewrite(ctx, m, tenant, userID, pseudonym)
+		if err != nil {
+			return err
+		}
+		if changed == nil {
+			continue
+		}
+		if _, err := tx.Exec(ctx, "UPDATE archive_files SET sha256 = $1 WHERE name = $2", changed.SHA256, changed.File); err != nil {
+			return err
+		}
+		log.Printf("archive: erased a user from %s", m.File)
+	}
+	return nil
+}
+
+// rewrite replaces the user in file m and returns the new manifest, or nil
+// when the file does not mention the user.
+func (a *Archiver) rewrite(ctx context.Context, m Manifest, tenant, userID, pseudonym string) (*Manifest, error) {
+	rc, err := a.store.Get(ctx, m.File)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	raw, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		txs   []models.Transaction
+		found bool
+	)
+	err = Decode(bytes.NewReader(raw), func(t models.Transaction) error {
+		if t.TenantID == tenant && t.UserID == userID {
+			t.UserID, t.Metadata, found = pseudonym, nil, true
+		}
+		txs = append(txs, t)
+		return nil
+	})
+	if err != nil || !found {
+		return nil, err
+	}
+	data, err := Encode(txs)
+	if err != nil {
+		return nil, err
+	}
+	m.SHA256 = checksum(data)
+	if err := a.store.Put(ctx, m.File, data); err != nil {
+		return nil, err
+	}
+	if err := a.verify(ctx, m, txs); err != nil {
+		return nil, err
+	}
+	manifest, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := a.store.Put(ctx, m.File+".manifest.json", manifest); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
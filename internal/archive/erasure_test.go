@@ -0,0 +1,169 @@
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/audit"
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// Needs a disposable database: TEST_DATABASE_URL=postgres://...
+func TestEraseUserLeavesNoTrace(t *testing.T) {
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+	bg := context.Background()
+	pool, err := db.NewPool(bg, url)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(pool.Close)
+	if err := pool.Migrate(bg); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	store := DirStore{Root: t.TempDir()}
+	archiver := NewArchiver(pool, store)
+	pool.AddEraser(archiver)
+
+	suffix := time.Now().UnixNano()
+	userID := fmt.Sprintf("erase-me-%d", suffix)
+	tenant, _, err := db.NewTenantRepo(pool).Create(bg, models.Tenant{ID: fmt.Sprintf("erase-%d", suffix), Name: "erase"})
+	if err != nil {
+		t.Fatalf("create tenant: %v", err)
+	}
+	ctx := middleware.WithIdentity(db.WithTenant(bg, tenant), "10.0.0.1", userID)
+	if _, err := db.NewCoinRepo(pool).Set(ctx, models.Coin{ID: "BTC", Active: true, Precision: 8}); err != nil {
+		t.Fatalf("register coin: %v", err)
+	}
+	if _, err := db.NewPlatformRepo(pool).Create(ctx, models.Platform{Name: "p", Enabled: true}); err != nil {
+		t.Fatalf("register platform: %v", err)
+	}
+
+	// Live rows, with an audited call by the user.
+	repo := db.NewTransactionRepo(pool)
+	now := time.Now().UTC()
+	live, err := repo.Insert(ctx, models.Transaction{CoinID: "BTC", UserID: userID, DataID: "d1", CoinUsed: 1,
+		TransactionTimestamp: now, ExpiryDate: now.Add(time.Hour), PlatformName: "p", Metadata: map[string]string{"email": userID}})
+	if err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+	if _, err := db.NewCapRepo(pool).Set(ctx, models.SpendingCap{UserID: userID, Period: models.CapPeriodDaily, Amount: 100}); err != nil {
+		t.Fatalf("set cap: %v", err)
+	}
+	if _, err := db.NewReconciliationRepo(pool).Save(ctx, models.ReconciliationRun{PlatformName: "p", From: now, To: now},
+		[]models.ReconciliationItem{{Kind: "missing_ours", Status: "open", DataID: "d1", UserID: userID}}); err != nil {
+		t.Fatalf("save reconciliation: %v", err)
+	}
+	rec := &audit.Recorder{Log: audit.NewLog(pool), UserKey: []byte("test-key")}
+	rec.Record(ctx, audit.TransportGraphQL, "addTransaction", map[string]any{"input": map[string]any{"userid": userID}}, live, nil)
+
+	// A partition detached to the archive schema, and an archive file.
+	part := pgx.Identifier{"archive", fmt.Sprintf("transactions_p19%04d", suffix%10000)}.Sanitize()
+	archived := *live
+	archived.ID = fmt.Sprintf("00000000-0000-4000-8000-%012d", suffix%1000000000000)
+	archived.TransactionTimestamp = now.AddDate(-2, 0, 0)
+	err = pool.MaintenanceTx(bg, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(bg, "CREATE TABLE "+part+" (LIKE transactions INCLUDING DEFAULTS)"); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(bg, "INSERT INTO "+part+" (id, coinid, userid, dataid, coinused, transactionTimestamp, expiryDate, platformName, tenant_id, metadata) SELECT gen_random_uuid(), coinid, userid, dataid, coinused, transactionTimestamp, expiryDate, platformName, tenant_id, metadata FROM transactions WHERE id = $1", live.ID); err != nil {
+			return err
+		}
+		data, err := Encode([]models.Transaction{archived})
+		if err != nil {
+			return err
+		}
+		file := fmt.Sprintf("transactions/test/%d.ndjson.gz", suffix)
+		if err := store.Put(bg, file, data); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(bg, "INSERT INTO archive_files (name, row_count, sha256, from_ts, to_ts) VALUES ($1, 1, $2, $3, $3)",
+			file, checksum(data), archived.TransactionTimestamp); err != nil {
+			return err
+		}
+		_, err = tx.Exec(bg, "INSERT INTO archive_index (id, tenant_id, file) VALUES ($1, $2, $3)", archived.ID, tenant.ID, file)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("set up archive: %v", err)
+	}
+	t.Cleanup(func() { _, _ = pool.Exec(bg, "DROP TABLE IF EXISTS "+part) })
+
+	rc, err := repo.EraseUser(ctx, userID, "admin")
+	if err != nil {
+		t.Fatalf("erase: %v", err)
+	}
+
+	// Every text, jsonb and array column of every table.
+	var hits []string
+	err = pool.MaintenanceTx(bg, func(tx pgx.Tx) error {
+		rows, err := tx.Query(bg, `
+			SELECT table_schema, table_name, column_name
+			FROM information_schema.columns c
+			WHERE table_schema IN ('public', 'archive')
+			  AND data_type IN ('text', 'jsonb', 'ARRAY')
+			  AND EXISTS (SELECT 1 FROM information_schema.tables t
+			              WHERE t.table_schema = c.table_schema AND t.table_name = c.table_name AND t.table_type = 'BASE TABLE')`)
+		if err != nil {
+			return err
+		}
+		cols, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) ([3]string, error) {
+			var c [3]string
+			err := row.Scan(&c[0], &c[1], &c[2])
+			return c, err
+		})
+		if err != nil {
+			return err
+		}
+		for _, c := range cols {
+			var n int
+			q := fmt.Sprintf("SELECT count(*) FROM %s WHERE %s::text LIKE '%%' || $1 || '%%'",
+				pgx.Identifier{c[0], c[1]}.Sanitize(), pgx.Identifier{c[2]}.Sanitize())
+			if err := tx.QueryRow(bg, q, userID).Scan(&n); err != nil {
+				return fmt.Errorf("%s.%s.%s: %w", c[0], c[1], c[2], err)
+			}
+			if n > 0 {
+				hits = append(hits, fmt.Sprintf("%s.%s.%s (%d rows)", c[0], c[1], c[2], n))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("search tables: %v", err)
+	}
+	if len(hits) > 0 {
+		t.Errorf("erased user id still stored in %v", hits)
+	}
+
+	var file string
+	if err := pool.QueryRow(bg, "SELECT file FROM archive_index WHERE id = $1", archived.ID).Scan(&file); err != nil {
+		t.Fatal(err)
+	}
+	f, err := store.Get(bg, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if raw, _ := io.ReadAll(zr); bytes.Contains(raw, []byte(userID)) {
+		t.Error("erased user id still in the archive file")
+	}
+	got, err := archiver.GetByID(ctx, archived.ID, false)
+	if err != nil || got.UserID != rc.Pseudonym || len(got.Metadata) != 0 {
+		t.Errorf("archived row after erase: %+v, err=%v", got, err)
+	}
+}
@@ -0,0 +1,161 @@
+package audit
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+)
+
+func TestComputeHashCoversContentAndChain(t *testing.T) {
+	e := Event{
+		OccurredAt: time.Date(2025, 1, 2, 3, 4, 5, 6000, time.UTC),
+		Actor:      "user-1",
+		Transport:  TransportGRPC,
+		Operation:  "CreateTransaction",
+		Args:       `{"coinid":"BTC"}`,
+		ResultIDs:  []string{"tx-1"},
+		Outcome:    "ok",
+	}
+	h := ComputeHash(e)
+	if h != ComputeHash(e) {
+		t.Fatalf("hash is not deterministic")
+	}
+
+	tampered := e
+	tampered.Args = `{"coinid":"ETH"}`
+	if ComputeHash(tampered) == h {
+		t.Fatalf("hash ignores args")
+	}
+
+	relinked := e
+	relinked.PrevHash = "abc"
+	if ComputeHash(relinked) == h {
+		t.Fatalf("hash ignores prev_hash")
+	}
+}
+
+func TestRecorderPseudonymizesUserIDs(t *testing.T) {
+	ctxFor := func(tenant string) context.Context {
+		ctx := db.WithTenant(context.Background(), &models.Tenant{ID: tenant})
+		return middleware.WithIdentity(ctx, "10.0.0.1", "alice")
+	}
+	args := map[string]any{
+		"input":  map[string]any{"userid": "alice", "coinid": "BTC", "coinused": 1.5},
+		"filter": map[string]any{"userIds": []any{"alice", "bob"}},
+	}
+	r := &Recorder{UserKey: []byte("k")}
+	e := r.event(ctxFor("t1"), TransportGraphQL, "addTransaction", args, nil, nil)
+	if strings.Contains(e.Actor, "alice") || strings.Contains(e.Args, "alice") || strings.Contains(e.Args, "bob") {
+		t.Fatalf("user id stored: actor=%q args=%s", e.Actor, e.Args)
+	}
+	if !strings.Contains(e.Args, `"coinid":"BTC"`) || !strings.Contains(e.Args, `"coinused":1.5`) {
+		t.Fatalf("other arguments lost: %s", e.Args)
+	}
+	if want := r.Actor(ctxFor("t1"), "alice"); e.Actor != want || !strings.Contains(e.Args, want) {
+		t.Fatalf("actor %q and args %s do not use pseudonym %q", e.Actor, e.Args, want)
+	}
+	if r.Actor(ctxFor("t2"), "alice") == e.Actor {
+		t.Fatal("pseudonym is shared across tenants")
+	}
+
+	unkeyed := (&Recorder{}).event(ctxFor("t1"), TransportGraphQL, "addTransaction", args, nil, nil)
+	if unkeyed.Actor != redacted || strings.Contains(unkeyed.Args, "alice") {
+		t.Fatalf("without a key: actor=%q args=%s", unkeyed.Actor, unkeyed.Args)
+	}
+	if got := (&Recorder{}).Actor(context.Background(), ""); got != anonymous {
+		t.Fatalf("anonymous actor = %q", got)
+	}
+}
+
+func TestSummarizeTruncatesOnRuneBoundary(t *testing.T) {
+	// The JSON opening quote shifts the 3-byte runes so one straddles
+	// maxArgs.
+	for pad := 0; pad < 3; pad++ {
+		s := Summarize(strings.Repeat("x", pad) + strings.Repeat("€", maxArgs))
+		if !utf8.ValidString(s) {
+			t.Fatalf("pad %d: truncated summary is not valid UTF-8", pad)
+		}
+		if len(s) > maxArgs+len("…") || !strings.HasSuffix(s, "…") {
+			t.Fatalf("pad %d: summary of %d bytes not truncated to the limit", pad, len(s))
+		}
+	}
+}
+
+func TestComputeHashCoversTenant(t *testing.T) {
+	e := Event{OccurredAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), Actor: "a", Operation: "op"}
+	other := e
+	other.TenantID = "acme"
+	if ComputeHash(e) == ComputeHash(other) {
+		t.Fatal("hash ignores the tenant")
+	}
+}
+
+// chain links events of tenant into a valid chain starting at seq.
+func chain(tenant string, seq int64, prev string, n int) []Event {
+	var out []Event
+	for i := 0; i < n; i++ {
+		e := Event{Seq: seq + int64(i), TenantID: tenant, OccurredAt: time.Date(2025, 1, 1, 0, i, 0, 0, time.UTC),
+			Operation: "CreateTransaction", Outcome: "ok", PrevHash: prev}
+		e.Hash = ComputeHash(e)
+		prev = e.Hash
+		out = append(out, e)
+	}
+	return out
+}
+
+func verify(known map[string]Head, events []Event) VerifyResult {
+	v := newVerifier(known)
+	for _, e := range events {
+		if !v.add(e) {
+			break
+		}
+	}
+	return v.finish()
+}
+
+func TestVerifyDetectsTailDeletion(t *testing.T) {
+	events := chain("t1", 1, "", 5)
+	first := verify(nil, events)
+	if first.BrokenAt != 0 || first.Heads["t1"].Seq != 5 {
+		t.Fatalf("intact chain: %+v", first)
+	}
+
+	// The chain without its last two rows still links up.
+	if res := verify(nil, events[:3]); res.BrokenAt != 0 {
+		t.Fatalf("truncated chain without heads: %+v", res)
+	}
+	if res := verify(first.Heads, events[:3]); res.BrokenAt != 5 {
+		t.Fatalf("truncated chain: %+v, want broken at the recorded head", res)
+	}
+
+	// Rows appended after the deletion do not hide it.
+	regrown := append(events[:3:3], chain("t1", 6, events[2].Hash, 3)...)
+	if res := verify(first.Heads, regrown); res.BrokenAt != 5 {
+		t.Fatalf("truncated and regrown chain: %+v, want broken at the recorded head", res)
+	}
+
+	// A chain rewritten with recomputed hashes no longer ends in the head.
+	rewritten := chain("t1", 1, "", 5)
+	rewritten[0].Actor = "someone-else"
+	prev := ""
+	for i := range rewritten {
+		rewritten[i].PrevHash = prev
+		rewritten[i].Hash = ComputeHash(rewritten[i])
+		prev = rewritten[i].Hash
+	}
+	if res := verify(first.Heads, rewritten); res.BrokenAt != 5 {
+		t.Fatalf("rewritten chain: %+v, want broken at the recorded head", res)
+	}
+
+	// New rows after the head verify and move it forward.
+	grown := append(events[:5:5], chain("t1", 6, events[4].Hash, 2)...)
+	if res := verify(first.Heads, grown); res.BrokenAt != 0 || res.Heads["t1"].Seq != 7 {
+		t.Fatalf("grown chain: %+v", res)
+	}
+}
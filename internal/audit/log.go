@@ -0,0 +1,211 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// Log appends to and reads from the audit_log table.
+type Log struct {
+	pool *db.Pool
+}
+
+func NewLog(pool *db.Pool) *Log {
+	return &Log{pool: pool}
+}
+
+const eventColumns = "seq, tenant_id, occurred_at, actor, admin, transport, operation, args, result_ids, outcome, client_ip, request_id, prev_hash, hash"
+
+func scanEvent(row pgx.Row) (Event, error) {
+	var e Event
+	err := row.Scan(&e.Seq, &e.TenantID, &e.OccurredAt, &e.Actor, &e.Admin, &e.Transport, &e.Operation, &e.Args,
+		&e.ResultIDs, &e.Outcome, &e.ClientIP, &e.RequestID, &e.PrevHash, &e.Hash)
+	return e, err
+}
+
+// ErrNoTenant is returned by List without Filter.TenantID.
+var ErrNoTenant = errors.New("audit: tenant is required")
+
+// Append links e to the head of the chain of the tenant in ctx and stores
+// it. Appends to one chain are serialized with an advisory lock on its
+// head so it never forks; other tenants append concurrently.
+func (l *Log) Append(ctx context.Context, e Event) (Event, error) {
+	// Postgres keeps microseconds; hash what will be read back.
+	e.OccurredAt = time.Now().UTC().Truncate(time.Microsecond)
+	if e.ResultIDs == nil {
+		e.ResultIDs = []string{}
+	}
+	if t := db.TenantFromContext(ctx); t != nil {
+		e.TenantID = t.ID
+	}
+	err := pgx.BeginFunc(ctx, l.pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtextextended('audit:' || $1, 0))", e.TenantID); err != nil {
+			return err
+		}
+		e.PrevHash = ""
+		err := tx.QueryRow(ctx, "SELECT hash FROM audit_log WHERE tenant_id = $1 ORDER BY seq DESC LIMIT 1", e.TenantID).Scan(&e.PrevHash)
+		if err != nil && err != pgx.ErrNoRows {
+			return err
+		}
+		e.Hash = ComputeHash(e)
+		return tx.QueryRow(ctx, `
+			INSERT INTO audit_log (tenant_id, occurred_at, actor, admin, transport, operation, args, result_ids, outcome, client_ip, request_id, prev_hash, hash)
+			VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13)
+			RETURNING seq`,
+			e.TenantID, e.OccurredAt, e.Actor, e.Admin, e.Transport, e.Operation, e.Args, e.ResultIDs, e.Outcome,
+			e.ClientIP, e.RequestID, e.PrevHash, e.Hash).Scan(&e.Seq)
+	})
+	return e, err
+}
+
+// List returns the events of one tenant matching f, newest first.
+func (l *Log) List(ctx context.Context, f Filter) ([]Event, error) {
+	if f.TenantID == "" {
+		return nil, ErrNoTenant
+	}
+	sb := strings.Builder{}
+	sb.WriteString("SELECT " + eventColumns + " FROM audit_log WHERE tenant_id = $1")
+	args := []any{f.TenantID}
+	add := func(clause string, val any) {
+		args = append(args, val)
+		sb.WriteString(" AND ")
+		sb.WriteString(fmt.Sprintf(clause, len(args)))
+	}
+	if f.Actor != "" {
+		add("actor = $%d", f.Actor)
+	}
+	if f.Operation != "" {
+		add("operation = $%d", f.Operation)
+	}
+	if f.ResultID != "" {
+		add("result_ids @> ARRAY[$%d::text]", f.ResultID)
+	}
+	if f.From != nil {
+		add("occurred_at >= $%d", *f.From)
+	}
+	if f.To != nil {
+		add("occurred_at <= $%d", *f.To)
+	}
+	sb.WriteString(" ORDER BY seq DESC")
+	limit := 100
+	if f.Limit > 0 && f.Limit <= 1000 {
+		limit = f.Limit
+	}
+	sb.WriteString(fmt.Sprintf(" LIMIT %d", limit))
+	if f.Offset > 0 {
+		sb.WriteString(fmt.Sprintf(" OFFSET %d", f.Offset))
+	}
+
+	rows, err := l.pool.Query(ctx, sb.String(), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []Event
+	for rows.Next() {
+		e, err := scanEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, e)
+	}
+	return out, rows.Err()
+}
+
+// Head is the last row of a tenant's chain. Hashes are unkeyed, so
+// someone able to bypass the triggers can drop the end of a chain or
+// rewrite it and recompute every hash; keeping the heads of one
+// verification outside the database and passing them to the next catches
+// both.
+type Head struct {
+	Seq  int64  `json:"seq"`
+	Hash string `json:"hash"`
+}
+
+// VerifyResult summarizes a chain check.
+type VerifyResult struct {
+	Checked  int64
+	BrokenAt int64 // seq of the first bad row, 0 if the chain is intact
+	Reason   string
+	Heads    map[string]Head // each tenant's last row, to pass to the next Verify
+}
+
+// verifier checks rows in seq order against their chains and the heads
+// recorded by an earlier verification.
+type verifier struct {
+	known map[string]Head
+	seen  map[string]bool // tenants whose recorded head was found
+	res   VerifyResult
+}
+
+func newVerifier(known map[string]Head) *verifier {
+	return &verifier{known: known, seen: map[string]bool{}, res: VerifyResult{Heads: map[string]Head{}}}
+}
+
+// add checks the next row and reports whether the chain is still intact.
+func (v *verifier) add(e Event) bool {
+	v.res.Checked++
+	head, anchored := v.known[e.TenantID]
+	switch {
+	case e.PrevHash != v.res.Heads[e.TenantID].Hash:
+		v.res.BrokenAt, v.res.Reason = e.Seq, "prev_hash does not match the preceding row of its tenant"
+	case ComputeHash(e) != e.Hash:
+		v.res.BrokenAt, v.res.Reason = e.Seq, "hash does not match row contents"
+	case anchored && e.Seq == head.Seq && e.Hash != head.Hash:
+		v.res.BrokenAt, v.res.Reason = e.Seq, "row differs from the recorded head of its tenant"
+	}
+	if v.res.BrokenAt != 0 {
+		return false
+	}
+	if anchored && e.Seq == head.Seq {
+		v.seen[e.TenantID] = true
+	}
+	v.res.Heads[e.TenantID] = Head{Seq: e.Seq, Hash: e.Hash}
+	return true
+}
+
+// finish checks that every recorded head is still in its chain.
+func (v *verifier) finish() VerifyResult {
+	if v.res.BrokenAt != 0 {
+		return v.res
+	}
+	for tenant, head := range v.known {
+		if !v.seen[tenant] && (v.res.BrokenAt == 0 || head.Seq < v.res.BrokenAt) {
+			v.res.BrokenAt = head.Seq
+			v.res.Reason = fmt.Sprintf("recorded head of tenant %q is missing; rows were removed", tenant)
+		}
+	}
+	return v.res
+}
+
+// Verify walks every tenant's chain in order, recomputing every hash and
+// comparing it with known, the heads of an earlier Verify (nil on the
+// first run).
+func (l *Log) Verify(ctx context.Context, known map[string]Head) (VerifyResult, error) {
+	rows, err := l.pool.Query(ctx, "SELECT "+eventColumns+" FROM audit_log ORDER BY seq")
+	if err != nil {
+		return VerifyResult{}, err
+	}
+	defer rows.Close()
+
+	v := newVerifier(known)
+	for rows.Next() {
+		e, err := scanEvent(rows)
+		if err != nil {
+			return v.res, err
+		}
+		if !v.add(e) {
+			return v.finish(), nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return v.res, err
+	}
+	return v.finish(), nil
+}
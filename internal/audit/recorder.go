@@ -0,0 +1,162 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"strings"
+
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+)
+
+// Recorder turns API calls into audit events. Mutations are always
+// recorded; reads only when Reads is set. A nil *Recorder records nothing.
+type Recorder struct {
+	Log   *Log
+	Reads bool
+	// UserKey keys the hash stored in place of user ids in actors and
+	// arguments, so the append-only log never holds an id that erasure
+	// would have to remove. Without a key user ids are redacted outright.
+	UserKey []byte
+}
+
+// Enabled reports whether a call of the given kind should be recorded.
+func (r *Recorder) Enabled(read bool) bool {
+	return r != nil && (!read || r.Reads)
+}
+
+// redactedOps never have their arguments stored, not even pseudonymized:
+// an erasure request must not leave a trace linking to the erased user.
+var redactedOps = map[string]bool{
+	"eraseUser": true, // GraphQL
+	"EraseUser": true, // gRPC
+}
+
+// Record appends an event for a completed call. Identity fields come from
+// the context populated by middleware.Identify / grpcapi.Identify.
+//
+// Auditing deliberately fails open: the call has already taken effect, so
+// reporting it as failed would only invite a retry that repeats it. A
+// failed append is logged with the request id so the gap can be traced.
+func (r *Recorder) Record(ctx context.Context, transport, op string, args, result any, callErr error) {
+	e := r.event(ctx, transport, op, args, result, callErr)
+	// Detach from the request so a cancelled client cannot drop the record.
+	if _, err := r.Log.Append(context.WithoutCancel(ctx), e); err != nil {
+		log.Printf("audit: failed to record %s %s (request %s): %v", transport, op, e.RequestID, err)
+	}
+}
+
+func (r *Recorder) event(ctx context.Context, transport, op string, args, result any, callErr error) Event {
+	outcome := "ok"
+	if callErr != nil {
+		outcome = callErr.Error()
+	}
+	if redactedOps[op] {
+		args = redacted
+	} else {
+		args = r.pseudonymizeArgs(ctx, args)
+	}
+	return Event{
+		Actor:     r.Actor(ctx, middleware.UserIDFromContext(ctx)),
+		Admin:     middleware.IsAdmin(ctx),
+		Transport: transport,
+		Operation: op,
+		Args:      Summarize(args),
+		ResultIDs: ResultIDs(result),
+		Outcome:   outcome,
+		ClientIP:  middleware.ClientIPFromContext(ctx),
+		RequestID: middleware.RequestIDFromContext(ctx),
+	}
+}
+
+const (
+	redacted  = "[redacted]"
+	anonymous = "anonymous"
+)
+
+// Actor returns the actor stored for calls by user id uid in the tenant in
+// ctx. Filters on the actor column must go through it too.
+func (r *Recorder) Actor(ctx context.Context, uid string) string {
+	if uid == "" || uid == anonymous {
+		return anonymous
+	}
+	return r.pseudonym(ctx, uid)
+}
+
+// pseudonym is a keyed hash of uid scoped to the tenant, so the same user
+// cannot be correlated across tenants.
+func (r *Recorder) pseudonym(ctx context.Context, uid string) string {
+	if len(r.UserKey) == 0 {
+		return redacted
+	}
+	mac := hmac.New(sha256.New, r.UserKey)
+	mac.Write([]byte(middleware.TenantIDFromContext(ctx) + "\x00" + uid))
+	return "user:" + hex.EncodeToString(mac.Sum(nil))[:32]
+}
+
+// userArgs are argument names holding user ids, compared in lower case
+// without underscores to cover GraphQL, protojson and proto spellings.
+var userArgs = map[string]bool{"userid": true, "userids": true, "createdby": true, "actor": true, "requestedby": true}
+
+// pseudonymizeArgs replaces the user ids in args, walking its JSON form.
+func (r *Recorder) pseudonymizeArgs(ctx context.Context, args any) any {
+	raw, err := json.Marshal(args)
+	if err != nil {
+		return args
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return args
+	}
+	return r.walkArgs(ctx, v, false)
+}
+
+func (r *Recorder) walkArgs(ctx context.Context, v any, user bool) any {
+	switch x := v.(type) {
+	case map[string]any:
+		for k, e := range x {
+			x[k] = r.walkArgs(ctx, e, userArgs[strings.ToLower(strings.ReplaceAll(k, "_", ""))])
+		}
+	case []any:
+		for i, e := range x {
+			x[i] = r.walkArgs(ctx, e, user)
+		}
+	case string:
+		if user && x != "" {
+			return r.pseudonym(ctx, x)
+		}
+	}
+	return v
+}
+
+// ResultIDs extracts transaction (or other entity) ids from a result.
+func ResultIDs(v any) []string {
+	switch x := v.(type) {
+	case []string:
+		return x
+	case *models.Transaction:
+		if x != nil {
+			return []string{x.ID}
+		}
+	case models.Transaction:
+		return []string{x.ID}
+	case []models.Transaction:
+		ids := make([]string, 0, len(x))
+		for _, t := range x {
+			ids = append(ids, t.ID)
+		}
+		return ids
+	case interface{ GetId() string }:
+		if id := x.GetId(); id != "" {
+			return []string{id}
+		}
+	}
+	return nil
+}
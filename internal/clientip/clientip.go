@@ -0,0 +1,157 @@
+// Package clientip derives the real client address of a request that may
+// have passed through reverse proxies. Forwarding headers are only honoured
+// when the directly connected peer is a configured trusted proxy, and are
+// walked right to left so a client cannot spoof its address by prepending
+// entries.
+package clientip
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+type Resolver struct {
+	trusted []netip.Prefix
+}
+
+// NewResolver parses a comma separated list of CIDRs or bare IPs.
+func NewResolver(cidrs string) (*Resolver, error) {
+	r := &Resolver{}
+	for _, s := range strings.Split(cidrs, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if !strings.Contains(s, "/") {
+			addr, err := netip.ParseAddr(s)
+			if err != nil {
+				return nil, fmt.Errorf("trusted proxy %q: %w", s, err)
+			}
+			r.trusted = append(r.trusted, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy %q: %w", s, err)
+		}
+		r.trusted = append(r.trusted, p.Masked())
+	}
+	return r, nil
+}
+
+// Trusted reports whether ip belongs to a trusted proxy.
+func (r *Resolver) Trusted(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, p := range r.trusted {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolve walks hops (client first, as listed in the headers) from the
+// right, starting at the directly connected remote, and returns the first
+// address not belonging to a trusted proxy.
+func (r *Resolver) resolve(remote string, hops []string) string {
+	client := remote
+	for i := len(hops) - 1; i >= 0 && r.Trusted(client); i-- {
+		ip, ok := parseHop(hops[i])
+		if !ok {
+			// Obfuscated or malformed entry: the last trusted proxy is the
+			// best we can vouch for.
+			break
+		}
+		client = ip
+	}
+	return client
+}
+
+// FromHTTP returns the client address of req.
+func (r *Resolver) FromHTTP(req *http.Request) string {
+	remote := hostOnly(req.RemoteAddr)
+	if !r.Trusted(remote) {
+		return remote
+	}
+	if fwd := req.Header.Values("Forwarded"); len(fwd) > 0 {
+		return r.resolve(remote, parseForwarded(fwd))
+	}
+	return r.resolve(remote, splitXFF(req.Header.Values("X-Forwarded-For")))
+}
+
+// FromGRPC returns the client address of an incoming gRPC call, honouring
+// forwarded/x-forwarded-for metadata set by trusted proxies.
+func (r *Resolver) FromGRPC(ctx context.Context) string {
+	remote := "unknown"
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		remote = hostOnly(p.Addr.String())
+	}
+	if !r.Trusted(remote) {
+		return remote
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if fwd := md.Get("forwarded"); len(fwd) > 0 {
+		return r.resolve(remote, parseForwarded(fwd))
+	}
+	return r.resolve(remote, splitXFF(md.Get("x-forwarded-for")))
+}
+
+func hostOnly(addr string) string {
+	if h, _, err := net.SplitHostPort(addr); err == nil {
+		return h
+	}
+	return addr
+}
+
+// splitXFF flattens (possibly repeated) X-Forwarded-For headers.
+func splitXFF(values []string) []string {
+	var hops []string
+	for _, v := range values {
+		for _, part := range strings.Split(v, ",") {
+			hops = append(hops, strings.TrimSpace(part))
+		}
+	}
+	return hops
+}
+
+// parseForwarded extracts the for= parameter of each RFC 7239 element.
+// Elements without one are kept as empty hops so positions stay aligned.
+func parseForwarded(values []string) []string {
+	var hops []string
+	for _, v := range values {
+		for _, elem := range strings.Split(v, ",") {
+			hop := ""
+			for _, pair := range strings.Split(elem, ";") {
+				k, val, ok := strings.Cut(strings.TrimSpace(pair), "=")
+				if ok && strings.EqualFold(k, "for") {
+					hop = strings.Trim(val, `"`)
+				}
+			}
+			hops = append(hops, hop)
+		}
+	}
+	return hops
+}
+
+// parseHop normalizes "1.2.3.4", "1.2.3.4:80", "[::1]:80" or "::1".
+func parseHop(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	if addr, err := netip.ParseAddr(strings.Trim(s, "[]")); err == nil {
+		return addr.Unmap().String(), true
+	}
+	if ap, err := netip.ParseAddrPort(s); err == nil {
+		return ap.Addr().Unmap().String(), true
+	}
+	return "", false
+}
@@ -0,0 +1,75 @@
+package clientip
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestFromHTTP(t *testing.T) {
+	r, err := NewResolver("10.0.0.0/8, 192.168.1.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		name    string
+		remote  string
+		headers map[string]string
+		want    string
+	}{
+		{"untrusted peer ignores xff", "203.0.113.9:1234", map[string]string{"X-Forwarded-For": "1.1.1.1"}, "203.0.113.9"},
+		{"rightmost untrusted wins", "10.0.0.2:80", map[string]string{"X-Forwarded-For": "6.6.6.6, 198.51.100.7, 10.0.0.9"}, "198.51.100.7"},
+		{"all trusted falls back to leftmost", "10.0.0.2:80", map[string]string{"X-Forwarded-For": "192.168.1.1, 10.1.1.1"}, "192.168.1.1"},
+		{"forwarded header", "192.168.1.1:80", map[string]string{"Forwarded": `for=6.6.6.6, for="[2001:db8::17]:4711";proto=https`}, "2001:db8::17"},
+		{"obfuscated hop stops walk", "10.0.0.2:80", map[string]string{"Forwarded": "for=6.6.6.6, for=_hidden"}, "10.0.0.2"},
+	}
+	for _, c := range cases {
+		req, _ := http.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = c.remote
+		for k, v := range c.headers {
+			req.Header.Set(k, v)
+		}
+		if got := r.FromHTTP(req); got != c.want {
+			t.Errorf("%s: got %s, want %s", c.name, got, c.want)
+		}
+	}
+}
+
+func TestReadProxyHeaderV1(t *testing.T) {
+	br := bufio.NewReader(strings.NewReader("PROXY TCP4 198.51.100.1 10.0.0.1 5555 443\r\nGET / HTTP/1.1\r\n"))
+	addr, err := readProxyHeader(br)
+	if err != nil || addr.String() != "198.51.100.1:5555" {
+		t.Fatalf("got %v, %v", addr, err)
+	}
+	rest, _ := br.ReadString('\n')
+	if rest != "GET / HTTP/1.1\r\n" {
+		t.Fatalf("payload not preserved: %q", rest)
+	}
+}
+
+func TestReadProxyHeaderV2(t *testing.T) {
+	var buf bytes.Buffer
+	buf.Write(v2Signature)
+	buf.Write([]byte{0x21, 0x11}) // v2 PROXY, TCP over IPv4
+	binary.Write(&buf, binary.BigEndian, uint16(12))
+	buf.Write([]byte{198, 51, 100, 1, 10, 0, 0, 1})
+	binary.Write(&buf, binary.BigEndian, uint16(5555))
+	binary.Write(&buf, binary.BigEndian, uint16(443))
+	buf.WriteString("PRI * HTTP/2.0")
+
+	br := bufio.NewReader(&buf)
+	addr, err := readProxyHeader(br)
+	if err != nil || addr.String() != "198.51.100.1:5555" {
+		t.Fatalf("got %v, %v", addr, err)
+	}
+}
+
+func TestReadProxyHeaderAbsent(t *testing.T) {
+	br := bufio.NewReader(strings.NewReader("GET / HTTP/1.1\r\n"))
+	if addr, err := readProxyHeader(br); addr != nil || err != nil {
+		t.Fatalf("got %v, %v", addr, err)
+	}
+}
@@ -0,0 +1,170 @@
+package clientip
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// v2Signature prefixes every PROXY protocol v2 header.
+var v2Signature = []byte("\r\n\r\n\x00\r\nQUIT\n")
+
+// headerTimeout bounds how long a connection may take to send its header.
+const headerTimeout = 5 * time.Second
+
+// ProxyListener accepts PROXY protocol v1/v2 headers from trusted proxies
+// and reports the original client as the connection's RemoteAddr. Headers
+// are parsed lazily on first use so a slow peer cannot stall Accept.
+// Connections from untrusted peers are passed through untouched.
+type ProxyListener struct {
+	net.Listener
+	resolver *Resolver
+}
+
+func NewProxyListener(l net.Listener, r *Resolver) *ProxyListener {
+	return &ProxyListener{Listener: l, resolver: r}
+}
+
+func (l *ProxyListener) Accept() (net.Conn, error) {
+	c, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	if !l.resolver.Trusted(hostOnly(c.RemoteAddr().String())) {
+		return c, nil
+	}
+	return &proxyConn{Conn: c, br: bufio.NewReader(c), remote: c.RemoteAddr()}, nil
+}
+
+type proxyConn struct {
+	net.Conn
+	br     *bufio.Reader
+	once   sync.Once
+	remote net.Addr
+	err    error
+}
+
+func (c *proxyConn) init() {
+	c.once.Do(func() {
+		_ = c.Conn.SetReadDeadline(time.Now().Add(headerTimeout))
+		defer c.Conn.SetReadDeadline(time.Time{})
+		addr, err := readProxyHeader(c.br)
+		if err != nil {
+			c.err = err
+			c.Conn.Close()
+			return
+		}
+		if addr != nil {
+			c.remote = addr
+		}
+	})
+}
+
+func (c *proxyConn) Read(b []byte) (int, error) {
+	c.init()
+	if c.err != nil {
+		return 0, c.err
+	}
+	return c.br.Read(b)
+}
+
+func (c *proxyConn) RemoteAddr() net.Addr {
+	c.init()
+	return c.remote
+}
+
+// readProxyHeader consumes a PROXY header if one is present. It returns a
+// nil address when there is no header or it carries no address (LOCAL,
+// UNKNOWN), in which case the socket peer stays authoritative.
+func readProxyHeader(br *bufio.Reader) (net.Addr, error) {
+	prefix, err := br.Peek(5)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	switch {
+	case string(prefix) == "PROXY":
+		return readV1(br)
+	case prefix[0] == '\r':
+		sig, err := br.Peek(len(v2Signature))
+		if err == nil && bytes.Equal(sig, v2Signature) {
+			return readV2(br)
+		}
+	}
+	return nil, nil
+}
+
+// readV1 parses "PROXY TCP4 <src> <dst> <sport> <dport>\r\n".
+func readV1(br *bufio.Reader) (net.Addr, error) {
+	var line []byte
+	for len(line) < 107 {
+		b, err := br.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		line = append(line, b)
+		if b == '\n' {
+			break
+		}
+	}
+	s := string(line)
+	if !strings.HasSuffix(s, "\r\n") {
+		return nil, fmt.Errorf("proxy v1: header too long or unterminated")
+	}
+	fields := strings.Fields(strings.TrimSuffix(s, "\r\n"))
+	if len(fields) >= 2 && fields[1] == "UNKNOWN" {
+		return nil, nil
+	}
+	if len(fields) != 6 || (fields[1] != "TCP4" && fields[1] != "TCP6") {
+		return nil, fmt.Errorf("proxy v1: malformed header %q", s)
+	}
+	ip := net.ParseIP(fields[2])
+	port, err := strconv.Atoi(fields[4])
+	if ip == nil || err != nil {
+		return nil, fmt.Errorf("proxy v1: bad source %s:%s", fields[2], fields[4])
+	}
+	return &net.TCPAddr{IP: ip, Port: port}, nil
+}
+
+// readV2 parses the binary header defined in section 2.2 of the spec.
+func readV2(br *bufio.Reader) (net.Addr, error) {
+	hdr := make([]byte, 16)
+	if _, err := io.ReadFull(br, hdr); err != nil {
+		return nil, err
+	}
+	if hdr[12]>>4 != 2 {
+		return nil, fmt.Errorf("proxy v2: unsupported version %d", hdr[12]>>4)
+	}
+	cmd, family := hdr[12]&0x0f, hdr[13]>>4
+	body := make([]byte, binary.BigEndian.Uint16(hdr[14:16]))
+	if _, err := io.ReadFull(br, body); err != nil {
+		return nil, err
+	}
+	if cmd == 0 { // LOCAL: health checks from the proxy itself
+		return nil, nil
+	}
+	switch family {
+	case 1: // AF_INET
+		if len(body) < 12 {
+			return nil, fmt.Errorf("proxy v2: short ipv4 block")
+		}
+		return &net.TCPAddr{IP: net.IP(body[0:4]), Port: int(binary.BigEndian.Uint16(body[8:10]))}, nil
+	case 2: // AF_INET6
+		if len(body) < 36 {
+			return nil, fmt.Errorf("proxy v2: short ipv6 block")
+		}
+		return &net.TCPAddr{IP: net.IP(body[0:16]), Port: int(binary.BigEndian.Uint16(body[32:34]))}, nil
+	default:
+		return nil, nil
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 )
 
 type Config struct {
@@ -16,6 +17,59 @@ type Config struct {
 	// Rate Limiting
 	IPRatePerMinute   int
 	UserRatePerMinute int
+	AuthRatePerMinute int           // per IP, across tenants, before the API key is looked up
+	RateLimitBackend  string        // "memory" (per process) or "postgres" (shared)
+	RateLimitIdleTTL  time.Duration // evict buckets unused for this long
+	RateLimitMaxKeys  int           // in-memory backend cap on tracked keys
+	QuotaPolicyFile   string        // optional JSON quota policy, see internal/quota
+
+	// Business rules applied to every new transaction; optional JSON file,
+	// see internal/rules
+	RulesFile string
+
+	// Fraud screening of every new transaction; optional JSON file, see
+	// internal/fraud
+	FraudRulesFile string
+
+	// Admin APIs are enabled only when a token is configured
+	AdminToken string
+
+	// Tenancy: callers without X-API-Key act as DefaultTenant; empty
+	// makes the key mandatory
+	DefaultTenant string
+
+	// Audit: mutations are always recorded, reads only when enabled.
+	// User ids are stored as hashes keyed by AuditUserKey, or redacted
+	// when it is empty
+	AuditReads   bool
+	AuditUserKey string
+
+	// Partition maintenance (cmd/maintenance)
+	PartitionMonthsAhead int           // monthly partitions created ahead of time
+	RetentionMonths      int           // full months kept attached; 0 keeps everything
+	RetentionMode        string        // "detach" (to the archive schema) or "drop"
+	MaintenanceInterval  time.Duration // 0 runs once and exits
+
+	// Cold archival to files; disabled unless ArchiveDir is set
+	ArchiveDir       string
+	ArchiveAfterDays int // archive transactions older than this; 0 disables the job
+	ArchiveBatchSize int // rows per archive file
+
+	// Authorized holds are voided when not captured within HoldTimeout
+	HoldTimeout time.Duration
+
+	// Settlement reconciliation defaults, overridable per run
+	ReconcileAmountTolerance float64       // coin amount difference still counted as a match
+	ReconcileTimeTolerance   time.Duration // timestamp difference still counted as a match
+
+	// Recurring charges (cmd/worker)
+	SubscriptionRetryInterval time.Duration // wait between attempts of a failed charge
+	SubscriptionGracePeriod   time.Duration // a subscription lapses this long after a missed charge
+	WorkerInterval            time.Duration // 0 runs once and exits
+
+	// Proxies
+	TrustedProxies string // comma separated CIDRs whose forwarding headers are honoured
+	ProxyProtocol  bool   // accept PROXY protocol v1/v2 from trusted proxies
 
 	// gRPC
 	GRPCAddr string // e.g. ":9090"
@@ -37,13 +91,72 @@ func getenvInt(key string, def int) int {
 	return def
 }
 
+func getenvFloat(key string, def float64) float64 {
+	if v := os.Getenv(key); v != "" {
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f
+		}
+	}
+	return def
+}
+
+func getenvDuration(key string, def time.Duration) time.Duration {
+	if v := os.Getenv(key); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return def
+}
+
+func getenvBool(key string, def bool) bool {
+	if v := os.Getenv(key); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	}
+	return def
+}
+
 func Load() (*Config, error) {
 	cfg := &Config{
 		Addr:              getenv("ADDR", ":6080"),
 		DatabaseURL:       os.Getenv("DATABASE_URL"),
 		IPRatePerMinute:   getenvInt("IP_RATE_PER_MINUTE", 60),
 		UserRatePerMinute: getenvInt("USER_RATE_PER_MINUTE", 120),
-		GRPCAddr:          getenv("GRPC_ADDR", ":6090"),
+		AuthRatePerMinute: getenvInt("AUTH_RATE_PER_MINUTE", 600),
+		RateLimitBackend:  getenv("RATE_LIMIT_BACKEND", "memory"),
+		RateLimitIdleTTL:  getenvDuration("RATE_LIMIT_IDLE_TTL", 10*time.Minute),
+		RateLimitMaxKeys:  getenvInt("RATE_LIMIT_MAX_ENTRIES", 100000),
+		QuotaPolicyFile:   os.Getenv("QUOTA_POLICY_FILE"),
+		RulesFile:         os.Getenv("RULES_FILE"),
+		FraudRulesFile:    os.Getenv("FRAUD_RULES_FILE"),
+		AdminToken:        os.Getenv("ADMIN_TOKEN"),
+		DefaultTenant:     getenv("DEFAULT_TENANT", "default"),
+		AuditReads:        getenvBool("AUDIT_READS", false),
+		AuditUserKey:      os.Getenv("AUDIT_USER_KEY"),
+		TrustedProxies:    os.Getenv("TRUSTED_PROXIES"),
+
+		PartitionMonthsAhead: getenvInt("PARTITION_MONTHS_AHEAD", 3),
+		RetentionMonths:      getenvInt("RETENTION_MONTHS", 0),
+		RetentionMode:        getenv("RETENTION_MODE", "detach"),
+		MaintenanceInterval:  getenvDuration("MAINTENANCE_INTERVAL", 0),
+
+		ArchiveDir:       os.Getenv("ARCHIVE_DIR"),
+		ArchiveAfterDays: getenvInt("ARCHIVE_AFTER_DAYS", 0),
+		ArchiveBatchSize: getenvInt("ARCHIVE_BATCH_SIZE", 10000),
+
+		HoldTimeout: getenvDuration("HOLD_TIMEOUT", 24*time.Hour),
+
+		ReconcileAmountTolerance: getenvFloat("RECONCILE_AMOUNT_TOLERANCE", 0),
+		ReconcileTimeTolerance:   getenvDuration("RECONCILE_TIME_TOLERANCE", 5*time.Minute),
+
+		SubscriptionRetryInterval: getenvDuration("SUBSCRIPTION_RETRY_INTERVAL", 6*time.Hour),
+		SubscriptionGracePeriod:   getenvDuration("SUBSCRIPTION_GRACE_PERIOD", 72*time.Hour),
+		WorkerInterval:            getenvDuration("WORKER_INTERVAL", time.Minute),
+
+		ProxyProtocol: getenvBool("PROXY_PROTOCOL", false),
+		GRPCAddr:      getenv("GRPC_ADDR", ":6090"),
 	}
 	if cfg.DatabaseURL == "" {
 		return nil, fmt.Errorf("DATABASE_URL is required")
@@ -0,0 +1,196 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// InvalidCoinError is returned by TransactionRepo.Insert when the coin is
+// unknown or inactive, or the amount does not fit the coin's rules, and by
+// CoinRepo.Set for invalid catalog entries.
+type InvalidCoinError struct {
+	CoinID string
+	Reason string
+}
+
+func (e *InvalidCoinError) Error() string {
+	return fmt.Sprintf("coin %q: %s", e.CoinID, e.Reason)
+}
+
+// ErrInvalidAmount rejects negative, NaN and infinite amounts, which would
+// slip past every range check and poison spend totals.
+var ErrInvalidAmount = errors.New("coinused must be a finite, non-negative number")
+
+func checkAmount(v float64) error {
+	if math.IsNaN(v) || math.IsInf(v, 0) || v < 0 {
+		return ErrInvalidAmount
+	}
+	return nil
+}
+
+// checkCoin validates spending amount of coin c, which is nil when coinID
+// is not in the catalog.
+func checkCoin(c *models.Coin, coinID string, amount float64) error {
+	invalid := func(format string, args ...any) error {
+		return &InvalidCoinError{CoinID: coinID, Reason: fmt.Sprintf(format, args...)}
+	}
+	switch {
+	case c == nil:
+		return invalid("unknown coin")
+	case !c.Active:
+		return invalid("coin is inactive")
+	case math.IsNaN(amount) || math.IsInf(amount, 0):
+		return invalid("amount must be finite")
+	case amount < c.MinSpend:
+		return invalid("amount %g is below the minimum of %g", amount, c.MinSpend)
+	case c.MaxSpend > 0 && amount > c.MaxSpend:
+		return invalid("amount %g is above the maximum of %g", amount, c.MaxSpend)
+	}
+	scaled := amount * math.Pow10(c.Precision)
+	if math.Abs(scaled-math.Round(scaled)) > 1e-9*math.Max(1, math.Abs(scaled)) {
+		return invalid("amount %g has more than %d decimal places", amount, c.Precision)
+	}
+	return nil
+}
+
+const coinColumns = "id, name, precision, active, min_spend, max_spend, created_at, updated_at"
+
+func scanCoin(row pgx.Row) (*models.Coin, error) {
+	var c models.Coin
+	if err := row.Scan(&c.ID, &c.Name, &c.Precision, &c.Active, &c.MinSpend, &c.MaxSpend, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func getCoin(ctx context.Context, q querier, tenant, id string) (*models.Coin, error) {
+	c, err := scanCoin(q.QueryRow(ctx, "SELECT "+coinColumns+" FROM coins WHERE tenant_id = $1 AND id = $2", tenant, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return c, err
+}
+
+// validateCoin must run inside the inserting transaction.
+func validateCoin(ctx context.Context, tx pgx.Tx, t models.Transaction) error {
+	c, err := getCoin(ctx, tx, t.TenantID, t.CoinID)
+	if err != nil {
+		return err
+	}
+	return checkCoin(c, t.CoinID, t.CoinUsed)
+}
+
+// CoinRepo manages the coin catalog. Get is cached briefly because GraphQL
+// resolves it once per listed transaction.
+type CoinRepo struct {
+	pool *Pool
+
+	mu    sync.Mutex
+	cache map[string]cachedCoin
+}
+
+type cachedCoin struct {
+	c       *models.Coin
+	expires time.Time
+}
+
+const coinCacheTTL = 30 * time.Second
+
+func NewCoinRepo(pool *Pool) *CoinRepo {
+	return &CoinRepo{pool: pool, cache: map[string]cachedCoin{}}
+}
+
+// Get returns a coin of the tenant in ctx, or nil if there is none.
+func (r *CoinRepo) Get(ctx context.Context, id string) (*models.Coin, error) {
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	key := tenant + ":" + id
+	r.mu.Lock()
+	cc, ok := r.cache[key]
+	r.mu.Unlock()
+	if ok && time.Now().Before(cc.expires) {
+		return cc.c, nil
+	}
+
+	var c *models.Coin
+	err = r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		c, err = getCoin(ctx, tx, tenant, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	r.cache[key] = cachedCoin{c: c, expires: time.Now().Add(coinCacheTTL)}
+	r.mu.Unlock()
+	return c, nil
+}
+
+func (r *CoinRepo) List(ctx context.Context, includeInactive bool) ([]models.Coin, error) {
+	var out []models.Coin
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		rows, err := tx.Query(ctx, "SELECT "+coinColumns+" FROM coins WHERE tenant_id = $1 AND (active OR $2) ORDER BY id", tenant, includeInactive)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			c, err := scanCoin(rows)
+			if err != nil {
+				return err
+			}
+			out = append(out, *c)
+		}
+		return rows.Err()
+	})
+	return out, err
+}
+
+// Set creates or replaces a coin of the tenant in ctx.
+func (r *CoinRepo) Set(ctx context.Context, c models.Coin) (*models.Coin, error) {
+	invalid := func(reason string) error {
+		return &InvalidCoinError{CoinID: c.ID, Reason: reason}
+	}
+	switch {
+	case c.ID == "":
+		return nil, invalid("id is required")
+	case c.Precision < 0 || c.Precision > 18:
+		return nil, invalid("precision must be between 0 and 18")
+	case c.MinSpend < 0 || c.MaxSpend < 0:
+		return nil, invalid("spend limits must be non-negative")
+	case c.MaxSpend > 0 && c.MaxSpend < c.MinSpend:
+		return nil, invalid("maxSpend must not be below minSpend")
+	}
+	if c.Name == "" {
+		c.Name = c.ID
+	}
+	var out *models.Coin
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		var err error
+		out, err = scanCoin(tx.QueryRow(ctx, `
+			INSERT INTO coins (tenant_id, id, name, precision, active, min_spend, max_spend)
+			VALUES ($1,$2,$3,$4,$5,$6,$7)
+			ON CONFLICT (tenant_id, id) DO UPDATE
+			SET name = EXCLUDED.name, precision = EXCLUDED.precision, active = EXCLUDED.active,
+			    min_spend = EXCLUDED.min_spend, max_spend = EXCLUDED.max_spend, updated_at = now()
+			RETURNING `+coinColumns,
+			tenant, c.ID, c.Name, c.Precision, c.Active, c.MinSpend, c.MaxSpend))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	r.cache = map[string]cachedCoin{}
+	r.mu.Unlock()
+	return out, nil
+}
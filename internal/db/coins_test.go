@@ -0,0 +1,40 @@
+package db
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+)
+
+func TestCheckCoin(t *testing.T) {
+	btc := &models.Coin{ID: "BTC", Active: true, Precision: 2, MinSpend: 1, MaxSpend: 100}
+	cases := []struct {
+		name   string
+		coin   *models.Coin
+		amount float64
+		ok     bool
+	}{
+		{"valid", btc, 12.34, true},
+		{"float noise", btc, 0.1 + 0.2 + 1, true},
+		{"unknown", nil, 5, false},
+		{"inactive", &models.Coin{ID: "BTC", Precision: 2}, 5, false},
+		{"below min", btc, 0.5, false},
+		{"above max", btc, 100.01, false},
+		{"too precise", btc, 1.234, false},
+		{"no max", &models.Coin{ID: "X", Active: true, Precision: 0}, 1e9, true},
+		{"NaN", btc, math.NaN(), false},
+		{"infinite, no max", &models.Coin{ID: "X", Active: true, Precision: 0}, math.Inf(1), false},
+	}
+	for _, c := range cases {
+		err := checkCoin(c.coin, "BTC", c.amount)
+		var invalid *InvalidCoinError
+		if c.ok && err != nil {
+			t.Errorf("%s: unexpected error %v", c.name, err)
+		}
+		if !c.ok && !errors.As(err, &invalid) {
+			t.Errorf("%s: err = %v, want InvalidCoinError", c.name, err)
+		}
+	}
+}
@@ -9,6 +9,10 @@ import (
 
 type Pool struct {
 	*pgxpool.Pool
+
+	hooks    []Hook   // see AddHook
+	screener Screener // see SetScreener
+	erasers  []Eraser // see AddEraser
 }
 
 func NewPool(ctx context.Context, url string) (*Pool, error) {
@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// DuplicateTransactionError is returned by TransactionRepo.Insert and
+// Authorize when the platform rejects duplicates and t repeats a recent
+// transaction.
+type DuplicateTransactionError struct {
+	Existing models.Transaction
+}
+
+func (e *DuplicateTransactionError) Error() string {
+	return fmt.Sprintf("duplicate of transaction %s on platform %q", e.Existing.ID, e.Existing.PlatformName)
+}
+
+// findDuplicate must run inside the inserting transaction, before the
+// insert. It returns the live transaction that t repeats within its
+// platform's dedup window, or nil when there is none or the platform has
+// no window. Platforms set to reject duplicates get a
+// DuplicateTransactionError instead. The user's spend lock is taken
+// first, so of two concurrent identical creates the second waits for the
+// first to commit and then finds it.
+func findDuplicate(ctx context.Context, tx pgx.Tx, t models.Transaction) (*models.Transaction, error) {
+	p, err := getPlatform(ctx, tx, t.TenantID, t.PlatformName)
+	if err != nil || p == nil || p.DedupWindow <= 0 {
+		return nil, err
+	}
+	if err := lockUser(ctx, tx, t.TenantID, t.UserID); err != nil {
+		return nil, err
+	}
+	dup, err := scanTransaction(tx.QueryRow(ctx, `
+		SELECT `+txColumns+`
+		FROM transactions
+		WHERE tenant_id = $1
+		  AND userid = $2
+		  AND dataid = $3
+		  AND platformName = $4
+		  AND coinid = $5
+		  AND coinused = $6
+		  AND deleted_at IS NULL
+		  AND status <> 'voided'
+		  AND transactionTimestamp BETWEEN $7::timestamptz - $8::interval AND $7::timestamptz + $8::interval
+		ORDER BY transactionTimestamp DESC
+		LIMIT 1`,
+		t.TenantID, t.UserID, t.DataID, t.PlatformName, t.CoinID, t.CoinUsed, t.TransactionTimestamp, p.DedupWindow))
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	case p.DedupAction == models.DedupReject:
+		return nil, &DuplicateTransactionError{Existing: *dup}
+	}
+	return dup, nil
+}
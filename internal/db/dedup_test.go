@@ -0,0 +1,78 @@
+package db
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+)
+
+func TestDuplicateDetection(t *testing.T) {
+	pool := testPool(t)
+	repo := NewTransactionRepo(pool)
+	platforms := NewPlatformRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "dedup")
+	if _, err := platforms.Update(ctx, models.Platform{Name: "p", Enabled: true, DedupWindow: time.Minute}); err != nil {
+		t.Fatalf("set dedup window: %v", err)
+	}
+
+	now := time.Now().UTC()
+	tx := models.Transaction{
+		CoinID: "BTC", UserID: "u", DataID: "d", CoinUsed: 1,
+		TransactionTimestamp: now, ExpiryDate: now.Add(time.Hour), PlatformName: "p",
+	}
+
+	// Concurrent double-clicks must all resolve to one stored transaction.
+	const clicks = 5
+	ids := make([]string, clicks)
+	errs := make([]error, clicks)
+	var wg sync.WaitGroup
+	for i := range clicks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			click := tx
+			click.TransactionTimestamp = now.Add(time.Duration(i) * time.Second)
+			out, err := repo.Insert(ctx, click)
+			if err == nil {
+				ids[i] = out.ID
+			}
+			errs[i] = err
+		}()
+	}
+	wg.Wait()
+	for i := range clicks {
+		if errs[i] != nil {
+			t.Fatalf("insert %d: %v", i, errs[i])
+		}
+		if ids[i] != ids[0] {
+			t.Fatalf("insert %d returned %s, want %s", i, ids[i], ids[0])
+		}
+	}
+
+	// A different amount or a repeat outside the window is a new purchase.
+	other := tx
+	other.CoinUsed = 2
+	if out, err := repo.Insert(ctx, other); err != nil || out.ID == ids[0] {
+		t.Fatalf("different amount: %+v, %v", out, err)
+	}
+	later := tx
+	later.TransactionTimestamp = now.Add(-2 * time.Minute)
+	if out, err := repo.Insert(ctx, later); err != nil || out.ID == ids[0] {
+		t.Fatalf("outside window: %+v, %v", out, err)
+	}
+
+	if _, err := platforms.Update(ctx, models.Platform{Name: "p", Enabled: true, DedupWindow: time.Minute, DedupAction: models.DedupReject}); err != nil {
+		t.Fatalf("set dedup action: %v", err)
+	}
+	var dup *DuplicateTransactionError
+	if _, err := repo.Insert(ctx, tx); !errors.As(err, &dup) || dup.Existing.ID != ids[0] {
+		t.Fatalf("rejected duplicate: err=%v", err)
+	}
+
+	if _, err := platforms.Update(ctx, models.Platform{Name: "p", Enabled: true, DedupAction: "ignore"}); err == nil {
+		t.Fatal("unknown dedup action accepted")
+	}
+}
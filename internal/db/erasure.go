@@ -0,0 +1,205 @@
+package db
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// ErasureSubjectHash identifies an erased user on receipts without storing
+// the userid.
+func ErasureSubjectHash(tenant, userID string) string {
+	sum := sha256.Sum256([]byte(tenant + "\x00" + userID))
+	return hex.EncodeToString(sum[:])
+}
+
+func newPseudonym() string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	return "erased-" + hex.EncodeToString(b)
+}
+
+// Eraser pseudonymizes a user in a store outside the tables EraseUser
+// rewrites itself, such as archive files. Erasers run inside EraseUser's
+// database transaction after the tables are rewritten, so an error aborts
+// the erasure; their own writes cannot be rolled back and must be safe to
+// repeat.
+type Eraser interface {
+	EraseUser(ctx context.Context, tx pgx.Tx, tenant, userID, pseudonym string) error
+}
+
+// AddEraser registers e with every EraseUser call on p. It must be called
+// before p serves requests.
+func (p *Pool) AddEraser(e Eraser) {
+	p.erasers = append(p.erasers, e)
+}
+
+// ErrArchiveNotErasable is returned by EraseUser when the tenant has
+// archived transactions but no Eraser is registered to rewrite them.
+var ErrArchiveNotErasable = errors.New("tenant has archived transactions but no archive is configured to erase them from")
+
+// userColumns are columns, besides transactions.userid and
+// spending_caps.userid, that may hold the user's id.
+var userColumns = []struct{ table, column string }{
+	{"transaction_signals", "userid"},
+	{"fraud_reviews", "userid"},
+	{"fraud_reviews", "reviewed_by"},
+	{"scheduled_transactions", "created_by"},
+	{"transaction_status_history", "actor"},
+	{"transaction_revisions", "actor"},
+	{"reconciliation_items", "userid"},
+	{"reconciliation_items", "resolved_by"},
+}
+
+// EraseUser replaces userID with a random pseudonym in every table of the
+// tenant in ctx, detached partitions included, clears the metadata of their
+// transactions, cancels their open subscriptions and pending scheduled
+// spends, and stores a receipt. Registered Erasers then rewrite archive
+// files. It holds the per-user lock cap enforcement takes, so no concurrent
+// insert is half-erased.
+func (r *TransactionRepo) EraseUser(ctx context.Context, userID, requestedBy string) (*models.ErasureReceipt, error) {
+	var out *models.ErasureReceipt
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		if err := lockUser(ctx, tx, tenant, userID); err != nil {
+			return err
+		}
+		if len(r.pool.erasers) == 0 {
+			var archived bool
+			if err := tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM archive_index WHERE tenant_id = $1)", tenant).Scan(&archived); err != nil {
+				return err
+			}
+			if archived {
+				return ErrArchiveNotErasable
+			}
+		}
+		rc := models.ErasureReceipt{
+			TenantID:    tenant,
+			Pseudonym:   newPseudonym(),
+			SubjectHash: ErasureSubjectHash(tenant, userID),
+			RequestedBy: requestedBy,
+		}
+		tag, err := tx.Exec(ctx, "UPDATE transactions SET userid = $1, metadata = '{}' WHERE tenant_id = $2 AND userid = $3", rc.Pseudonym, tenant, userID)
+		if err != nil {
+			return err
+		}
+		rc.Transactions = tag.RowsAffected()
+		n, err := eraseDetachedPartitions(ctx, tx, tenant, userID, rc.Pseudonym)
+		if err != nil {
+			return err
+		}
+		rc.Transactions += n
+		tag, err = tx.Exec(ctx, "UPDATE spending_caps SET userid = $1 WHERE tenant_id = $2 AND userid = $3", rc.Pseudonym, tenant, userID)
+		if err != nil {
+			return err
+		}
+		rc.SpendingCaps = tag.RowsAffected()
+		if _, err := tx.Exec(ctx, `
+			UPDATE subscriptions
+			SET userid = $1, updated_at = now(),
+			    status = CASE WHEN status IN ('active', 'past_due', 'paused') THEN 'cancelled' ELSE status END
+			WHERE tenant_id = $2 AND userid = $3`, rc.Pseudonym, tenant, userID); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, `
+			UPDATE scheduled_transactions
+			SET userid = $1, updated_at = now(),
+			    status = CASE WHEN status = 'pending' THEN 'cancelled' ELSE status END
+			WHERE tenant_id = $2 AND userid = $3`, rc.Pseudonym, tenant, userID); err != nil {
+			return err
+		}
+		for _, c := range userColumns {
+			q := fmt.Sprintf("UPDATE %s SET %s = $1 WHERE tenant_id = $2 AND %[2]s = $3", c.table, c.column)
+			if _, err := tx.Exec(ctx, q, rc.Pseudonym, tenant, userID); err != nil {
+				return err
+			}
+		}
+		if _, err := tx.Exec(ctx, "UPDATE ledger_postings SET account = $1 WHERE tenant_id = $2 AND account = $3",
+			models.UserAccount(rc.Pseudonym), tenant, models.UserAccount(userID)); err != nil {
+			return err
+		}
+		for _, e := range r.pool.erasers {
+			if err := e.EraseUser(ctx, tx, tenant, userID, rc.Pseudonym); err != nil {
+				return err
+			}
+		}
+		if err := tx.QueryRow(ctx, `
+			INSERT INTO erasure_receipts (tenant_id, pseudonym, subject_hash, transactions, spending_caps, requested_by)
+			VALUES ($1,$2,$3,$4,$5,$6)
+			RETURNING id, erased_at`,
+			rc.TenantID, rc.Pseudonym, rc.SubjectHash, rc.Transactions, rc.SpendingCaps, rc.RequestedBy,
+		).Scan(&rc.ID, &rc.ErasedAt); err != nil {
+			return err
+		}
+		out = &rc
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// eraseDetachedPartitions pseudonymizes the user in the monthly partitions
+// ApplyRetention moved to the archive schema. Partitions detached before
+// metadata existed lack that column.
+func eraseDetachedPartitions(ctx context.Context, tx pgx.Tx, tenant, userID, pseudonym string) (int64, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT c.relname,
+		       EXISTS (SELECT 1 FROM pg_attribute a WHERE a.attrelid = c.oid AND a.attname = 'metadata' AND NOT a.attisdropped)
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = 'archive' AND c.relkind = 'r' AND c.relname ~ '^transactions_p[0-9]{6}$'`)
+	if err != nil {
+		return 0, err
+	}
+	type partition struct {
+		name        string
+		hasMetadata bool
+	}
+	parts, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (partition, error) {
+		var p partition
+		err := row.Scan(&p.name, &p.hasMetadata)
+		return p, err
+	})
+	if err != nil {
+		return 0, err
+	}
+	var total int64
+	for _, p := range parts {
+		set := "userid = $1"
+		if p.hasMetadata {
+			set += ", metadata = '{}'"
+		}
+		ident := pgx.Identifier{"archive", p.name}.Sanitize()
+		tag, err := tx.Exec(ctx, "UPDATE "+ident+" SET "+set+" WHERE tenant_id = $2 AND userid = $3", pseudonym, tenant, userID)
+		if err != nil {
+			return 0, fmt.Errorf("erase from %s: %w", ident, err)
+		}
+		total += tag.RowsAffected()
+	}
+	return total, nil
+}
+
+// SoftDelete hides a transaction from List and GetByID. Deleting an already
+// deleted or unknown id returns pgx.ErrNoRows.
+func (r *TransactionRepo) SoftDelete(ctx context.Context, id string) (*models.Transaction, error) {
+	var out *models.Transaction
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		var err error
+		out, err = scanTransaction(tx.QueryRow(ctx, `
+			UPDATE transactions SET deleted_at = now()
+			WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+			RETURNING `+txColumns, id, tenant))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,69 @@
+package db
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+func TestEraseUserAndSoftDelete(t *testing.T) {
+	pool := testPool(t)
+	repo := NewTransactionRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "erase")
+
+	now := time.Now().UTC()
+	userID := "to-be-forgotten"
+	var ids []string
+	for i := 0; i < 2; i++ {
+		tx, err := repo.Insert(ctx, models.Transaction{
+			CoinID: "BTC", UserID: userID, DataID: "d", CoinUsed: 2,
+			TransactionTimestamp: now, ExpiryDate: now.Add(time.Hour), PlatformName: "p",
+		})
+		if err != nil {
+			t.Fatalf("insert: %v", err)
+		}
+		ids = append(ids, tx.ID)
+	}
+
+	if _, err := repo.SoftDelete(ctx, ids[0]); err != nil {
+		t.Fatalf("soft delete: %v", err)
+	}
+	if _, err := repo.GetByID(ctx, ids[0], false); !errors.Is(err, pgx.ErrNoRows) {
+		t.Fatalf("deleted row visible: err=%v", err)
+	}
+	if got, err := repo.GetByID(ctx, ids[0], true); err != nil || got.DeletedAt == nil {
+		t.Fatalf("includeDeleted: got %+v, err=%v", got, err)
+	}
+	if list, _ := repo.List(ctx, TransactionFilter{UserID: &userID}); len(list) != 1 {
+		t.Fatalf("list returned %d rows, want 1", len(list))
+	}
+
+	recon := NewReconciliationRepo(pool)
+	run, err := recon.Save(ctx, models.ReconciliationRun{PlatformName: "p", From: now, To: now},
+		[]models.ReconciliationItem{{Kind: "missing_ours", Status: "open", DataID: "d", UserID: userID}})
+	if err != nil {
+		t.Fatalf("save reconciliation: %v", err)
+	}
+
+	rc, err := repo.EraseUser(ctx, userID, "admin")
+	if err != nil {
+		t.Fatalf("erase: %v", err)
+	}
+	if rc.Transactions != 2 || rc.SubjectHash != ErasureSubjectHash(rc.TenantID, userID) {
+		t.Fatalf("unexpected receipt %+v", rc)
+	}
+	if list, _ := repo.List(ctx, TransactionFilter{UserID: &userID, IncludeDeleted: true}); len(list) != 0 {
+		t.Fatalf("%d rows still carry the erased userid", len(list))
+	}
+	items, err := recon.Items(ctx, ItemFilter{RunID: run.ID})
+	if err != nil || len(items) != 1 || items[0].UserID != rc.Pseudonym {
+		t.Fatalf("reconciliation items after erase: %+v, err=%v", items, err)
+	}
+	got, err := repo.GetByID(ctx, ids[1], false)
+	if err != nil || got.UserID != rc.Pseudonym || got.CoinUsed != 2 {
+		t.Fatalf("after erase: got %+v, err=%v", got, err)
+	}
+}
@@ -0,0 +1,206 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// Screener evaluates new transactions for fraud inside the creating
+// database transaction, after the caps check has locked the user and
+// before the transaction is stored. ip is the client address, "" for
+// transactions created by background jobs.
+type Screener interface {
+	Screen(ctx context.Context, tx pgx.Tx, t models.Transaction, ip string) ([]models.FraudFinding, error)
+}
+
+// SetScreener enables fraud screening on every create path using p. It
+// must be called before p serves requests.
+func (p *Pool) SetScreener(s Screener) {
+	p.screener = s
+}
+
+// FraudRejectedError is returned when a screening rule rejects a
+// transaction.
+type FraudRejectedError struct {
+	Findings []models.FraudFinding
+}
+
+func (e *FraudRejectedError) Error() string {
+	var reasons []string
+	for _, f := range e.Findings {
+		if f.Action == models.FraudReject {
+			reasons = append(reasons, fmt.Sprintf("%s (%s)", f.Rule, f.Reason))
+		}
+	}
+	return "rejected as suspected fraud: " + strings.Join(reasons, "; ")
+}
+
+// ErrReviewClosed is returned when deciding a fraud review twice.
+var ErrReviewClosed = errors.New("fraud review is already decided")
+
+type clientIPKey struct{}
+
+// WithClientIP records the caller's address for fraud screening.
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, ip)
+}
+
+func clientIP(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey{}).(string)
+	return ip
+}
+
+// screen runs the screener on t and returns the strongest action of its
+// findings, or "" when nothing matched or screening is disabled.
+func (p *Pool) screen(ctx context.Context, tx pgx.Tx, t models.Transaction) (string, []models.FraudFinding, error) {
+	if p.screener == nil {
+		return "", nil, nil
+	}
+	findings, err := p.screener.Screen(ctx, tx, t, clientIP(ctx))
+	if err != nil {
+		return "", nil, err
+	}
+	action := ""
+	for _, f := range findings {
+		if models.FraudActionRank(f.Action) > models.FraudActionRank(action) {
+			action = f.Action
+		}
+	}
+	if action == models.FraudReject {
+		return "", nil, &FraudRejectedError{Findings: findings}
+	}
+	return action, findings, nil
+}
+
+// recordScreening stores the origin of a screened transaction and queues it
+// for review when it was flagged or held.
+func recordScreening(ctx context.Context, tx pgx.Tx, t *models.Transaction, ip, action string, findings []models.FraudFinding) error {
+	if ip != "" {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO transaction_signals (transaction_id, tenant_id, userid, platformName, ip)
+			VALUES ($1,$2,$3,$4,$5)`, t.ID, t.TenantID, t.UserID, t.PlatformName, ip); err != nil {
+			return err
+		}
+	}
+	if action == "" {
+		return nil
+	}
+	_, err := tx.Exec(ctx, `
+		INSERT INTO fraud_reviews (tenant_id, transaction_id, userid, platformName, coinused, action, findings)
+		VALUES ($1,$2,$3,$4,$5,$6,$7)`, t.TenantID, t.ID, t.UserID, t.PlatformName, t.CoinUsed, action, findings)
+	return err
+}
+
+// checkNotHeld keeps clients from capturing or voiding a transaction held
+// for fraud review; only the review decides it.
+func checkNotHeld(ctx context.Context, tx pgx.Tx, tenant, id, to string) error {
+	var held bool
+	if err := tx.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM fraud_reviews
+			WHERE tenant_id = $1 AND transaction_id::text = $2 AND action = 'hold' AND status = 'open')`,
+		tenant, id).Scan(&held); err != nil {
+		return err
+	}
+	if held {
+		return &IllegalTransitionError{ID: id, From: models.StatusAuthorized, To: to, Reason: "held for fraud review"}
+	}
+	return nil
+}
+
+// FraudReviewRepo is the operator queue of flagged and held transactions
+// of the tenant in ctx.
+type FraudReviewRepo struct {
+	pool *Pool
+}
+
+func NewFraudReviewRepo(pool *Pool) *FraudReviewRepo {
+	return &FraudReviewRepo{pool: pool}
+}
+
+const reviewColumns = `id, transaction_id, userid, platformName, coinused, action, findings, status,
+	reviewed_by, note, created_at, reviewed_at`
+
+func scanReview(row pgx.Row) (*models.FraudReview, error) {
+	var r models.FraudReview
+	if err := row.Scan(&r.ID, &r.TransactionID, &r.UserID, &r.PlatformName, &r.CoinUsed, &r.Action, &r.Findings, &r.Status,
+		&r.ReviewedBy, &r.Note, &r.CreatedAt, &r.ReviewedAt); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// List returns reviews oldest first, optionally in one status.
+func (r *FraudReviewRepo) List(ctx context.Context, status string, limit, offset int) ([]models.FraudReview, error) {
+	if limit <= 0 || limit > 1000 {
+		limit = 100
+	}
+	var out []models.FraudReview
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		rows, err := tx.Query(ctx, `
+			SELECT `+reviewColumns+` FROM fraud_reviews
+			WHERE tenant_id = $1 AND ($2 = '' OR status = $2)
+			ORDER BY id
+			LIMIT $3 OFFSET $4`, tenant, status, limit, offset)
+		if err != nil {
+			return err
+		}
+		out, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.FraudReview, error) {
+			rv, err := scanReview(row)
+			if err != nil {
+				return models.FraudReview{}, err
+			}
+			return *rv, nil
+		})
+		return err
+	})
+	return out, err
+}
+
+// Approve closes a review; a held transaction is captured.
+func (r *FraudReviewRepo) Approve(ctx context.Context, id int64, note, reviewer string) (*models.FraudReview, error) {
+	return r.decide(ctx, id, models.ReviewApproved, note, reviewer)
+}
+
+// Deny closes a review; a held transaction is voided. A flagged
+// transaction was already captured and is left as it is.
+func (r *FraudReviewRepo) Deny(ctx context.Context, id int64, note, reviewer string) (*models.FraudReview, error) {
+	return r.decide(ctx, id, models.ReviewDenied, note, reviewer)
+}
+
+func (r *FraudReviewRepo) decide(ctx context.Context, id int64, decision, note, reviewer string) (*models.FraudReview, error) {
+	var out *models.FraudReview
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		cur, err := scanReview(tx.QueryRow(ctx,
+			"SELECT "+reviewColumns+" FROM fraud_reviews WHERE tenant_id = $1 AND id = $2 FOR UPDATE", tenant, id))
+		if err != nil {
+			return err
+		}
+		if cur.Status != models.ReviewOpen {
+			return ErrReviewClosed
+		}
+		if cur.Action == models.FraudHold {
+			to, reason := models.StatusCaptured, "approved by fraud review"
+			if decision == models.ReviewDenied {
+				to, reason = models.StatusVoided, "denied by fraud review"
+			}
+			if _, err := transition(ctx, tx, tenant, cur.TransactionID, to, reason, reviewer); err != nil {
+				return err
+			}
+		}
+		out, err = scanReview(tx.QueryRow(ctx, `
+			UPDATE fraud_reviews SET status = $3, note = $4, reviewed_by = $5, reviewed_at = now()
+			WHERE tenant_id = $1 AND id = $2
+			RETURNING `+reviewColumns, tenant, id, decision, note, reviewer))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,86 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// userScreener applies the action keyed by the transaction's user.
+type userScreener map[string]string
+
+func (s userScreener) Screen(ctx context.Context, tx pgx.Tx, t models.Transaction, ip string) ([]models.FraudFinding, error) {
+	if action, ok := s[t.UserID]; ok {
+		return []models.FraudFinding{{Rule: "test", Action: action, Reason: "listed"}}, nil
+	}
+	return nil, nil
+}
+
+func TestFraudScreening(t *testing.T) {
+	pool := testPool(t)
+	pool.SetScreener(userScreener{"flagged": models.FraudFlag, "held": models.FraudHold, "rejected": models.FraudReject})
+	repo := NewTransactionRepo(pool)
+	reviews := NewFraudReviewRepo(pool)
+	ctx := WithClientIP(testTenant(t, NewTenantRepo(pool), "fraud"), "192.0.2.1")
+
+	insert := func(user string) (*models.Transaction, error) {
+		now := time.Now().UTC()
+		return repo.Insert(ctx, models.Transaction{
+			CoinID: "BTC", UserID: user, DataID: "d", CoinUsed: 1,
+			TransactionTimestamp: now, ExpiryDate: now.Add(time.Hour), PlatformName: "p",
+		})
+	}
+
+	if tx, err := insert("clean"); err != nil || tx.Status != models.StatusCaptured {
+		t.Fatalf("clean insert: %+v, %v", tx, err)
+	}
+	var fraudErr *FraudRejectedError
+	if _, err := insert("rejected"); !errors.As(err, &fraudErr) {
+		t.Fatalf("rejected insert: err=%v, want FraudRejectedError", err)
+	}
+	flagged, err := insert("flagged")
+	if err != nil || flagged.Status != models.StatusCaptured {
+		t.Fatalf("flagged insert: %+v, %v", flagged, err)
+	}
+	held, err := insert("held")
+	if err != nil || held.Status != models.StatusAuthorized || held.HoldExpiresAt != nil {
+		t.Fatalf("held insert: %+v, %v", held, err)
+	}
+	var illegal *IllegalTransitionError
+	if _, err := repo.Capture(ctx, held.ID, "client"); !errors.As(err, &illegal) {
+		t.Fatalf("client capture of held transaction: err=%v", err)
+	}
+
+	open, err := reviews.List(ctx, models.ReviewOpen, 0, 0)
+	if err != nil || len(open) != 2 {
+		t.Fatalf("open reviews = %+v, err=%v; want 2", open, err)
+	}
+	for _, rv := range open {
+		if len(rv.Findings) != 1 || rv.Findings[0].Rule != "test" {
+			t.Fatalf("review findings = %+v", rv.Findings)
+		}
+		var err error
+		switch rv.TransactionID {
+		case held.ID:
+			_, err = reviews.Approve(ctx, rv.ID, "known customer", "ops")
+		case flagged.ID:
+			_, err = reviews.Deny(ctx, rv.ID, "refund issued", "ops")
+		}
+		if err != nil {
+			t.Fatalf("decide review %d: %v", rv.ID, err)
+		}
+		if _, err := reviews.Approve(ctx, rv.ID, "", "ops"); !errors.Is(err, ErrReviewClosed) {
+			t.Fatalf("second decision: err=%v", err)
+		}
+	}
+	if got, err := repo.GetByID(ctx, held.ID, false); err != nil || got.Status != models.StatusCaptured {
+		t.Fatalf("approved hold = %+v, %v", got, err)
+	}
+	if got, err := repo.GetByID(ctx, flagged.ID, false); err != nil || got.Status != models.StatusCaptured {
+		t.Fatalf("denied flag = %+v, %v", got, err)
+	}
+}
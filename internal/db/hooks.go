@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// Hook lets business rules take part in every transaction create path:
+// inserts, holds, renewals, subscription charges and scheduled spends.
+// Hooks are registered with Pool.AddHook at startup and run in
+// registration order.
+type Hook interface {
+	// PreValidate runs before the built-in checks. An error rejects t.
+	PreValidate(ctx context.Context, t models.Transaction) error
+	// PreCommit runs inside the creating database transaction once t is
+	// stored, its caps checked and its ledger postings made. An error
+	// rolls all of that back.
+	PreCommit(ctx context.Context, tx pgx.Tx, t models.Transaction) error
+	// PostCommit runs after t is committed and cannot reject it.
+	PostCommit(ctx context.Context, t models.Transaction)
+}
+
+// RuleError is returned by hooks that reject a transaction.
+type RuleError struct {
+	Rule   string
+	Reason string
+}
+
+func (e *RuleError) Error() string {
+	return fmt.Sprintf("rejected by rule %q: %s", e.Rule, e.Reason)
+}
+
+// AddHook registers hooks for every create path using p. It must be called
+// before p serves requests.
+func (p *Pool) AddHook(hooks ...Hook) {
+	p.hooks = append(p.hooks, hooks...)
+}
+
+// postCommit runs the PostCommit hooks for transactions created by a
+// committed database transaction.
+func (p *Pool) postCommit(ctx context.Context, txs ...*models.Transaction) {
+	for _, t := range txs {
+		if t == nil {
+			continue
+		}
+		for _, h := range p.hooks {
+			h.PostCommit(ctx, *t)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+type recordingHook struct {
+	calls  []string
+	reject string // user whose transactions PreCommit rejects
+}
+
+func (h *recordingHook) PreValidate(ctx context.Context, t models.Transaction) error {
+	h.calls = append(h.calls, "pre-validate")
+	return nil
+}
+
+func (h *recordingHook) PreCommit(ctx context.Context, tx pgx.Tx, t models.Transaction) error {
+	h.calls = append(h.calls, "pre-commit")
+	if t.UserID == h.reject {
+		return &RuleError{Rule: "test", Reason: "rejected"}
+	}
+	return nil
+}
+
+func (h *recordingHook) PostCommit(ctx context.Context, t models.Transaction) {
+	h.calls = append(h.calls, "post-commit")
+}
+
+func TestHooks(t *testing.T) {
+	pool := testPool(t)
+	hook := &recordingHook{reject: "mallory"}
+	pool.AddHook(hook)
+	repo := NewTransactionRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "hooks")
+
+	now := time.Now().UTC()
+	tx := models.Transaction{
+		CoinID: "BTC", UserID: "alice", DataID: "d", CoinUsed: 1,
+		TransactionTimestamp: now, ExpiryDate: now.Add(time.Hour), PlatformName: "p",
+	}
+	if _, err := repo.Insert(ctx, tx); err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+	if want := []string{"pre-validate", "pre-commit", "post-commit"}; len(hook.calls) != 3 ||
+		hook.calls[0] != want[0] || hook.calls[1] != want[1] || hook.calls[2] != want[2] {
+		t.Fatalf("calls = %v, want %v", hook.calls, want)
+	}
+
+	hook.calls = nil
+	tx.UserID = "mallory"
+	var ruleErr *RuleError
+	if _, err := repo.Insert(ctx, tx); !errors.As(err, &ruleErr) {
+		t.Fatalf("rejected insert: err=%v, want RuleError", err)
+	}
+	if len(hook.calls) != 2 {
+		t.Fatalf("calls after rejection = %v, want no post-commit", hook.calls)
+	}
+	user := "mallory"
+	list, err := repo.List(ctx, TransactionFilter{UserID: &user})
+	if err != nil || len(list) != 0 {
+		t.Fatalf("rejected transaction stored: %d rows, err=%v", len(list), err)
+	}
+}
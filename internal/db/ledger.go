@@ -0,0 +1,201 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// postTransaction must run inside the inserting transaction. The database
+// rejects the commit if the entry does not balance.
+func postTransaction(ctx context.Context, tx pgx.Tx, t *models.Transaction) error {
+	var entry string
+	if err := tx.QueryRow(ctx, `
+		INSERT INTO journal_entries (tenant_id, transaction_id, transaction_ts)
+		VALUES ($1,$2,$3)
+		RETURNING id`, t.TenantID, t.ID, t.TransactionTimestamp).Scan(&entry); err != nil {
+		return err
+	}
+	if t.CoinUsed == 0 {
+		return nil
+	}
+	_, err := tx.Exec(ctx, `
+		INSERT INTO ledger_postings (entry_id, tenant_id, account, coinid, amount, posted_at)
+		VALUES ($1,$2,$3,$5,$6::float8::numeric,$7), ($1,$2,$4,$5,-$6::float8::numeric,$7)`,
+		entry, t.TenantID, models.UserAccount(t.UserID), models.PlatformAccount(t.PlatformName),
+		t.CoinID, t.CoinUsed, t.TransactionTimestamp)
+	return err
+}
+
+// LedgerRepo reads the double-entry journal of the tenant in ctx.
+type LedgerRepo struct {
+	pool *Pool
+}
+
+func NewLedgerRepo(pool *Pool) *LedgerRepo {
+	return &LedgerRepo{pool: pool}
+}
+
+// Balances returns an account's balance in every coin it has postings in.
+func (r *LedgerRepo) Balances(ctx context.Context, account string) ([]models.AccountBalance, error) {
+	var out []models.AccountBalance
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		rows, err := tx.Query(ctx, `
+			SELECT coinid, sum(amount)::float8
+			FROM ledger_postings
+			WHERE tenant_id = $1 AND account = $2
+			GROUP BY coinid
+			ORDER BY coinid`, tenant, account)
+		if err != nil {
+			return err
+		}
+		out, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.AccountBalance, error) {
+			b := models.AccountBalance{Account: account}
+			err := row.Scan(&b.CoinID, &b.Balance)
+			return b, err
+		})
+		return err
+	})
+	return out, err
+}
+
+// Statement returns the postings of account in coinID with posted_at in
+// [from, to] (either bound optional), oldest first and paginated by limit
+// and offset. Opening and Closing cover the whole range, not just the page.
+func (r *LedgerRepo) Statement(ctx context.Context, account, coinID string, from, to *time.Time, limit, offset int) (*models.Statement, error) {
+	if limit <= 0 || limit > 1000 {
+		limit = 100
+	}
+	out := &models.Statement{Account: account, CoinID: coinID, From: from, To: to, Postings: []models.Posting{}}
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		if err := tx.QueryRow(ctx, `
+			SELECT COALESCE(sum(amount) FILTER (WHERE $4::timestamptz IS NOT NULL AND posted_at < $4), 0)::float8,
+			       COALESCE(sum(amount) FILTER (WHERE $5::timestamptz IS NULL OR posted_at <= $5), 0)::float8
+			FROM ledger_postings
+			WHERE tenant_id = $1 AND account = $2 AND coinid = $3`, tenant, account, coinID, from, to).Scan(&out.Opening, &out.Closing); err != nil {
+			return err
+		}
+		rows, err := tx.Query(ctx, `
+			SELECT p.id, p.entry_id, e.transaction_id, p.amount::float8, p.posted_at
+			FROM ledger_postings p
+			JOIN journal_entries e ON e.id = p.entry_id
+			WHERE p.tenant_id = $1 AND p.account = $2 AND p.coinid = $3
+			  AND ($4::timestamptz IS NULL OR p.posted_at >= $4)
+			  AND ($5::timestamptz IS NULL OR p.posted_at <= $5)
+			ORDER BY p.posted_at, p.id
+			LIMIT $6 OFFSET $7`, tenant, account, coinID, from, to, limit, offset)
+		if err != nil {
+			return err
+		}
+		out.Postings, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.Posting, error) {
+			p := models.Posting{Account: account, CoinID: coinID}
+			err := row.Scan(&p.ID, &p.EntryID, &p.TransactionID, &p.Amount, &p.PostedAt)
+			return p, err
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ledgerSampleSize bounds the ids reported per kind of problem.
+const ledgerSampleSize = 100
+
+// CheckLedger verifies the journal against captured transactions for every
+// tenant, considering transactions and entries dated at or after since. Entries of
+// archived transactions are expected; entries of transactions removed by
+// partition retention show up as orphaned, so choose since accordingly.
+func (p *Pool) CheckLedger(ctx context.Context, since time.Time) (*models.LedgerReport, error) {
+	rep := &models.LedgerReport{}
+	err := p.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+		if err := tx.QueryRow(ctx, "SELECT count(*) FROM transactions WHERE transactionTimestamp >= $1 AND status = 'captured'", since).Scan(&rep.Transactions); err != nil {
+			return err
+		}
+		if err := tx.QueryRow(ctx, "SELECT count(*) FROM journal_entries WHERE transaction_ts >= $1", since).Scan(&rep.Entries); err != nil {
+			return err
+		}
+		samples := []struct {
+			dst   *[]string
+			query string
+		}{
+			{&rep.Unbalanced, `
+				SELECT DISTINCT p.entry_id::text
+				FROM ledger_postings p
+				JOIN journal_entries e ON e.id = p.entry_id
+				WHERE e.transaction_ts >= $1
+				GROUP BY p.entry_id, p.coinid
+				HAVING sum(p.amount) <> 0`},
+			{&rep.MissingEntries, `
+				SELECT t.id::text
+				FROM transactions t
+				WHERE t.transactionTimestamp >= $1 AND t.status = 'captured'
+				  AND NOT EXISTS (SELECT 1 FROM journal_entries e WHERE e.transaction_id = t.id)`},
+			{&rep.Orphaned, `
+				SELECT e.transaction_id::text
+				FROM journal_entries e
+				WHERE e.transaction_ts >= $1
+				  AND NOT EXISTS (SELECT 1 FROM transactions t WHERE t.id = e.transaction_id AND t.transactionTimestamp = e.transaction_ts)
+				  AND NOT EXISTS (SELECT 1 FROM archive_index a WHERE a.id = e.transaction_id)`},
+			{&rep.Mismatched, `
+				SELECT t.id::text
+				FROM transactions t
+				JOIN journal_entries e ON e.transaction_id = t.id
+				WHERE t.transactionTimestamp >= $1
+				  AND (
+					e.tenant_id <> t.tenant_id
+					OR t.coinused <> 0 AND NOT EXISTS (
+						SELECT 1 FROM ledger_postings p
+						WHERE p.entry_id = e.id AND p.account = 'user:' || t.userid
+						  AND p.coinid = t.coinid AND p.amount = t.coinused::numeric)
+					OR t.coinused <> 0 AND NOT EXISTS (
+						SELECT 1 FROM ledger_postings p
+						WHERE p.entry_id = e.id AND p.account = 'platform:' || t.platformName
+						  AND p.coinid = t.coinid AND p.amount = -t.coinused::numeric))`},
+		}
+		for _, s := range samples {
+			rows, err := tx.Query(ctx, s.query+fmt.Sprintf(" LIMIT %d", ledgerSampleSize), since)
+			if err != nil {
+				return err
+			}
+			ids, err := pgx.CollectRows(rows, pgx.RowTo[string])
+			if err != nil {
+				return err
+			}
+			*s.dst = ids
+		}
+
+		rows, err := tx.Query(ctx, `
+			SELECT coinid, sum(tx_total)::float8, sum(ledger_total)::float8
+			FROM (
+				SELECT t.coinid, t.coinused::numeric AS tx_total, 0::numeric AS ledger_total
+				FROM transactions t
+				WHERE t.transactionTimestamp >= $1 AND t.status = 'captured'
+				UNION ALL
+				SELECT p.coinid, 0, p.amount
+				FROM ledger_postings p
+				JOIN journal_entries e ON e.id = p.entry_id
+				JOIN transactions t ON t.id = e.transaction_id AND t.transactionTimestamp = e.transaction_ts
+				WHERE e.transaction_ts >= $1 AND p.account LIKE 'user:%'
+			) totals
+			GROUP BY coinid
+			ORDER BY coinid`, since)
+		if err != nil {
+			return err
+		}
+		rep.Totals, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.LedgerCoinTotal, error) {
+			var c models.LedgerCoinTotal
+			err := row.Scan(&c.CoinID, &c.Transactions, &c.Ledger)
+			return c, err
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rep, nil
+}
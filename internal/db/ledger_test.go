@@ -0,0 +1,47 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+)
+
+func TestLedgerPostings(t *testing.T) {
+	pool := testPool(t)
+	repo := NewTransactionRepo(pool)
+	ledger := NewLedgerRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "ledger")
+
+	start := time.Now().UTC().Add(-time.Minute)
+	for _, amount := range []float64{0.1, 0.2} {
+		if _, err := repo.Insert(ctx, models.Transaction{
+			CoinID: "BTC", UserID: "alice", DataID: "d", CoinUsed: amount,
+			TransactionTimestamp: time.Now().UTC(), ExpiryDate: time.Now().UTC().Add(time.Hour), PlatformName: "p",
+		}); err != nil {
+			t.Fatalf("insert: %v", err)
+		}
+	}
+
+	user, err := ledger.Balances(ctx, models.UserAccount("alice"))
+	if err != nil || len(user) != 1 || user[0].Balance != 0.3 {
+		t.Fatalf("user balance = %+v, err=%v; want 0.3 BTC", user, err)
+	}
+	platform, err := ledger.Balances(ctx, models.PlatformAccount("p"))
+	if err != nil || len(platform) != 1 || platform[0].Balance != -0.3 {
+		t.Fatalf("platform balance = %+v, err=%v; want -0.3 BTC", platform, err)
+	}
+
+	st, err := ledger.Statement(ctx, models.UserAccount("alice"), "BTC", &start, nil, 0, 0)
+	if err != nil || len(st.Postings) != 2 || st.Opening != 0 || st.Closing != 0.3 {
+		t.Fatalf("statement = %+v, err=%v", st, err)
+	}
+
+	rep, err := pool.CheckLedger(ctx, start)
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if !rep.OK() {
+		t.Fatalf("ledger inconsistent: %+v", rep)
+	}
+}
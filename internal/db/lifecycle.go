@@ -0,0 +1,169 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// DefaultHoldTimeout applies when TransactionRepo.HoldTimeout is unset.
+const DefaultHoldTimeout = 24 * time.Hour
+
+// IllegalTransitionError is returned when a transaction cannot move to the
+// requested status, including captures of expired holds.
+type IllegalTransitionError struct {
+	ID       string
+	From, To string
+	Reason   string
+}
+
+func (e *IllegalTransitionError) Error() string {
+	msg := fmt.Sprintf("transaction %s cannot go from %s to %s", e.ID, e.From, e.To)
+	if e.Reason != "" {
+		msg += ": " + e.Reason
+	}
+	return msg
+}
+
+// recordStatus appends t's move from the from status to its current one
+// to the history.
+func recordStatus(ctx context.Context, tx pgx.Tx, t *models.Transaction, from, reason, actor string) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO transaction_status_history (tenant_id, transaction_id, from_status, to_status, reason, actor)
+		VALUES ($1,$2,$3,$4,$5,$6)`, t.TenantID, t.ID, from, t.Status, reason, actor)
+	return err
+}
+
+// transition moves a live transaction of the tenant to status to. Captures
+// post the transaction to the ledger and apply renewals in the same
+// database transaction.
+func transition(ctx context.Context, tx pgx.Tx, tenant, id, to, reason, actor string) (*models.Transaction, error) {
+	cur, err := scanTransaction(tx.QueryRow(ctx, `
+		SELECT `+txColumns+` FROM transactions
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+		FOR UPDATE`, id, tenant))
+	if err != nil {
+		return nil, err
+	}
+	if !models.CanTransition(cur.Status, to) {
+		return nil, &IllegalTransitionError{ID: id, From: cur.Status, To: to}
+	}
+	if to == models.StatusCaptured && cur.HoldExpiresAt != nil && !cur.HoldExpiresAt.After(time.Now()) {
+		return nil, &IllegalTransitionError{ID: id, From: cur.Status, To: to, Reason: "hold expired"}
+	}
+	out, err := scanTransaction(tx.QueryRow(ctx, `
+		UPDATE transactions SET status = $3, hold_expires_at = NULL
+		WHERE id = $1 AND tenant_id = $2
+		RETURNING `+txColumns, id, tenant, to))
+	if err != nil {
+		return nil, err
+	}
+	if err := recordStatus(ctx, tx, out, cur.Status, reason, actor); err != nil {
+		return nil, err
+	}
+	if to == models.StatusCaptured {
+		if err := postTransaction(ctx, tx, out); err != nil {
+			return nil, err
+		}
+		if err := applyRenewal(ctx, tx, out); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// Capture charges an authorized hold. Holds placed by fraud screening are
+// decided through FraudReviewRepo instead. Unknown ids return
+// pgx.ErrNoRows.
+func (r *TransactionRepo) Capture(ctx context.Context, id, actor string) (*models.Transaction, error) {
+	var out *models.Transaction
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		if err := checkNotHeld(ctx, tx, tenant, id, models.StatusCaptured); err != nil {
+			return err
+		}
+		var err error
+		out, err = transition(ctx, tx, tenant, id, models.StatusCaptured, "captured", actor)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Void releases an authorized hold. Unknown ids return pgx.ErrNoRows.
+func (r *TransactionRepo) Void(ctx context.Context, id, reason, actor string) (*models.Transaction, error) {
+	var out *models.Transaction
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		if err := checkNotHeld(ctx, tx, tenant, id, models.StatusVoided); err != nil {
+			return err
+		}
+		var err error
+		if reason == "" {
+			reason = "voided"
+		}
+		out, err = transition(ctx, tx, tenant, id, models.StatusVoided, reason, actor)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// History returns a transaction's status changes, oldest first.
+func (r *TransactionRepo) History(ctx context.Context, id string) ([]models.StatusChange, error) {
+	var out []models.StatusChange
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		rows, err := tx.Query(ctx, `
+			SELECT id, transaction_id, from_status, to_status, reason, actor, changed_at
+			FROM transaction_status_history
+			WHERE tenant_id = $1 AND transaction_id = $2
+			ORDER BY id`, tenant, id)
+		if err != nil {
+			return err
+		}
+		out, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.StatusChange, error) {
+			var c models.StatusChange
+			err := row.Scan(&c.ID, &c.TransactionID, &c.From, &c.To, &c.Reason, &c.Actor, &c.ChangedAt)
+			return c, err
+		})
+		return err
+	})
+	return out, err
+}
+
+// VoidExpiredHolds voids authorized holds of every tenant whose hold
+// timeout has passed and returns how many were voided.
+func (p *Pool) VoidExpiredHolds(ctx context.Context) (int, error) {
+	var n int
+	err := p.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `
+			SELECT id::text, tenant_id FROM transactions
+			WHERE status = 'authorized' AND hold_expires_at <= now() AND deleted_at IS NULL
+			FOR UPDATE SKIP LOCKED`)
+		if err != nil {
+			return err
+		}
+		type hold struct{ id, tenant string }
+		holds, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (hold, error) {
+			var h hold
+			err := row.Scan(&h.id, &h.tenant)
+			return h, err
+		})
+		if err != nil {
+			return err
+		}
+		for _, h := range holds {
+			if _, err := transition(ctx, tx, h.tenant, h.id, models.StatusVoided, "hold expired", "system"); err != nil {
+				return err
+			}
+			n++
+		}
+		return nil
+	})
+	return n, err
+}
@@ -0,0 +1,98 @@
+package db
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+func TestCanTransition(t *testing.T) {
+	for _, c := range []struct {
+		from, to string
+		ok       bool
+	}{
+		{models.StatusPending, models.StatusAuthorized, true},
+		{models.StatusPending, models.StatusCaptured, true},
+		{models.StatusAuthorized, models.StatusCaptured, true},
+		{models.StatusAuthorized, models.StatusVoided, true},
+		{models.StatusCaptured, models.StatusVoided, false},
+		{models.StatusVoided, models.StatusCaptured, false},
+		{models.StatusAuthorized, models.StatusPending, false},
+	} {
+		if got := models.CanTransition(c.from, c.to); got != c.ok {
+			t.Errorf("CanTransition(%s, %s) = %v, want %v", c.from, c.to, got, c.ok)
+		}
+	}
+}
+
+func TestHoldLifecycle(t *testing.T) {
+	pool := testPool(t)
+	repo := NewTransactionRepo(pool)
+	ledger := NewLedgerRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "holds")
+
+	hold := func() *models.Transaction {
+		t.Helper()
+		tx, err := repo.Authorize(ctx, models.Transaction{
+			CoinID: "BTC", UserID: "alice", DataID: "d", CoinUsed: 2,
+			TransactionTimestamp: time.Now().UTC(), ExpiryDate: time.Now().UTC().Add(time.Hour), PlatformName: "p",
+		})
+		if err != nil {
+			t.Fatalf("authorize: %v", err)
+		}
+		if tx.Status != models.StatusAuthorized || tx.HoldExpiresAt == nil {
+			t.Fatalf("hold = %+v", tx)
+		}
+		return tx
+	}
+
+	captured := hold()
+	if bs, _ := ledger.Balances(ctx, models.UserAccount("alice")); len(bs) != 0 {
+		t.Fatalf("hold was posted to the ledger: %+v", bs)
+	}
+	out, err := repo.Capture(ctx, captured.ID, "ops")
+	if err != nil || out.Status != models.StatusCaptured || out.HoldExpiresAt != nil {
+		t.Fatalf("capture = %+v, err=%v", out, err)
+	}
+	if bs, _ := ledger.Balances(ctx, models.UserAccount("alice")); len(bs) != 1 || bs[0].Balance != 2 {
+		t.Fatalf("balance after capture = %+v", bs)
+	}
+	var illegal *IllegalTransitionError
+	if _, err := repo.Void(ctx, captured.ID, "", "ops"); !errors.As(err, &illegal) {
+		t.Fatalf("void after capture: err=%v, want IllegalTransitionError", err)
+	}
+
+	voided := hold()
+	if out, err := repo.Void(ctx, voided.ID, "cancelled", "ops"); err != nil || out.Status != models.StatusVoided {
+		t.Fatalf("void = %+v, err=%v", out, err)
+	}
+	if _, err := repo.Capture(ctx, voided.ID, "ops"); !errors.As(err, &illegal) {
+		t.Fatalf("capture after void: err=%v, want IllegalTransitionError", err)
+	}
+	hist, err := repo.History(ctx, voided.ID)
+	if err != nil || len(hist) != 2 ||
+		hist[0].From != models.StatusPending || hist[0].To != models.StatusAuthorized ||
+		hist[1].To != models.StatusVoided || hist[1].Reason != "cancelled" {
+		t.Fatalf("history = %+v, err=%v", hist, err)
+	}
+
+	expired := hold()
+	if err := pool.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, "UPDATE transactions SET hold_expires_at = now() - interval '1 second' WHERE id = $1", expired.ID)
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.Capture(ctx, expired.ID, "ops"); !errors.As(err, &illegal) {
+		t.Fatalf("capture of expired hold: err=%v, want IllegalTransitionError", err)
+	}
+	if n, err := pool.VoidExpiredHolds(ctx); err != nil || n < 1 {
+		t.Fatalf("VoidExpiredHolds = %d, err=%v", n, err)
+	}
+	if got, err := repo.GetByID(ctx, expired.ID, false); err != nil || got.Status != models.StatusVoided {
+		t.Fatalf("expired hold = %+v, err=%v", got, err)
+	}
+}
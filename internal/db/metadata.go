@@ -0,0 +1,58 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+)
+
+// Limits on the metadata and tags of a transaction.
+const (
+	MaxMetadataKeys     = 32
+	MaxMetadataKeyLen   = 64
+	MaxMetadataValueLen = 512
+	MaxTags             = 16
+	MaxTagLen           = 64
+)
+
+// ErrInvalidMetadata wraps metadata and tags that exceed the limits.
+var ErrInvalidMetadata = errors.New("invalid metadata")
+
+func invalidMetadata(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidMetadata, fmt.Sprintf(format, args...))
+}
+
+// prepareMetadata checks t's metadata and tags against the limits, sorts
+// and deduplicates the tags, and replaces nil values with empty ones.
+func prepareMetadata(t *models.Transaction) error {
+	if len(t.Metadata) > MaxMetadataKeys {
+		return invalidMetadata("at most %d metadata keys are allowed", MaxMetadataKeys)
+	}
+	for k, v := range t.Metadata {
+		switch {
+		case k == "" || len(k) > MaxMetadataKeyLen:
+			return invalidMetadata("metadata keys must be 1 to %d bytes", MaxMetadataKeyLen)
+		case len(v) > MaxMetadataValueLen:
+			return invalidMetadata("metadata value of %q exceeds %d bytes", k, MaxMetadataValueLen)
+		}
+	}
+	tags := slices.Compact(slices.Sorted(slices.Values(t.Tags)))
+	if len(tags) > MaxTags {
+		return invalidMetadata("at most %d tags are allowed", MaxTags)
+	}
+	for _, tag := range tags {
+		if tag == "" || len(tag) > MaxTagLen {
+			return invalidMetadata("tags must be 1 to %d bytes", MaxTagLen)
+		}
+	}
+	if t.Metadata == nil {
+		t.Metadata = map[string]string{}
+	}
+	if tags == nil {
+		tags = []string{}
+	}
+	t.Tags = tags
+	return nil
+}
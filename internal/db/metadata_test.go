@@ -0,0 +1,86 @@
+package db
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+)
+
+func TestPrepareMetadata(t *testing.T) {
+	tx := models.Transaction{Tags: []string{"promo", "mobile", "promo"}}
+	if err := prepareMetadata(&tx); err != nil {
+		t.Fatalf("valid metadata rejected: %v", err)
+	}
+	if tx.Metadata == nil || !slices.Equal(tx.Tags, []string{"mobile", "promo"}) {
+		t.Errorf("prepared = %+v", tx)
+	}
+
+	tooMany := map[string]string{}
+	for i := range MaxMetadataKeys + 1 {
+		tooMany[strings.Repeat("k", i+1)] = "v"
+	}
+	manyTags := make([]string, MaxTags+1)
+	for i := range manyTags {
+		manyTags[i] = strings.Repeat("t", i+1)
+	}
+	rejected := map[string]models.Transaction{
+		"too many keys": {Metadata: tooMany},
+		"empty key":     {Metadata: map[string]string{"": "v"}},
+		"long value":    {Metadata: map[string]string{"k": strings.Repeat("v", MaxMetadataValueLen+1)}},
+		"too many tags": {Tags: manyTags},
+		"empty tag":     {Tags: []string{""}},
+		"long tag":      {Tags: []string{strings.Repeat("t", MaxTagLen+1)}},
+	}
+	for name, tx := range rejected {
+		if err := prepareMetadata(&tx); !errors.Is(err, ErrInvalidMetadata) {
+			t.Errorf("%s: err = %v, want ErrInvalidMetadata", name, err)
+		}
+	}
+}
+
+func TestMetadataFilter(t *testing.T) {
+	pool := testPool(t)
+	repo := NewTransactionRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "metadata")
+
+	now := time.Now().UTC()
+	insert := func(dataID string, metadata map[string]string, tags ...string) *models.Transaction {
+		out, err := repo.Insert(ctx, models.Transaction{
+			CoinID: "BTC", UserID: "u", DataID: dataID, CoinUsed: 1,
+			TransactionTimestamp: now, ExpiryDate: now.Add(time.Hour), PlatformName: "p",
+			Metadata: metadata, Tags: tags,
+		})
+		if err != nil {
+			t.Fatalf("insert %s: %v", dataID, err)
+		}
+		return out
+	}
+	a := insert("a", map[string]string{"order": "o-1", "device": "ios"}, "promo", "mobile")
+	insert("b", map[string]string{"order": "o-2"}, "promo")
+	insert("c", nil)
+	if a.Metadata["order"] != "o-1" || !slices.Equal(a.Tags, []string{"mobile", "promo"}) {
+		t.Fatalf("stored = %+v", a)
+	}
+
+	cases := []struct {
+		name string
+		f    TransactionFilter
+		want int
+	}{
+		{"metadata key", TransactionFilter{Metadata: map[string]string{"order": "o-1"}}, 1},
+		{"tag", TransactionFilter{Tags: []string{"promo"}}, 2},
+		{"every tag", TransactionFilter{Tags: []string{"promo", "mobile"}}, 1},
+		{"no match", TransactionFilter{Metadata: map[string]string{"device": "android"}}, 0},
+		{"unfiltered", TransactionFilter{}, 3},
+	}
+	for _, c := range cases {
+		got, err := repo.List(ctx, c.f)
+		if err != nil || len(got) != c.want {
+			t.Errorf("%s: %d rows, err=%v; want %d", c.name, len(got), err, c.want)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+-- Baseline schema. Existing deployments already have this table; the
+-- IF NOT EXISTS keeps the migration a no-op for them.
+CREATE TABLE IF NOT EXISTS transactions (
+	id                   uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+	coinid               text NOT NULL,
+	userid               text NOT NULL,
+	dataid               text NOT NULL,
+	coinused             double precision NOT NULL,
+	transactionTimestamp timestamptz NOT NULL,
+	expiryDate           timestamptz NOT NULL,
+	platformName         text NOT NULL
+);
@@ -0,0 +1,6 @@
+-- Token buckets shared by every replica when RATE_LIMIT_BACKEND=postgres.
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+	key        text PRIMARY KEY,
+	tokens     double precision NOT NULL,
+	updated_at timestamptz NOT NULL DEFAULT now()
+);
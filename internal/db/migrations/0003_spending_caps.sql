@@ -0,0 +1,15 @@
+-- Per-user spending limits over rolling windows. Empty coinid/platformName
+-- mean "any", which keeps the uniqueness constraint simple.
+CREATE TABLE IF NOT EXISTS spending_caps (
+	id           uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+	userid       text NOT NULL,
+	coinid       text NOT NULL DEFAULT '',
+	platformName text NOT NULL DEFAULT '',
+	period       text NOT NULL CHECK (period IN ('daily', 'monthly')),
+	amount       double precision NOT NULL CHECK (amount >= 0),
+	updated_at   timestamptz NOT NULL DEFAULT now(),
+	UNIQUE (userid, coinid, platformName, period)
+);
+
+CREATE INDEX IF NOT EXISTS transactions_userid_ts_idx
+	ON transactions (userid, transactionTimestamp);
@@ -0,0 +1,31 @@
+-- Append-only audit trail. Each row's hash covers its content and the
+-- previous row's hash, so edits or deletions break the chain.
+CREATE TABLE IF NOT EXISTS audit_log (
+	seq         bigserial PRIMARY KEY,
+	occurred_at timestamptz NOT NULL,
+	actor       text NOT NULL,
+	admin       boolean NOT NULL,
+	transport   text NOT NULL,
+	operation   text NOT NULL,
+	args        text NOT NULL,
+	result_ids  text[] NOT NULL,
+	outcome     text NOT NULL,
+	client_ip   text NOT NULL,
+	request_id  text NOT NULL,
+	prev_hash   text NOT NULL,
+	hash        text NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_result_ids_idx ON audit_log USING gin (result_ids);
+CREATE INDEX IF NOT EXISTS audit_log_actor_idx ON audit_log (actor, occurred_at);
+
+CREATE OR REPLACE FUNCTION audit_log_immutable() RETURNS trigger AS $$
+BEGIN
+	RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS audit_log_immutable ON audit_log;
+CREATE TRIGGER audit_log_immutable
+	BEFORE UPDATE OR DELETE ON audit_log
+	FOR EACH ROW EXECUTE FUNCTION audit_log_immutable();
@@ -0,0 +1,38 @@
+-- First-class tenants. Existing rows belong to the "default" tenant.
+CREATE TABLE IF NOT EXISTS tenants (
+	id                   text PRIMARY KEY,
+	name                 text NOT NULL,
+	api_key_hash         text UNIQUE,
+	ip_rate_per_minute   integer NOT NULL DEFAULT 0, -- 0 inherits the global limit
+	user_rate_per_minute integer NOT NULL DEFAULT 0,
+	retention_days       integer NOT NULL DEFAULT 0, -- 0 keeps history forever
+	created_at           timestamptz NOT NULL DEFAULT now()
+);
+
+INSERT INTO tenants (id, name) VALUES ('default', 'Default tenant') ON CONFLICT (id) DO NOTHING;
+
+ALTER TABLE transactions
+	ADD COLUMN IF NOT EXISTS tenant_id text NOT NULL DEFAULT 'default' REFERENCES tenants (id);
+CREATE INDEX IF NOT EXISTS transactions_tenant_ts_idx ON transactions (tenant_id, transactionTimestamp);
+
+ALTER TABLE spending_caps
+	ADD COLUMN IF NOT EXISTS tenant_id text NOT NULL DEFAULT 'default' REFERENCES tenants (id);
+ALTER TABLE spending_caps DROP CONSTRAINT IF EXISTS spending_caps_userid_coinid_platformname_period_key;
+ALTER TABLE spending_caps ADD CONSTRAINT spending_caps_scope_key
+	UNIQUE (tenant_id, userid, coinid, platformName, period);
+
+-- Row-level security backs up the explicit tenant_id predicates in the
+-- repositories. It is only enforced for roles that do not own the tables
+-- (or after ALTER TABLE ... FORCE ROW LEVEL SECURITY). Every repository
+-- transaction sets app.tenant_id; '*' is reserved for maintenance jobs.
+ALTER TABLE transactions ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON transactions;
+CREATE POLICY tenant_isolation ON transactions
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
+
+ALTER TABLE spending_caps ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON spending_caps;
+CREATE POLICY tenant_isolation ON spending_caps
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
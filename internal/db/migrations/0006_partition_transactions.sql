@@ -0,0 +1,51 @@
+-- Convert transactions into a table range-partitioned by month on
+-- transactionTimestamp. The primary key must include the partition key.
+-- Large deployments should run this in a maintenance window: the copy runs
+-- inside the migration transaction.
+ALTER TABLE transactions RENAME TO transactions_legacy;
+
+CREATE TABLE transactions (LIKE transactions_legacy INCLUDING DEFAULTS)
+	PARTITION BY RANGE (transactionTimestamp);
+ALTER TABLE transactions ADD PRIMARY KEY (id, transactionTimestamp);
+ALTER TABLE transactions ADD FOREIGN KEY (tenant_id) REFERENCES tenants (id);
+
+-- Catches rows outside every monthly partition (e.g. far past or future
+-- timestamps). The maintenance job moves rows out of it when it creates
+-- the matching partition.
+CREATE TABLE transactions_default PARTITION OF transactions DEFAULT;
+
+-- Monthly partitions (UTC) covering existing data, at most five years back,
+-- and three months ahead.
+DO $$
+DECLARE
+	m    date;
+	stop date;
+BEGIN
+	SELECT date_trunc('month', GREATEST(COALESCE(min(transactionTimestamp), now()), now() - interval '5 years') AT TIME ZONE 'UTC')::date,
+	       (date_trunc('month', GREATEST(COALESCE(max(transactionTimestamp), now()), now()) AT TIME ZONE 'UTC') + interval '4 months')::date
+	INTO m, stop
+	FROM transactions_legacy;
+
+	WHILE m < stop LOOP
+		EXECUTE format(
+			'CREATE TABLE %I PARTITION OF transactions FOR VALUES FROM (%L) TO (%L)',
+			'transactions_p' || to_char(m, 'YYYYMM'),
+			to_char(m, 'YYYY-MM-DD') || ' 00:00:00+00',
+			to_char(m + interval '1 month', 'YYYY-MM-DD') || ' 00:00:00+00');
+		m := (m + interval '1 month')::date;
+	END LOOP;
+END $$;
+
+INSERT INTO transactions SELECT * FROM transactions_legacy;
+DROP TABLE transactions_legacy;
+
+CREATE INDEX IF NOT EXISTS transactions_userid_ts_idx ON transactions (userid, transactionTimestamp);
+CREATE INDEX IF NOT EXISTS transactions_tenant_ts_idx ON transactions (tenant_id, transactionTimestamp);
+
+ALTER TABLE transactions ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON transactions
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
+
+-- Detached partitions kept for archival live here.
+CREATE SCHEMA IF NOT EXISTS archive;
@@ -0,0 +1,16 @@
+-- Cold archival: files written by the archive job and where each archived
+-- transaction went, so single-row lookups can still find it.
+CREATE TABLE IF NOT EXISTS archive_files (
+	name       text PRIMARY KEY,
+	row_count  integer NOT NULL,
+	sha256     text NOT NULL,
+	from_ts    timestamptz NOT NULL,
+	to_ts      timestamptz NOT NULL,
+	created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS archive_index (
+	id        uuid PRIMARY KEY,
+	tenant_id text NOT NULL,
+	file      text NOT NULL REFERENCES archive_files (name)
+);
@@ -0,0 +1,17 @@
+-- Soft delete: hidden rows stay for accounting but leave List/GetByID and
+-- spending totals unless an admin asks for them.
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+
+-- Proof that a user's identity was removed. subject_hash lets a repeated
+-- request be matched without keeping the userid itself.
+CREATE TABLE IF NOT EXISTS erasure_receipts (
+	id            uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+	tenant_id     text NOT NULL REFERENCES tenants (id),
+	pseudonym     text NOT NULL,
+	subject_hash  text NOT NULL,
+	transactions  bigint NOT NULL,
+	spending_caps bigint NOT NULL,
+	requested_by  text NOT NULL DEFAULT '',
+	erased_at     timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS erasure_receipts_subject_idx ON erasure_receipts (tenant_id, subject_hash);
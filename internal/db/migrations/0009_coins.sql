@@ -0,0 +1,25 @@
+-- Coin catalog. Transactions may only use active coins of their tenant.
+CREATE TABLE IF NOT EXISTS coins (
+	tenant_id  text NOT NULL REFERENCES tenants (id),
+	id         text NOT NULL,
+	name       text NOT NULL,
+	precision  smallint NOT NULL DEFAULT 8 CHECK (precision BETWEEN 0 AND 18), -- decimal places allowed in coinused
+	active     boolean NOT NULL DEFAULT true,
+	min_spend  double precision NOT NULL DEFAULT 0,
+	max_spend  double precision NOT NULL DEFAULT 0, -- 0 means no maximum
+	created_at timestamptz NOT NULL DEFAULT now(),
+	updated_at timestamptz NOT NULL DEFAULT now(),
+	PRIMARY KEY (tenant_id, id)
+);
+
+-- Register every coin already in use so existing clients keep working;
+-- admins can deactivate the typos afterwards.
+INSERT INTO coins (tenant_id, id, name)
+SELECT DISTINCT tenant_id, coinid, coinid FROM transactions
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE coins ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON coins;
+CREATE POLICY tenant_isolation ON coins
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
@@ -0,0 +1,26 @@
+-- Platform registry. Transactions may only be created on enabled platforms
+-- of their tenant.
+CREATE TABLE IF NOT EXISTS platforms (
+	tenant_id              text NOT NULL REFERENCES tenants (id),
+	name                   text NOT NULL,
+	display_name           text NOT NULL,
+	owner_contact          text NOT NULL DEFAULT '',
+	allowed_coins          text[] NOT NULL DEFAULT '{}', -- empty allows every coin
+	default_access_seconds bigint NOT NULL DEFAULT 0 CHECK (default_access_seconds >= 0), -- 0: clients must send expiryDate
+	webhook_url            text NOT NULL DEFAULT '',
+	enabled                boolean NOT NULL DEFAULT true,
+	created_at             timestamptz NOT NULL DEFAULT now(),
+	updated_at             timestamptz NOT NULL DEFAULT now(),
+	PRIMARY KEY (tenant_id, name)
+);
+
+-- Register every platform already in use so existing clients keep working.
+INSERT INTO platforms (tenant_id, name, display_name)
+SELECT DISTINCT tenant_id, platformName, platformName FROM transactions
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE platforms ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON platforms;
+CREATE POLICY tenant_isolation ON platforms
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
@@ -0,0 +1,36 @@
+-- Time-versioned conversion rates: 1 base_coin = rate quote_coin from
+-- effective_at until the pair's next entry.
+CREATE TABLE IF NOT EXISTS exchange_rates (
+	tenant_id    text NOT NULL REFERENCES tenants (id),
+	base_coin    text NOT NULL,
+	quote_coin   text NOT NULL,
+	rate         double precision NOT NULL CHECK (rate > 0),
+	effective_at timestamptz NOT NULL,
+	created_at   timestamptz NOT NULL DEFAULT now(),
+	PRIMARY KEY (tenant_id, base_coin, quote_coin, effective_at),
+	CHECK (base_coin <> quote_coin)
+);
+
+ALTER TABLE exchange_rates ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON exchange_rates;
+CREATE POLICY tenant_isolation ON exchange_rates
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
+
+-- exchange_rate returns how many p_to one p_from was worth at p_at, using
+-- the latest direct or inverse entry effective by then; NULL if none.
+CREATE OR REPLACE FUNCTION exchange_rate(p_tenant text, p_from text, p_to text, p_at timestamptz)
+RETURNS double precision
+LANGUAGE sql STABLE AS $$
+	SELECT CASE WHEN p_from = p_to THEN 1.0 ELSE (
+		SELECT r FROM (
+			SELECT rate AS r, effective_at FROM exchange_rates
+			WHERE tenant_id = p_tenant AND base_coin = p_from AND quote_coin = p_to AND effective_at <= p_at
+			UNION ALL
+			SELECT 1 / rate, effective_at FROM exchange_rates
+			WHERE tenant_id = p_tenant AND base_coin = p_to AND quote_coin = p_from AND effective_at <= p_at
+		) candidates
+		ORDER BY effective_at DESC
+		LIMIT 1)
+	END
+$$;
@@ -0,0 +1,82 @@
+-- Double-entry journal. Every transaction gets one entry with a debit on
+-- the user's wallet account and a matching credit on the platform's
+-- revenue account. Debits are positive, credits negative.
+CREATE TABLE IF NOT EXISTS journal_entries (
+	id             uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+	tenant_id      text NOT NULL REFERENCES tenants (id),
+	transaction_id uuid NOT NULL,
+	transaction_ts timestamptz NOT NULL,
+	created_at     timestamptz NOT NULL DEFAULT now(),
+	UNIQUE (transaction_id)
+);
+
+CREATE TABLE IF NOT EXISTS ledger_postings (
+	id         bigserial PRIMARY KEY,
+	entry_id   uuid NOT NULL REFERENCES journal_entries (id),
+	tenant_id  text NOT NULL REFERENCES tenants (id),
+	account    text NOT NULL, -- user:<userid> or platform:<platformName>
+	coinid     text NOT NULL,
+	amount     numeric NOT NULL CHECK (amount <> 0),
+	posted_at  timestamptz NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS ledger_postings_entry_idx ON ledger_postings (entry_id);
+CREATE INDEX IF NOT EXISTS ledger_postings_account_idx ON ledger_postings (tenant_id, account, coinid, posted_at, id);
+
+-- Every entry must sum to zero per coin once its transaction commits.
+CREATE OR REPLACE FUNCTION ledger_entry_balanced() RETURNS trigger AS $$
+DECLARE
+	entry uuid;
+	bad   text;
+BEGIN
+	IF TG_OP = 'DELETE' THEN
+		entry := OLD.entry_id;
+	ELSE
+		entry := NEW.entry_id;
+	END IF;
+	SELECT coinid INTO bad
+	FROM ledger_postings
+	WHERE entry_id = entry
+	GROUP BY coinid
+	HAVING sum(amount) <> 0
+	LIMIT 1;
+	IF FOUND THEN
+		RAISE EXCEPTION 'journal entry % does not balance for coin %', entry, bad;
+	END IF;
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS ledger_entry_balanced ON ledger_postings;
+CREATE CONSTRAINT TRIGGER ledger_entry_balanced
+	AFTER INSERT OR UPDATE OR DELETE ON ledger_postings
+	DEFERRABLE INITIALLY DEFERRED
+	FOR EACH ROW EXECUTE FUNCTION ledger_entry_balanced();
+
+-- Backfill existing transactions.
+INSERT INTO journal_entries (tenant_id, transaction_id, transaction_ts)
+SELECT tenant_id, id, transactionTimestamp FROM transactions
+ON CONFLICT (transaction_id) DO NOTHING;
+
+INSERT INTO ledger_postings (entry_id, tenant_id, account, coinid, amount, posted_at)
+SELECT e.id, t.tenant_id, p.account, t.coinid, p.amount, t.transactionTimestamp
+FROM transactions t
+JOIN journal_entries e ON e.transaction_id = t.id
+CROSS JOIN LATERAL (VALUES
+	('user:' || t.userid, t.coinused::numeric),
+	('platform:' || t.platformName, -t.coinused::numeric)
+) AS p (account, amount)
+WHERE t.coinused <> 0
+  AND NOT EXISTS (SELECT 1 FROM ledger_postings lp WHERE lp.entry_id = e.id);
+
+ALTER TABLE journal_entries ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON journal_entries;
+CREATE POLICY tenant_isolation ON journal_entries
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
+
+ALTER TABLE ledger_postings ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON ledger_postings;
+CREATE POLICY tenant_isolation ON ledger_postings
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
@@ -0,0 +1,51 @@
+-- Reconciliation of platform settlement files. Each run keeps every item
+-- so discrepancies can be tracked until resolved.
+CREATE TABLE IF NOT EXISTS reconciliation_runs (
+	id                     uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+	tenant_id              text NOT NULL REFERENCES tenants (id),
+	platformName           text NOT NULL,
+	file_name              text NOT NULL DEFAULT '',
+	period_from            timestamptz NOT NULL,
+	period_to              timestamptz NOT NULL,
+	amount_tolerance       double precision NOT NULL,
+	time_tolerance_seconds bigint NOT NULL,
+	matched                integer NOT NULL,
+	missing_ours           integer NOT NULL,
+	missing_theirs         integer NOT NULL,
+	amount_mismatches      integer NOT NULL,
+	created_by             text NOT NULL DEFAULT '',
+	created_at             timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS reconciliation_runs_platform_idx ON reconciliation_runs (tenant_id, platformName, created_at);
+
+CREATE TABLE IF NOT EXISTS reconciliation_items (
+	id              bigserial PRIMARY KEY,
+	run_id          uuid NOT NULL REFERENCES reconciliation_runs (id),
+	tenant_id       text NOT NULL REFERENCES tenants (id),
+	kind            text NOT NULL CHECK (kind IN ('matched', 'missing_ours', 'missing_theirs', 'amount_mismatch')),
+	status          text NOT NULL CHECK (status IN ('open', 'resolved')),
+	dataid          text NOT NULL,
+	userid          text NOT NULL,
+	their_amount    double precision,
+	our_amount      double precision,
+	their_timestamp timestamptz,
+	our_timestamp   timestamptz,
+	transaction_id  uuid,
+	resolution      text NOT NULL DEFAULT '',
+	resolved_by     text NOT NULL DEFAULT '',
+	resolved_at     timestamptz
+);
+CREATE INDEX IF NOT EXISTS reconciliation_items_run_idx ON reconciliation_items (run_id, kind);
+CREATE INDEX IF NOT EXISTS reconciliation_items_open_idx ON reconciliation_items (tenant_id, status) WHERE status = 'open';
+
+ALTER TABLE reconciliation_runs ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON reconciliation_runs;
+CREATE POLICY tenant_isolation ON reconciliation_runs
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
+
+ALTER TABLE reconciliation_items ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON reconciliation_items;
+CREATE POLICY tenant_isolation ON reconciliation_items
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
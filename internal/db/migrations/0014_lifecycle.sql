@@ -0,0 +1,24 @@
+-- Transaction lifecycle: holds are authorized first and captured or voided
+-- later. Existing transactions were final when inserted.
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS status text NOT NULL DEFAULT 'captured'
+	CHECK (status IN ('pending', 'authorized', 'captured', 'voided'));
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS hold_expires_at timestamptz;
+CREATE INDEX IF NOT EXISTS transactions_open_holds_idx ON transactions (hold_expires_at) WHERE status = 'authorized';
+
+CREATE TABLE IF NOT EXISTS transaction_status_history (
+	id             bigserial PRIMARY KEY,
+	tenant_id      text NOT NULL REFERENCES tenants (id),
+	transaction_id uuid NOT NULL,
+	from_status    text NOT NULL, -- 'pending' when the transaction was created
+	to_status      text NOT NULL,
+	reason         text NOT NULL DEFAULT '',
+	actor          text NOT NULL DEFAULT '',
+	changed_at     timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS transaction_status_history_tx_idx ON transaction_status_history (transaction_id, id);
+
+ALTER TABLE transaction_status_history ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON transaction_status_history;
+CREATE POLICY tenant_isolation ON transaction_status_history
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
@@ -0,0 +1,5 @@
+-- Access renewals. A renewal is a charge linked to the original purchase
+-- of the entitlement, whose expiryDate is moved to the renewed expiry.
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS renews_id uuid;
+CREATE INDEX IF NOT EXISTS transactions_renews_idx ON transactions (renews_id) WHERE renews_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS transactions_entitlement_idx ON transactions (tenant_id, userid, dataid, platformName) WHERE renews_id IS NULL;
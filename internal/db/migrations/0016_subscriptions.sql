@@ -0,0 +1,64 @@
+-- Recurring charges. Due subscriptions are charged by the worker through
+-- the normal insert path; events are kept for webhook delivery.
+CREATE TABLE IF NOT EXISTS subscription_plans (
+	id           uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+	tenant_id    text NOT NULL REFERENCES tenants (id),
+	name         text NOT NULL,
+	platformName text NOT NULL,
+	dataid       text NOT NULL,
+	coinid       text NOT NULL,
+	amount       double precision NOT NULL CHECK (amount >= 0),
+	period       text NOT NULL CHECK (period IN ('daily', 'weekly', 'monthly', 'yearly')),
+	active       boolean NOT NULL DEFAULT true,
+	created_at   timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS subscriptions (
+	id              uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+	tenant_id       text NOT NULL REFERENCES tenants (id),
+	plan_id         uuid NOT NULL REFERENCES subscription_plans (id),
+	userid          text NOT NULL,
+	status          text NOT NULL CHECK (status IN ('active', 'past_due', 'paused', 'cancelled', 'lapsed')),
+	started_at      timestamptz NOT NULL,
+	cycle           integer NOT NULL DEFAULT 0,
+	next_charge_at  timestamptz NOT NULL,
+	failed_attempts integer NOT NULL DEFAULT 0,
+	grace_until     timestamptz,
+	last_error      text NOT NULL DEFAULT '',
+	created_at      timestamptz NOT NULL DEFAULT now(),
+	updated_at      timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS subscriptions_due_idx ON subscriptions (next_charge_at) WHERE status IN ('active', 'past_due');
+CREATE INDEX IF NOT EXISTS subscriptions_user_idx ON subscriptions (tenant_id, userid);
+
+CREATE TABLE IF NOT EXISTS subscription_events (
+	id                bigserial PRIMARY KEY,
+	tenant_id         text NOT NULL REFERENCES tenants (id),
+	subscription_id   uuid NOT NULL REFERENCES subscriptions (id),
+	type              text NOT NULL,
+	transaction_id    uuid,
+	detail            text NOT NULL DEFAULT '',
+	created_at        timestamptz NOT NULL DEFAULT now(),
+	delivered_at      timestamptz,
+	delivery_attempts integer NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS subscription_events_sub_idx ON subscription_events (subscription_id, id);
+CREATE INDEX IF NOT EXISTS subscription_events_undelivered_idx ON subscription_events (id) WHERE delivered_at IS NULL;
+
+ALTER TABLE subscription_plans ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON subscription_plans;
+CREATE POLICY tenant_isolation ON subscription_plans
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
+
+ALTER TABLE subscriptions ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON subscriptions;
+CREATE POLICY tenant_isolation ON subscriptions
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
+
+ALTER TABLE subscription_events ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON subscription_events;
+CREATE POLICY tenant_isolation ON subscription_events
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
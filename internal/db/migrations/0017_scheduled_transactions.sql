@@ -0,0 +1,27 @@
+-- Spends queued for a future time, executed by the worker through the
+-- normal insert path.
+CREATE TABLE IF NOT EXISTS scheduled_transactions (
+	id             uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+	tenant_id      text NOT NULL REFERENCES tenants (id),
+	coinid         text NOT NULL,
+	userid         text NOT NULL,
+	dataid         text NOT NULL,
+	coinused       double precision NOT NULL CHECK (coinused >= 0),
+	expiryDate     timestamptz,
+	platformName   text NOT NULL,
+	execute_at     timestamptz NOT NULL,
+	status         text NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'executed', 'failed', 'cancelled')),
+	transaction_id uuid,
+	error          text NOT NULL DEFAULT '',
+	created_by     text NOT NULL DEFAULT '',
+	created_at     timestamptz NOT NULL DEFAULT now(),
+	updated_at     timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS scheduled_transactions_due_idx ON scheduled_transactions (execute_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS scheduled_transactions_user_idx ON scheduled_transactions (tenant_id, userid, execute_at);
+
+ALTER TABLE scheduled_transactions ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON scheduled_transactions;
+CREATE POLICY tenant_isolation ON scheduled_transactions
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
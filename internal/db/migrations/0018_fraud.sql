@@ -0,0 +1,40 @@
+-- Fraud screening: the origin of every screened transaction, and the
+-- review queue of flagged and held ones.
+CREATE TABLE IF NOT EXISTS transaction_signals (
+	transaction_id uuid PRIMARY KEY,
+	tenant_id      text NOT NULL REFERENCES tenants (id),
+	userid         text NOT NULL,
+	platformName   text NOT NULL,
+	ip             text NOT NULL,
+	created_at     timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS transaction_signals_ip_idx ON transaction_signals (tenant_id, ip, created_at);
+
+CREATE TABLE IF NOT EXISTS fraud_reviews (
+	id             bigserial PRIMARY KEY,
+	tenant_id      text NOT NULL REFERENCES tenants (id),
+	transaction_id uuid NOT NULL UNIQUE,
+	userid         text NOT NULL,
+	platformName   text NOT NULL,
+	coinused       double precision NOT NULL,
+	action         text NOT NULL CHECK (action IN ('flag', 'hold')),
+	findings       jsonb NOT NULL,
+	status         text NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'approved', 'denied')),
+	reviewed_by    text NOT NULL DEFAULT '',
+	note           text NOT NULL DEFAULT '',
+	created_at     timestamptz NOT NULL DEFAULT now(),
+	reviewed_at    timestamptz
+);
+CREATE INDEX IF NOT EXISTS fraud_reviews_status_idx ON fraud_reviews (tenant_id, status, id);
+
+ALTER TABLE transaction_signals ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON transaction_signals;
+CREATE POLICY tenant_isolation ON transaction_signals
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
+
+ALTER TABLE fraud_reviews ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON fraud_reviews;
+CREATE POLICY tenant_isolation ON fraud_reviews
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
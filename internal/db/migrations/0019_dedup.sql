@@ -0,0 +1,6 @@
+-- Per-platform duplicate detection. A create matching a live transaction
+-- of the same user, data, coin and amount within the window either
+-- returns that transaction or is rejected.
+ALTER TABLE platforms
+	ADD COLUMN IF NOT EXISTS dedup_window_seconds bigint NOT NULL DEFAULT 0 CHECK (dedup_window_seconds >= 0), -- 0 disables
+	ADD COLUMN IF NOT EXISTS dedup_action text NOT NULL DEFAULT 'return' CHECK (dedup_action IN ('return', 'reject'));
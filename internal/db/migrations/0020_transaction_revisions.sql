@@ -0,0 +1,34 @@
+-- Operator edits of transactions. version is bumped by every update of a
+-- row, so an edit based on a stale read can be detected; each edit is
+-- recorded as a revision.
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
+
+CREATE OR REPLACE FUNCTION transactions_bump_version() RETURNS trigger AS $$
+BEGIN
+	NEW.version := OLD.version + 1;
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS transactions_bump_version ON transactions;
+CREATE TRIGGER transactions_bump_version
+	BEFORE UPDATE ON transactions
+	FOR EACH ROW EXECUTE FUNCTION transactions_bump_version();
+
+CREATE TABLE IF NOT EXISTS transaction_revisions (
+	id             bigserial PRIMARY KEY,
+	tenant_id      text NOT NULL REFERENCES tenants (id),
+	transaction_id uuid NOT NULL,
+	version        bigint NOT NULL, -- version the edit produced
+	changes        jsonb NOT NULL,  -- [{field, from, to}]
+	reason         text NOT NULL DEFAULT '',
+	actor          text NOT NULL DEFAULT '',
+	changed_at     timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS transaction_revisions_tx_idx ON transaction_revisions (transaction_id, id);
+
+ALTER TABLE transaction_revisions ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON transaction_revisions;
+CREATE POLICY tenant_isolation ON transaction_revisions
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
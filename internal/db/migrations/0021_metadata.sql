@@ -0,0 +1,6 @@
+-- Caller-defined context on transactions: a string map and a set of tags,
+-- both indexed for containment filters.
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS metadata jsonb NOT NULL DEFAULT '{}';
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS tags text[] NOT NULL DEFAULT '{}';
+CREATE INDEX IF NOT EXISTS transactions_metadata_idx ON transactions USING gin (metadata jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS transactions_tags_idx ON transactions USING gin (tags);
@@ -0,0 +1,15 @@
+-- Server-side insertion time. Spending caps are windowed on it rather than
+-- on the client-supplied transactionTimestamp, which can be backdated out
+-- of every window.
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS created_at timestamptz;
+
+-- Backfill without bumping every row's version.
+DROP TRIGGER IF EXISTS transactions_bump_version ON transactions;
+UPDATE transactions SET created_at = LEAST(transactionTimestamp, now()) WHERE created_at IS NULL;
+CREATE TRIGGER transactions_bump_version
+	BEFORE UPDATE ON transactions
+	FOR EACH ROW EXECUTE FUNCTION transactions_bump_version();
+
+ALTER TABLE transactions ALTER COLUMN created_at SET DEFAULT now();
+ALTER TABLE transactions ALTER COLUMN created_at SET NOT NULL;
+CREATE INDEX IF NOT EXISTS transactions_user_created_idx ON transactions (tenant_id, userid, created_at);
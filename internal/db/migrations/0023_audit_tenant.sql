@@ -0,0 +1,11 @@
+-- Audit events belong to a tenant, and each tenant has its own hash chain
+-- so appends only serialize within a tenant. Earlier rows form the chain
+-- of tenant ''.
+ALTER TABLE audit_log ADD COLUMN IF NOT EXISTS tenant_id text NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS audit_log_tenant_seq_idx ON audit_log (tenant_id, seq);
+
+-- TRUNCATE bypasses row triggers.
+DROP TRIGGER IF EXISTS audit_log_no_truncate ON audit_log;
+CREATE TRIGGER audit_log_no_truncate
+	BEFORE TRUNCATE ON audit_log
+	FOR EACH STATEMENT EXECUTE FUNCTION audit_log_immutable();
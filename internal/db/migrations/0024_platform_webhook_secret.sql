@@ -0,0 +1,6 @@
+-- Per-platform key signing webhook deliveries. Existing platforms get a
+-- random one; gen_random_uuid draws from a strong random source.
+ALTER TABLE platforms ADD COLUMN IF NOT EXISTS webhook_secret text NOT NULL DEFAULT '';
+UPDATE platforms
+SET webhook_secret = 'whsec_' || replace(gen_random_uuid()::text, '-', '') || replace(gen_random_uuid()::text, '-', '')
+WHERE webhook_secret = '';
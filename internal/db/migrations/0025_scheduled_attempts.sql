@@ -0,0 +1,3 @@
+-- Failed executions of a scheduled spend that were not rejections, so a
+-- spend that keeps failing is given up on instead of blocking the queue.
+ALTER TABLE scheduled_transactions ADD COLUMN IF NOT EXISTS attempts integer NOT NULL DEFAULT 0;
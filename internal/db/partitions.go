@@ -0,0 +1,188 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Retention modes for partitions older than the retention window.
+const (
+	RetentionDetach = "detach" // move to the archive schema as a standalone table
+	RetentionDrop   = "drop"
+)
+
+// PartitionManager maintains the monthly partitions of transactions.
+type PartitionManager struct {
+	pool *Pool
+}
+
+func NewPartitionManager(pool *Pool) *PartitionManager {
+	return &PartitionManager{pool: pool}
+}
+
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// PartitionName returns the partition holding month m.
+func PartitionName(m time.Time) string {
+	return "transactions_p" + monthStart(m).Format("200601")
+}
+
+// MaintenanceTx runs fn in a transaction with row-level security opened to
+// all tenants. It is for background jobs, never for request handling.
+func (p *Pool) MaintenanceTx(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	return p.tenantTx(WithTenant(ctx, &allTenants), func(tx pgx.Tx, _ string) error {
+		return fn(tx)
+	})
+}
+
+// EnsureFuture creates partitions from the current month through
+// monthsAhead months in the future.
+func (m *PartitionManager) EnsureFuture(ctx context.Context, monthsAhead int) error {
+	start := monthStart(time.Now())
+	for i := 0; i <= monthsAhead; i++ {
+		if err := m.Ensure(ctx, start.AddDate(0, i, 0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ensure creates the partition for the month containing t if it is
+// missing. Rows that landed in the default partition for that month are
+// moved into the new partition before it is attached, which Postgres
+// requires.
+func (m *PartitionManager) Ensure(ctx context.Context, t time.Time) error {
+	from := monthStart(t)
+	to := from.AddDate(0, 1, 0)
+	name := PartitionName(from)
+
+	return m.pool.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+		var exists bool
+		if err := tx.QueryRow(ctx, "SELECT to_regclass($1) IS NOT NULL", name).Scan(&exists); err != nil {
+			return err
+		}
+		if exists {
+			return nil
+		}
+		ident := pgx.Identifier{name}.Sanitize()
+		stmts := []string{
+			fmt.Sprintf("CREATE TABLE %s (LIKE transactions INCLUDING DEFAULTS)", ident),
+			fmt.Sprintf(`WITH moved AS (
+				DELETE FROM transactions_default
+				WHERE transactionTimestamp >= '%s' AND transactionTimestamp < '%s'
+				RETURNING *)
+			INSERT INTO %s SELECT * FROM moved`, from.Format(time.RFC3339), to.Format(time.RFC3339), ident),
+			fmt.Sprintf("ALTER TABLE transactions ATTACH PARTITION %s FOR VALUES FROM ('%s') TO ('%s')",
+				ident, from.Format(time.RFC3339), to.Format(time.RFC3339)),
+		}
+		for _, s := range stmts {
+			if _, err := tx.Exec(ctx, s); err != nil {
+				return fmt.Errorf("create partition %s: %w", name, err)
+			}
+		}
+		log.Printf("partitions: created %s", name)
+		return nil
+	})
+}
+
+// Partition describes one monthly partition.
+type Partition struct {
+	Name  string
+	Month time.Time
+}
+
+// List returns the attached monthly partitions, oldest first.
+func (m *PartitionManager) List(ctx context.Context) ([]Partition, error) {
+	rows, err := m.pool.Query(ctx, `
+		SELECT c.relname
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = 'transactions'::regclass
+		  AND c.relname ~ '^transactions_p[0-9]{6}$'
+		ORDER BY c.relname`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []Partition
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		month, err := time.Parse("200601", name[len("transactions_p"):])
+		if err != nil {
+			continue
+		}
+		out = append(out, Partition{Name: name, Month: month})
+	}
+	return out, rows.Err()
+}
+
+// ApplyRetention detaches partitions whose whole month is older than
+// keepMonths full months, then drops them or moves them to the archive
+// schema depending on mode. keepMonths <= 0 disables retention.
+func (m *PartitionManager) ApplyRetention(ctx context.Context, keepMonths int, mode string) ([]string, error) {
+	if keepMonths <= 0 {
+		return nil, nil
+	}
+	if mode != RetentionDetach && mode != RetentionDrop {
+		return nil, fmt.Errorf("unknown retention mode %q", mode)
+	}
+	cutoff := monthStart(time.Now()).AddDate(0, -keepMonths, 0)
+	parts, err := m.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var done []string
+	for _, p := range parts {
+		if !p.Month.Before(cutoff) {
+			break
+		}
+		ident := pgx.Identifier{p.Name}.Sanitize()
+		err := m.pool.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+			if _, err := tx.Exec(ctx, "ALTER TABLE transactions DETACH PARTITION "+ident); err != nil {
+				return err
+			}
+			var stmt string
+			if mode == RetentionDrop {
+				stmt = "DROP TABLE " + ident
+			} else {
+				stmt = "ALTER TABLE " + ident + " SET SCHEMA archive"
+			}
+			_, err := tx.Exec(ctx, stmt)
+			return err
+		})
+		if err != nil {
+			return done, fmt.Errorf("retire partition %s: %w", p.Name, err)
+		}
+		log.Printf("partitions: %s %s", mode, p.Name)
+		done = append(done, p.Name)
+	}
+	return done, nil
+}
+
+// ApplyTenantRetention deletes rows older than each tenant's own
+// retention_days. Partitions are shared between tenants, so this works row
+// by row; the time predicate still limits it to the oldest partitions.
+func (m *PartitionManager) ApplyTenantRetention(ctx context.Context) (int64, error) {
+	var total int64
+	err := m.pool.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, `
+			DELETE FROM transactions t
+			USING tenants tn
+			WHERE t.tenant_id = tn.id
+			  AND tn.retention_days > 0
+			  AND t.transactionTimestamp < now() - make_interval(days => tn.retention_days)`)
+		total = tag.RowsAffected()
+		return err
+	})
+	return total, err
+}
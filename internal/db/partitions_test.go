@@ -0,0 +1,19 @@
+package db
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPartitionName(t *testing.T) {
+	cases := map[time.Time]string{
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC):                     "transactions_p202401",
+		time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC):                "transactions_p202412",
+		time.Date(2024, 3, 1, 0, 30, 0, 0, time.FixedZone("CET", 3600)): "transactions_p202402",
+	}
+	for in, want := range cases {
+		if got := PartitionName(in); got != want {
+			t.Errorf("PartitionName(%v) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,285 @@
+package db
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// InvalidPlatformError is returned by TransactionRepo.Insert when the
+// platform is unknown, disabled or does not accept the transaction, and by
+// PlatformRepo for invalid registry entries.
+type InvalidPlatformError struct {
+	Platform string
+	Reason   string
+}
+
+func (e *InvalidPlatformError) Error() string {
+	return fmt.Sprintf("platform %q: %s", e.Platform, e.Reason)
+}
+
+// applyPlatform validates t against platform p (nil when t.PlatformName is
+// not registered) and fills a missing expiry date from the platform's
+// default access duration.
+func applyPlatform(p *models.Platform, t *models.Transaction) error {
+	invalid := func(format string, args ...any) error {
+		return &InvalidPlatformError{Platform: t.PlatformName, Reason: fmt.Sprintf(format, args...)}
+	}
+	switch {
+	case p == nil:
+		return invalid("unknown platform")
+	case !p.Enabled:
+		return invalid("platform is disabled")
+	case len(p.AllowedCoins) > 0 && !slices.Contains(p.AllowedCoins, t.CoinID):
+		return invalid("coin %q is not allowed", t.CoinID)
+	}
+	if t.ExpiryDate.IsZero() {
+		if p.DefaultAccess <= 0 {
+			return invalid("expiryDate is required, the platform has no default access duration")
+		}
+		t.ExpiryDate = t.TransactionTimestamp.Add(p.DefaultAccess)
+	}
+	return nil
+}
+
+const platformColumns = "name, display_name, owner_contact, allowed_coins, default_access_seconds, webhook_url, webhook_secret, enabled, dedup_window_seconds, dedup_action, created_at, updated_at"
+
+func scanPlatform(row pgx.Row) (*models.Platform, error) {
+	var p models.Platform
+	var seconds, dedupSeconds int64
+	if err := row.Scan(&p.Name, &p.DisplayName, &p.OwnerContact, &p.AllowedCoins, &seconds, &p.WebhookURL, &p.WebhookSecret, &p.Enabled, &dedupSeconds, &p.DedupAction, &p.CreatedAt, &p.UpdatedAt); err != nil {
+		return nil, err
+	}
+	p.DefaultAccess = time.Duration(seconds) * time.Second
+	p.DedupWindow = time.Duration(dedupSeconds) * time.Second
+	return &p, nil
+}
+
+func getPlatform(ctx context.Context, q querier, tenant, name string) (*models.Platform, error) {
+	p, err := scanPlatform(q.QueryRow(ctx, "SELECT "+platformColumns+" FROM platforms WHERE tenant_id = $1 AND name = $2", tenant, name))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return p, err
+}
+
+// resolvePlatform must run inside the inserting transaction.
+func resolvePlatform(ctx context.Context, tx pgx.Tx, t *models.Transaction) error {
+	p, err := getPlatform(ctx, tx, t.TenantID, t.PlatformName)
+	if err != nil {
+		return err
+	}
+	return applyPlatform(p, t)
+}
+
+func validPlatform(p models.Platform) error {
+	invalid := func(reason string) error {
+		return &InvalidPlatformError{Platform: p.Name, Reason: reason}
+	}
+	switch {
+	case p.Name == "":
+		return invalid("name is required")
+	case p.DefaultAccess < 0:
+		return invalid("default access duration must be non-negative")
+	case p.DedupWindow < 0:
+		return invalid("dedup window must be non-negative")
+	case p.DedupAction != models.DedupReturn && p.DedupAction != models.DedupReject:
+		return invalid(fmt.Sprintf("dedup action must be %q or %q", models.DedupReturn, models.DedupReject))
+	}
+	if p.WebhookURL != "" {
+		u, err := url.Parse(p.WebhookURL)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return invalid("webhook URL must be an absolute http(s) URL")
+		}
+	}
+	return nil
+}
+
+// newWebhookSecret returns a key for signing a platform's webhook
+// deliveries.
+func newWebhookSecret() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return "whsec_" + hex.EncodeToString(b)
+}
+
+// PlatformRepo manages the platform registry of the tenant in ctx.
+type PlatformRepo struct {
+	pool *Pool
+
+	mu    sync.Mutex
+	cache map[string]cachedPlatform
+}
+
+type cachedPlatform struct {
+	p       *models.Platform
+	expires time.Time
+}
+
+const platformCacheTTL = 30 * time.Second
+
+func NewPlatformRepo(pool *Pool) *PlatformRepo {
+	return &PlatformRepo{pool: pool, cache: map[string]cachedPlatform{}}
+}
+
+// Get returns a platform, or nil if there is none.
+func (r *PlatformRepo) Get(ctx context.Context, name string) (*models.Platform, error) {
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	key := tenant + ":" + name
+	r.mu.Lock()
+	cp, ok := r.cache[key]
+	r.mu.Unlock()
+	if ok && time.Now().Before(cp.expires) {
+		return cp.p, nil
+	}
+
+	var p *models.Platform
+	err = r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		p, err = getPlatform(ctx, tx, tenant, name)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	r.cache[key] = cachedPlatform{p: p, expires: time.Now().Add(platformCacheTTL)}
+	r.mu.Unlock()
+	return p, nil
+}
+
+func (r *PlatformRepo) List(ctx context.Context, includeDisabled bool) ([]models.Platform, error) {
+	var out []models.Platform
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		rows, err := tx.Query(ctx, "SELECT "+platformColumns+" FROM platforms WHERE tenant_id = $1 AND (enabled OR $2) ORDER BY name", tenant, includeDisabled)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			p, err := scanPlatform(rows)
+			if err != nil {
+				return err
+			}
+			out = append(out, *p)
+		}
+		return rows.Err()
+	})
+	return out, err
+}
+
+func (r *PlatformRepo) Create(ctx context.Context, p models.Platform) (*models.Platform, error) {
+	if p.DedupAction == "" {
+		p.DedupAction = models.DedupReturn
+	}
+	if err := validPlatform(p); err != nil {
+		return nil, err
+	}
+	if p.DisplayName == "" {
+		p.DisplayName = p.Name
+	}
+	if p.AllowedCoins == nil {
+		p.AllowedCoins = []string{}
+	}
+	var out *models.Platform
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		var err error
+		out, err = scanPlatform(tx.QueryRow(ctx, `
+			INSERT INTO platforms (tenant_id, name, display_name, owner_contact, allowed_coins, default_access_seconds, webhook_url, enabled, dedup_window_seconds, dedup_action, webhook_secret)
+			VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)
+			RETURNING `+platformColumns,
+			tenant, p.Name, p.DisplayName, p.OwnerContact, p.AllowedCoins, int64(p.DefaultAccess/time.Second), p.WebhookURL, p.Enabled,
+			int64(p.DedupWindow/time.Second), p.DedupAction, newWebhookSecret()))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.flush()
+	return out, nil
+}
+
+// Update replaces every setting of an existing platform.
+func (r *PlatformRepo) Update(ctx context.Context, p models.Platform) (*models.Platform, error) {
+	if p.DedupAction == "" {
+		p.DedupAction = models.DedupReturn
+	}
+	if err := validPlatform(p); err != nil {
+		return nil, err
+	}
+	if p.DisplayName == "" {
+		p.DisplayName = p.Name
+	}
+	if p.AllowedCoins == nil {
+		p.AllowedCoins = []string{}
+	}
+	var out *models.Platform
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		var err error
+		out, err = scanPlatform(tx.QueryRow(ctx, `
+			UPDATE platforms
+			SET display_name = $3, owner_contact = $4, allowed_coins = $5, default_access_seconds = $6,
+			    webhook_url = $7, enabled = $8, dedup_window_seconds = $9, dedup_action = $10, updated_at = now()
+			WHERE tenant_id = $1 AND name = $2
+			RETURNING `+platformColumns,
+			tenant, p.Name, p.DisplayName, p.OwnerContact, p.AllowedCoins, int64(p.DefaultAccess/time.Second), p.WebhookURL, p.Enabled,
+			int64(p.DedupWindow/time.Second), p.DedupAction))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.flush()
+	return out, nil
+}
+
+// RotateWebhookSecret replaces the key signing a platform's webhook
+// deliveries. Unknown platforms return pgx.ErrNoRows.
+func (r *PlatformRepo) RotateWebhookSecret(ctx context.Context, name string) (*models.Platform, error) {
+	var out *models.Platform
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		var err error
+		out, err = scanPlatform(tx.QueryRow(ctx, `
+			UPDATE platforms SET webhook_secret = $3, updated_at = now()
+			WHERE tenant_id = $1 AND name = $2
+			RETURNING `+platformColumns, tenant, name, newWebhookSecret()))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.flush()
+	return out, nil
+}
+
+// Delete removes a platform from the registry and reports whether it
+// existed. Its past transactions are kept; new ones are rejected.
+func (r *PlatformRepo) Delete(ctx context.Context, name string) (bool, error) {
+	var deleted bool
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		tag, err := tx.Exec(ctx, "DELETE FROM platforms WHERE tenant_id = $1 AND name = $2", tenant, name)
+		deleted = tag.RowsAffected() > 0
+		return err
+	})
+	if err == nil {
+		r.flush()
+	}
+	return deleted, err
+}
+
+func (r *PlatformRepo) flush() {
+	r.mu.Lock()
+	r.cache = map[string]cachedPlatform{}
+	r.mu.Unlock()
+}
@@ -0,0 +1,69 @@
+package db
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+func TestApplyPlatform(t *testing.T) {
+	now := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	web := &models.Platform{Name: "web", Enabled: true, AllowedCoins: []string{"BTC"}, DefaultAccess: 48 * time.Hour}
+
+	tx := models.Transaction{PlatformName: "web", CoinID: "BTC", TransactionTimestamp: now}
+	if err := applyPlatform(web, &tx); err != nil {
+		t.Fatalf("valid transaction rejected: %v", err)
+	}
+	if want := now.Add(48 * time.Hour); !tx.ExpiryDate.Equal(want) {
+		t.Errorf("derived expiry %v, want %v", tx.ExpiryDate, want)
+	}
+
+	explicit := now.Add(time.Hour)
+	tx = models.Transaction{PlatformName: "web", CoinID: "BTC", TransactionTimestamp: now, ExpiryDate: explicit}
+	if err := applyPlatform(web, &tx); err != nil || !tx.ExpiryDate.Equal(explicit) {
+		t.Errorf("explicit expiry changed to %v (err %v)", tx.ExpiryDate, err)
+	}
+
+	rejected := map[string]struct {
+		p  *models.Platform
+		tx models.Transaction
+	}{
+		"unknown":   {nil, models.Transaction{CoinID: "BTC", ExpiryDate: now}},
+		"disabled":  {&models.Platform{Name: "web"}, models.Transaction{CoinID: "BTC", ExpiryDate: now}},
+		"coin":      {web, models.Transaction{CoinID: "ETH", ExpiryDate: now}},
+		"no expiry": {&models.Platform{Name: "web", Enabled: true}, models.Transaction{CoinID: "BTC"}},
+	}
+	for name, c := range rejected {
+		var invalid *InvalidPlatformError
+		if err := applyPlatform(c.p, &c.tx); !errors.As(err, &invalid) {
+			t.Errorf("%s: err = %v, want InvalidPlatformError", name, err)
+		}
+	}
+}
+
+func TestWebhookSecretRotation(t *testing.T) {
+	pool := testPool(t)
+	ctx := testTenant(t, NewTenantRepo(pool), "whsec")
+	platforms := NewPlatformRepo(pool)
+
+	p, err := platforms.Get(ctx, "p")
+	if err != nil || p == nil || !strings.HasPrefix(p.WebhookSecret, "whsec_") {
+		t.Fatalf("created platform: %+v, %v", p, err)
+	}
+	p.WebhookSecret = "chosen-by-caller"
+	updated, err := platforms.Update(ctx, *p)
+	if err != nil || updated.WebhookSecret == "chosen-by-caller" {
+		t.Fatalf("update set the secret: %+v, %v", updated, err)
+	}
+	rotated, err := platforms.RotateWebhookSecret(ctx, "p")
+	if err != nil || rotated.WebhookSecret == updated.WebhookSecret || !strings.HasPrefix(rotated.WebhookSecret, "whsec_") {
+		t.Fatalf("rotate: %+v, %v", rotated, err)
+	}
+	if _, err := platforms.RotateWebhookSecret(ctx, "missing"); !errors.Is(err, pgx.ErrNoRows) {
+		t.Fatalf("rotate unknown platform: err=%v, want ErrNoRows", err)
+	}
+}
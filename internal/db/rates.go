@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// NoRateError is returned when no rate between two coins was effective at
+// the requested time.
+type NoRateError struct {
+	From, To string
+	At       time.Time
+}
+
+func (e *NoRateError) Error() string {
+	return fmt.Sprintf("no %s/%s exchange rate effective at %s", e.From, e.To, e.At.UTC().Format(time.RFC3339))
+}
+
+// ErrInvalidRate wraps validation failures of RateRepo.Import.
+var ErrInvalidRate = errors.New("invalid exchange rate")
+
+func validRate(r models.ExchangeRate) error {
+	invalid := func(reason string) error {
+		return fmt.Errorf("%w: %s", ErrInvalidRate, reason)
+	}
+	switch {
+	case r.BaseCoin == "" || r.QuoteCoin == "":
+		return invalid("base and quote coins are required")
+	case r.BaseCoin == r.QuoteCoin:
+		return invalid("base and quote coins must differ")
+	case !(r.Rate > 0) || math.IsInf(r.Rate, 1):
+		return invalid("rate must be positive and finite")
+	case r.EffectiveAt.IsZero():
+		return invalid("effective time is required")
+	}
+	return nil
+}
+
+type RateRepo struct {
+	pool *Pool
+}
+
+func NewRateRepo(pool *Pool) *RateRepo {
+	return &RateRepo{pool: pool}
+}
+
+const rateColumns = "base_coin, quote_coin, rate, effective_at"
+
+// Import stores rates for the tenant in ctx in one transaction, replacing
+// entries with the same pair and effective time.
+func (r *RateRepo) Import(ctx context.Context, rates []models.ExchangeRate) (int, error) {
+	for i, rt := range rates {
+		if err := validRate(rt); err != nil {
+			return 0, fmt.Errorf("rate %d: %w", i+1, err)
+		}
+	}
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		batch := &pgx.Batch{}
+		for _, rt := range rates {
+			batch.Queue(`
+				INSERT INTO exchange_rates (tenant_id, base_coin, quote_coin, rate, effective_at)
+				VALUES ($1,$2,$3,$4,$5)
+				ON CONFLICT (tenant_id, base_coin, quote_coin, effective_at)
+				DO UPDATE SET rate = EXCLUDED.rate, created_at = now()`,
+				tenant, rt.BaseCoin, rt.QuoteCoin, rt.Rate, rt.EffectiveAt)
+		}
+		return tx.SendBatch(ctx, batch).Close()
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(rates), nil
+}
+
+// List returns rate history newest first, optionally restricted to a base
+// and/or quote coin.
+func (r *RateRepo) List(ctx context.Context, base, quote string) ([]models.ExchangeRate, error) {
+	var out []models.ExchangeRate
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		rows, err := tx.Query(ctx, `
+			SELECT `+rateColumns+` FROM exchange_rates
+			WHERE tenant_id = $1 AND ($2 = '' OR base_coin = $2) AND ($3 = '' OR quote_coin = $3)
+			ORDER BY base_coin, quote_coin, effective_at DESC`, tenant, base, quote)
+		if err != nil {
+			return err
+		}
+		out, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.ExchangeRate, error) {
+			var rt models.ExchangeRate
+			err := row.Scan(&rt.BaseCoin, &rt.QuoteCoin, &rt.Rate, &rt.EffectiveAt)
+			return rt, err
+		})
+		return err
+	})
+	return out, err
+}
+
+// Convert returns what amount of coin from was worth in coin to at time at,
+// or a NoRateError when no rate between them was effective then.
+func (r *RateRepo) Convert(ctx context.Context, amount float64, from, to string, at time.Time) (float64, error) {
+	var rate *float64
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		return tx.QueryRow(ctx, "SELECT exchange_rate($1, $2, $3, $4)", tenant, from, to, at).Scan(&rate)
+	})
+	if err != nil {
+		return 0, err
+	}
+	if rate == nil {
+		return 0, &NoRateError{From: from, To: to, At: at}
+	}
+	return amount * *rate, nil
+}
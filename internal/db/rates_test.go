@@ -0,0 +1,113 @@
+package db
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+)
+
+func TestValidRate(t *testing.T) {
+	at := time.Now()
+	for _, rate := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+		if err := validRate(models.ExchangeRate{BaseCoin: "BTC", QuoteCoin: "USD", Rate: rate, EffectiveAt: at}); !errors.Is(err, ErrInvalidRate) {
+			t.Errorf("rate %v: err=%v, want ErrInvalidRate", rate, err)
+		}
+	}
+	if err := validRate(models.ExchangeRate{BaseCoin: "BTC", QuoteCoin: "USD", Rate: 2, EffectiveAt: at}); err != nil {
+		t.Errorf("valid rate: %v", err)
+	}
+}
+
+func TestExchangeRates(t *testing.T) {
+	pool := testPool(t)
+	rates := NewRateRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "rates")
+
+	t0 := time.Now().UTC().Add(-2 * time.Hour).Truncate(time.Second)
+	t1 := t0.Add(time.Hour)
+	if _, err := rates.Import(ctx, []models.ExchangeRate{
+		{BaseCoin: "BTC", QuoteCoin: "USD", Rate: 100, EffectiveAt: t0},
+		{BaseCoin: "BTC", QuoteCoin: "USD", Rate: 200, EffectiveAt: t1},
+	}); err != nil {
+		t.Fatalf("import: %v", err)
+	}
+
+	cases := []struct {
+		name     string
+		amount   float64
+		from, to string
+		at       time.Time
+		want     float64
+	}{
+		{"direct", 2, "BTC", "USD", t0.Add(time.Minute), 200},
+		{"latest effective", 2, "BTC", "USD", time.Now(), 400},
+		{"inverse", 400, "USD", "BTC", t0.Add(time.Minute), 4},
+		{"same coin", 3, "BTC", "BTC", t0.Add(-time.Hour), 3},
+	}
+	for _, c := range cases {
+		got, err := rates.Convert(ctx, c.amount, c.from, c.to, c.at)
+		if err != nil || got != c.want {
+			t.Errorf("%s: Convert(%g %s→%s) = %g, %v; want %g", c.name, c.amount, c.from, c.to, got, err, c.want)
+		}
+	}
+	var noRate *NoRateError
+	if _, err := rates.Convert(ctx, 1, "BTC", "USD", t0.Add(-time.Minute)); !errors.As(err, &noRate) {
+		t.Errorf("before the first rate: err=%v, want NoRateError", err)
+	}
+	if _, err := rates.Convert(ctx, 1, "BTC", "EUR", time.Now()); !errors.As(err, &noRate) {
+		t.Errorf("unknown pair: err=%v, want NoRateError", err)
+	}
+}
+
+func TestTotalsConvertsEachRowAtItsTimestamp(t *testing.T) {
+	pool := testPool(t)
+	repo := NewTransactionRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "totals")
+	if _, err := NewCoinRepo(pool).Set(ctx, models.Coin{ID: "ETH", Active: true, Precision: 8}); err != nil {
+		t.Fatalf("register coin: %v", err)
+	}
+
+	t0 := time.Now().UTC().Add(-2 * time.Hour).Truncate(time.Second)
+	t1 := t0.Add(time.Hour)
+	if _, err := NewRateRepo(pool).Import(ctx, []models.ExchangeRate{
+		{BaseCoin: "BTC", QuoteCoin: "USD", Rate: 100, EffectiveAt: t0},
+		{BaseCoin: "BTC", QuoteCoin: "USD", Rate: 200, EffectiveAt: t1},
+	}); err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	insert := func(coin string, amount float64, at time.Time) {
+		t.Helper()
+		if _, err := repo.Insert(ctx, models.Transaction{CoinID: coin, UserID: "alice", DataID: "d", CoinUsed: amount,
+			TransactionTimestamp: at, ExpiryDate: at.Add(24 * time.Hour), PlatformName: "p"}); err != nil {
+			t.Fatalf("insert: %v", err)
+		}
+	}
+	insert("BTC", 1, t0.Add(time.Minute)) // 100 USD
+	insert("BTC", 1, t1.Add(time.Minute)) // 200 USD
+	insert("ETH", 5, t1.Add(time.Minute)) // no ETH rate
+
+	user := "alice"
+	got, err := repo.Totals(ctx, TransactionFilter{UserID: &user}, "USD")
+	if err != nil {
+		t.Fatalf("totals: %v", err)
+	}
+	if got.Complete || got.Value != 300 || len(got.ByCoin) != 2 {
+		t.Fatalf("totals = %+v; want 300 USD, incomplete", got)
+	}
+	btc, eth := got.ByCoin[0], got.ByCoin[1]
+	if btc.CoinID != "BTC" || btc.Count != 2 || btc.Total != 2 || btc.Value != 300 || btc.Unconverted != 0 {
+		t.Errorf("BTC total = %+v", btc)
+	}
+	if eth.CoinID != "ETH" || eth.Count != 1 || eth.Total != 5 || eth.Value != 0 || eth.Unconverted != 1 {
+		t.Errorf("ETH total = %+v", eth)
+	}
+
+	btcOnly := "BTC"
+	got, err = repo.Totals(ctx, TransactionFilter{UserID: &user, CoinID: &btcOnly}, "USD")
+	if err != nil || !got.Complete || got.Value != 300 {
+		t.Fatalf("BTC totals = %+v, %v; want 300 USD, complete", got, err)
+	}
+}
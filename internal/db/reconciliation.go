@@ -0,0 +1,214 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// ReconciliationRepo stores settlement reconciliations of the tenant in
+// ctx. Matching itself lives in package reconcile.
+type ReconciliationRepo struct {
+	pool *Pool
+}
+
+func NewReconciliationRepo(pool *Pool) *ReconciliationRepo {
+	return &ReconciliationRepo{pool: pool}
+}
+
+// Transactions returns every live captured transaction of a registered
+// platform with a timestamp in [from, to], oldest first.
+func (r *ReconciliationRepo) Transactions(ctx context.Context, platform string, from, to time.Time) ([]models.Transaction, error) {
+	var out []models.Transaction
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		var known bool
+		if err := tx.QueryRow(ctx,
+			"SELECT EXISTS (SELECT 1 FROM platforms WHERE tenant_id = $1 AND name = $2)", tenant, platform).Scan(&known); err != nil {
+			return err
+		}
+		if !known {
+			return &InvalidPlatformError{Platform: platform, Reason: "unknown platform"}
+		}
+		rows, err := tx.Query(ctx, `
+			SELECT `+txColumns+` FROM transactions
+			WHERE tenant_id = $1 AND platformName = $2 AND deleted_at IS NULL AND status = 'captured'
+			  AND transactionTimestamp >= $3 AND transactionTimestamp <= $4
+			ORDER BY transactionTimestamp, id`, tenant, platform, from, to)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			t, err := scanTransaction(rows)
+			if err != nil {
+				return err
+			}
+			out = append(out, *t)
+		}
+		return rows.Err()
+	})
+	return out, err
+}
+
+const reconRunColumns = `id, platformName, file_name, period_from, period_to, amount_tolerance, time_tolerance_seconds,
+	matched, missing_ours, missing_theirs, amount_mismatches, created_by, created_at`
+
+func scanReconRun(row pgx.Row) (*models.ReconciliationRun, error) {
+	var run models.ReconciliationRun
+	var seconds int64
+	if err := row.Scan(&run.ID, &run.PlatformName, &run.FileName, &run.From, &run.To, &run.AmountTolerance, &seconds,
+		&run.Matched, &run.MissingOurs, &run.MissingTheirs, &run.AmountMismatches, &run.CreatedBy, &run.CreatedAt); err != nil {
+		return nil, err
+	}
+	run.TimeTolerance = time.Duration(seconds) * time.Second
+	return &run, nil
+}
+
+const reconItemColumns = `id, run_id, kind, status, dataid, userid, their_amount, our_amount, their_timestamp, our_timestamp,
+	transaction_id, resolution, resolved_by, resolved_at`
+
+func scanReconItem(row pgx.Row) (*models.ReconciliationItem, error) {
+	var it models.ReconciliationItem
+	if err := row.Scan(&it.ID, &it.RunID, &it.Kind, &it.Status, &it.DataID, &it.UserID, &it.TheirAmount, &it.OurAmount,
+		&it.TheirTimestamp, &it.OurTimestamp, &it.TransactionID, &it.Resolution, &it.ResolvedBy, &it.ResolvedAt); err != nil {
+		return nil, err
+	}
+	return &it, nil
+}
+
+// Save stores run and its items in one transaction and returns the run
+// with its id and creation time.
+func (r *ReconciliationRepo) Save(ctx context.Context, run models.ReconciliationRun, items []models.ReconciliationItem) (*models.ReconciliationRun, error) {
+	var out *models.ReconciliationRun
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		var err error
+		out, err = scanReconRun(tx.QueryRow(ctx, `
+			INSERT INTO reconciliation_runs (tenant_id, platformName, file_name, period_from, period_to, amount_tolerance,
+				time_tolerance_seconds, matched, missing_ours, missing_theirs, amount_mismatches, created_by)
+			VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)
+			RETURNING `+reconRunColumns,
+			tenant, run.PlatformName, run.FileName, run.From, run.To, run.AmountTolerance, int64(run.TimeTolerance/time.Second),
+			run.Matched, run.MissingOurs, run.MissingTheirs, run.AmountMismatches, run.CreatedBy))
+		if err != nil {
+			return err
+		}
+		rows := make([][]any, 0, len(items))
+		for _, it := range items {
+			rows = append(rows, []any{out.ID, tenant, it.Kind, it.Status, it.DataID, it.UserID,
+				it.TheirAmount, it.OurAmount, it.TheirTimestamp, it.OurTimestamp, it.TransactionID})
+		}
+		_, err = tx.CopyFrom(ctx, pgx.Identifier{"reconciliation_items"},
+			[]string{"run_id", "tenant_id", "kind", "status", "dataid", "userid",
+				"their_amount", "our_amount", "their_timestamp", "our_timestamp", "transaction_id"},
+			pgx.CopyFromRows(rows))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetRun returns a run, or nil if it does not exist.
+func (r *ReconciliationRepo) GetRun(ctx context.Context, id string) (*models.ReconciliationRun, error) {
+	var out *models.ReconciliationRun
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		var err error
+		out, err = scanReconRun(tx.QueryRow(ctx,
+			"SELECT "+reconRunColumns+" FROM reconciliation_runs WHERE tenant_id = $1 AND id = $2", tenant, id))
+		return err
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return out, err
+}
+
+// Runs lists runs newest first, optionally for one platform.
+func (r *ReconciliationRepo) Runs(ctx context.Context, platform string, limit, offset int) ([]models.ReconciliationRun, error) {
+	if limit <= 0 || limit > 1000 {
+		limit = 100
+	}
+	var out []models.ReconciliationRun
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		rows, err := tx.Query(ctx, `
+			SELECT `+reconRunColumns+` FROM reconciliation_runs
+			WHERE tenant_id = $1 AND ($2 = '' OR platformName = $2)
+			ORDER BY created_at DESC, id
+			LIMIT $3 OFFSET $4`, tenant, platform, limit, offset)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			run, err := scanReconRun(rows)
+			if err != nil {
+				return err
+			}
+			out = append(out, *run)
+		}
+		return rows.Err()
+	})
+	return out, err
+}
+
+// ItemFilter selects reconciliation items; empty fields match everything,
+// so an empty RunID lists discrepancies across runs.
+type ItemFilter struct {
+	RunID  string
+	Kind   string
+	Status string
+	Limit  int
+	Offset int
+}
+
+// Items lists items in run order.
+func (r *ReconciliationRepo) Items(ctx context.Context, f ItemFilter) ([]models.ReconciliationItem, error) {
+	if f.Limit <= 0 || f.Limit > 1000 {
+		f.Limit = 100
+	}
+	var out []models.ReconciliationItem
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		rows, err := tx.Query(ctx, `
+			SELECT `+reconItemColumns+` FROM reconciliation_items
+			WHERE tenant_id = $1 AND ($2 = '' OR run_id::text = $2)
+			  AND ($3 = '' OR kind = $3) AND ($4 = '' OR status = $4)
+			ORDER BY id
+			LIMIT $5 OFFSET $6`, tenant, f.RunID, f.Kind, f.Status, f.Limit, f.Offset)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			it, err := scanReconItem(rows)
+			if err != nil {
+				return err
+			}
+			out = append(out, *it)
+		}
+		return rows.Err()
+	})
+	return out, err
+}
+
+// Resolve closes an item with a note. Resolving an already resolved item
+// replaces its note. Returns nil if the item does not exist.
+func (r *ReconciliationRepo) Resolve(ctx context.Context, id int64, note, by string) (*models.ReconciliationItem, error) {
+	var out *models.ReconciliationItem
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		var err error
+		out, err = scanReconItem(tx.QueryRow(ctx, `
+			UPDATE reconciliation_items
+			SET status = 'resolved', resolution = $3, resolved_by = $4, resolved_at = now()
+			WHERE tenant_id = $1 AND id = $2
+			RETURNING `+reconItemColumns, tenant, id, note, by))
+		return err
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return out, err
+}
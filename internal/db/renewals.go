@@ -0,0 +1,133 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrNoEntitlement is returned by ExtendAccess when the user never bought
+// the dataid on the platform.
+var ErrNoEntitlement = errors.New("no entitlement to extend")
+
+// ExtendAccess charges a renewal and moves the expiry of the user's
+// entitlement: from its current expiry while that is in the future, from
+// now otherwise. The entitlement is the original captured purchase with
+// the latest expiry; renewals link to it through RenewsID and carry the
+// new expiry as well. The charge goes through the same checks as Insert;
+// one held by fraud screening extends access only once it is captured.
+func (r *TransactionRepo) ExtendAccess(ctx context.Context, req models.RenewalRequest) (*models.Renewal, error) {
+	var out *models.Renewal
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		// Serializes concurrent renewals of the same user so they stack.
+		if err := lockUser(ctx, tx, tenant, req.UserID); err != nil {
+			return err
+		}
+		orig, err := scanTransaction(tx.QueryRow(ctx, `
+			SELECT `+txColumns+` FROM transactions
+			WHERE tenant_id = $1 AND userid = $2 AND dataid = $3 AND platformName = $4
+			  AND renews_id IS NULL AND status = 'captured' AND deleted_at IS NULL
+			ORDER BY expiryDate DESC, transactionTimestamp DESC
+			LIMIT 1
+			FOR UPDATE`, tenant, req.UserID, req.DataID, req.PlatformName))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: user %q has no access to %q on %q", ErrNoEntitlement, req.UserID, req.DataID, req.PlatformName)
+		}
+		if err != nil {
+			return err
+		}
+
+		duration := req.Duration
+		if duration <= 0 {
+			p, err := getPlatform(ctx, tx, tenant, req.PlatformName)
+			if err != nil {
+				return err
+			}
+			if p != nil {
+				duration = p.DefaultAccess
+			}
+		}
+		if duration <= 0 {
+			return &InvalidPlatformError{Platform: req.PlatformName, Reason: "duration is required, the platform has no default access duration"}
+		}
+
+		now := time.Now().UTC()
+		start := orig.ExpiryDate
+		if start.Before(now) {
+			start = now
+		}
+		expiry := start.Add(duration)
+
+		renewal, err := r.pool.createTransaction(ctx, tx, models.Transaction{
+			CoinID:               req.CoinID,
+			UserID:               req.UserID,
+			DataID:               req.DataID,
+			CoinUsed:             req.CoinUsed,
+			TransactionTimestamp: now,
+			ExpiryDate:           expiry,
+			PlatformName:         req.PlatformName,
+			TenantID:             tenant,
+			Status:               models.StatusCaptured,
+			RenewsID:             &orig.ID,
+		})
+		if err != nil {
+			return err
+		}
+		if err := applyRenewal(ctx, tx, renewal); err != nil {
+			return err
+		}
+		out = &models.Renewal{
+			Transaction:    *renewal,
+			OriginalID:     orig.ID,
+			PreviousExpiry: orig.ExpiryDate,
+			ExpiryDate:     expiry,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.pool.postCommit(ctx, &out.Transaction)
+	return out, nil
+}
+
+// applyRenewal moves the expiry of the purchase a captured renewal renews
+// to the renewal's expiry. Other transactions are left alone.
+func applyRenewal(ctx context.Context, tx pgx.Tx, t *models.Transaction) error {
+	if t.RenewsID == nil || t.Status != models.StatusCaptured {
+		return nil
+	}
+	_, err := tx.Exec(ctx, `
+		UPDATE transactions SET expiryDate = GREATEST(expiryDate, $3)
+		WHERE id = $1 AND tenant_id = $2`, *t.RenewsID, t.TenantID, t.ExpiryDate)
+	return err
+}
+
+// Renewals lists the renewal charges of an original purchase, oldest
+// first.
+func (r *TransactionRepo) Renewals(ctx context.Context, originalID string) ([]models.Transaction, error) {
+	var out []models.Transaction
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		rows, err := tx.Query(ctx, `
+			SELECT `+txColumns+` FROM transactions
+			WHERE tenant_id = $1 AND renews_id = $2 AND deleted_at IS NULL
+			ORDER BY transactionTimestamp, id`, tenant, originalID)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			t, err := scanTransaction(rows)
+			if err != nil {
+				return err
+			}
+			out = append(out, *t)
+		}
+		return rows.Err()
+	})
+	return out, err
+}
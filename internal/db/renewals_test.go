@@ -0,0 +1,106 @@
+package db
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+func TestExtendAccess(t *testing.T) {
+	pool := testPool(t)
+	repo := NewTransactionRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "renew")
+
+	req := models.RenewalRequest{UserID: "alice", DataID: "d", PlatformName: "p", CoinID: "BTC", CoinUsed: 1, Duration: 24 * time.Hour}
+	if _, err := repo.ExtendAccess(ctx, req); !errors.Is(err, ErrNoEntitlement) {
+		t.Fatalf("renewal without purchase: err=%v, want ErrNoEntitlement", err)
+	}
+
+	now := time.Now().UTC()
+	orig, err := repo.Insert(ctx, models.Transaction{
+		CoinID: "BTC", UserID: "alice", DataID: "d", CoinUsed: 1,
+		TransactionTimestamp: now, ExpiryDate: now.Add(time.Hour), PlatformName: "p",
+	})
+	if err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+
+	// Unexpired access stacks on the current expiry.
+	ren, err := repo.ExtendAccess(ctx, req)
+	if err != nil {
+		t.Fatalf("extend: %v", err)
+	}
+	if ren.OriginalID != orig.ID || ren.Transaction.RenewsID == nil || *ren.Transaction.RenewsID != orig.ID {
+		t.Fatalf("renewal not linked to %s: %+v", orig.ID, ren)
+	}
+	if want := orig.ExpiryDate.Add(24 * time.Hour); !ren.ExpiryDate.Equal(want) {
+		t.Fatalf("expiry = %v, want %v", ren.ExpiryDate, want)
+	}
+	got, err := repo.GetByID(ctx, orig.ID, false)
+	if err != nil || !got.ExpiryDate.Equal(ren.ExpiryDate) {
+		t.Fatalf("original expiry = %v, err=%v; want %v", got.ExpiryDate, err, ren.ExpiryDate)
+	}
+
+	// Expired access restarts from now.
+	if err := pool.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, "UPDATE transactions SET expiryDate = now() - interval '1 day' WHERE id = $1", orig.ID)
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+	before := time.Now().UTC()
+	ren, err = repo.ExtendAccess(ctx, req)
+	if err != nil {
+		t.Fatalf("extend expired: %v", err)
+	}
+	if ren.ExpiryDate.Before(before.Add(24*time.Hour)) || ren.ExpiryDate.After(time.Now().UTC().Add(24*time.Hour)) {
+		t.Fatalf("expiry after lapse = %v, want now+24h", ren.ExpiryDate)
+	}
+
+	renewals, err := repo.Renewals(ctx, orig.ID)
+	if err != nil || len(renewals) != 2 {
+		t.Fatalf("renewals = %d, err=%v; want 2", len(renewals), err)
+	}
+}
+
+func TestExtendAccessHeldRenewal(t *testing.T) {
+	pool := testPool(t)
+	screener := userScreener{}
+	pool.SetScreener(screener)
+	repo := NewTransactionRepo(pool)
+	reviews := NewFraudReviewRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "renew")
+
+	now := time.Now().UTC()
+	orig, err := repo.Insert(ctx, models.Transaction{
+		CoinID: "BTC", UserID: "alice", DataID: "d", CoinUsed: 1,
+		TransactionTimestamp: now, ExpiryDate: now.Add(time.Hour), PlatformName: "p",
+	})
+	if err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+
+	screener["alice"] = models.FraudHold
+	req := models.RenewalRequest{UserID: "alice", DataID: "d", PlatformName: "p", CoinID: "BTC", CoinUsed: 1, Duration: 24 * time.Hour}
+	ren, err := repo.ExtendAccess(ctx, req)
+	if err != nil || ren.Transaction.Status != models.StatusAuthorized {
+		t.Fatalf("held renewal: %+v, %v", ren, err)
+	}
+	if got, err := repo.GetByID(ctx, orig.ID, false); err != nil || !got.ExpiryDate.Equal(orig.ExpiryDate) {
+		t.Fatalf("held renewal moved expiry to %v, err=%v; want %v", got.ExpiryDate, err, orig.ExpiryDate)
+	}
+
+	open, err := reviews.List(ctx, models.ReviewOpen, 0, 0)
+	if err != nil || len(open) != 1 {
+		t.Fatalf("open reviews = %+v, err=%v; want 1", open, err)
+	}
+	if _, err := reviews.Approve(ctx, open[0].ID, "", "ops"); err != nil {
+		t.Fatalf("approve: %v", err)
+	}
+	if got, err := repo.GetByID(ctx, orig.ID, false); err != nil || !got.ExpiryDate.Equal(ren.ExpiryDate) {
+		t.Fatalf("expiry after approval = %v, err=%v; want %v", got.ExpiryDate, err, ren.ExpiryDate)
+	}
+}
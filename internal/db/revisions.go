@@ -0,0 +1,149 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrVersionConflict is returned by TransactionRepo.Update when the
+// transaction changed after the version the edit was based on.
+var ErrVersionConflict = errors.New("transaction was modified concurrently")
+
+// ErrInvalidUpdate wraps validation failures of TransactionRepo.Update.
+var ErrInvalidUpdate = errors.New("invalid transaction update")
+
+func invalidUpdate(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidUpdate, fmt.Sprintf(format, args...))
+}
+
+// Update applies an operator edit to a live transaction of the tenant in
+// ctx and records it as a revision. The edit fails with ErrVersionConflict
+// unless the transaction is still at u.Version. A new platform must accept
+// the transaction's coin; on captured transactions the platform's ledger
+// credit is moved to the new platform by postings dated now. Edits that
+// change nothing return the transaction as is. Unknown ids return
+// pgx.ErrNoRows.
+func (r *TransactionRepo) Update(ctx context.Context, u models.TransactionUpdate, actor string) (*models.Transaction, error) {
+	if u.Version <= 0 {
+		return nil, invalidUpdate("version is required")
+	}
+	if len(u.Fields) == 0 {
+		return nil, invalidUpdate("no fields to update")
+	}
+	for i, f := range u.Fields {
+		if f != models.FieldExpiryDate && f != models.FieldPlatformName {
+			return nil, invalidUpdate("field %q cannot be updated", f)
+		}
+		if slices.Contains(u.Fields[:i], f) {
+			return nil, invalidUpdate("field %q is listed twice", f)
+		}
+	}
+
+	var out *models.Transaction
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		cur, err := scanTransaction(tx.QueryRow(ctx, `
+			SELECT `+txColumns+` FROM transactions
+			WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+			FOR UPDATE`, u.ID, tenant))
+		if err != nil {
+			return err
+		}
+		if cur.Version != u.Version {
+			return fmt.Errorf("%w: transaction %s is at version %d, not %d", ErrVersionConflict, cur.ID, cur.Version, u.Version)
+		}
+
+		next := *cur
+		var changes []models.FieldChange
+		for _, f := range u.Fields {
+			switch f {
+			case models.FieldExpiryDate:
+				if u.ExpiryDate.IsZero() || u.ExpiryDate.Before(cur.TransactionTimestamp) {
+					return invalidUpdate("expiryDate must be >= transactionTimestamp")
+				}
+				if !u.ExpiryDate.Equal(cur.ExpiryDate) {
+					next.ExpiryDate = u.ExpiryDate
+					changes = append(changes, models.FieldChange{
+						Field: f,
+						From:  cur.ExpiryDate.UTC().Format(time.RFC3339),
+						To:    u.ExpiryDate.UTC().Format(time.RFC3339),
+					})
+				}
+			case models.FieldPlatformName:
+				if u.PlatformName != cur.PlatformName {
+					next.PlatformName = u.PlatformName
+					if err := resolvePlatform(ctx, tx, &next); err != nil {
+						return err
+					}
+					changes = append(changes, models.FieldChange{Field: f, From: cur.PlatformName, To: u.PlatformName})
+				}
+			}
+		}
+		if len(changes) == 0 {
+			out = cur
+			return nil
+		}
+
+		out, err = scanTransaction(tx.QueryRow(ctx, `
+			UPDATE transactions SET expiryDate = $3, platformName = $4
+			WHERE id = $1 AND tenant_id = $2
+			RETURNING `+txColumns, cur.ID, tenant, next.ExpiryDate, next.PlatformName))
+		if err != nil {
+			return err
+		}
+		if out.PlatformName != cur.PlatformName && out.Status == models.StatusCaptured && out.CoinUsed != 0 {
+			if err := movePlatformCredit(ctx, tx, out, cur.PlatformName); err != nil {
+				return err
+			}
+		}
+		_, err = tx.Exec(ctx, `
+			INSERT INTO transaction_revisions (tenant_id, transaction_id, version, changes, reason, actor)
+			VALUES ($1,$2,$3,$4,$5,$6)`, tenant, out.ID, out.Version, changes, u.Reason, actor)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// movePlatformCredit reverses the credit of t's journal entry on platform
+// from and credits t's current platform instead, leaving the entry
+// balanced.
+func movePlatformCredit(ctx context.Context, tx pgx.Tx, t *models.Transaction, from string) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO ledger_postings (entry_id, tenant_id, account, coinid, amount, posted_at)
+		SELECT e.id, e.tenant_id, p.account, $3, p.amount, now()
+		FROM journal_entries e
+		CROSS JOIN (VALUES ($4, $6::float8::numeric), ($5, -$6::float8::numeric)) AS p (account, amount)
+		WHERE e.transaction_id = $1 AND e.tenant_id = $2`,
+		t.ID, t.TenantID, t.CoinID, models.PlatformAccount(from), models.PlatformAccount(t.PlatformName), t.CoinUsed)
+	return err
+}
+
+// Revisions returns a transaction's edits, oldest first.
+func (r *TransactionRepo) Revisions(ctx context.Context, id string) ([]models.TransactionRevision, error) {
+	var out []models.TransactionRevision
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		rows, err := tx.Query(ctx, `
+			SELECT id, transaction_id, version, changes, reason, actor, changed_at
+			FROM transaction_revisions
+			WHERE tenant_id = $1 AND transaction_id = $2
+			ORDER BY id`, tenant, id)
+		if err != nil {
+			return err
+		}
+		out, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.TransactionRevision, error) {
+			var rev models.TransactionRevision
+			err := row.Scan(&rev.ID, &rev.TransactionID, &rev.Version, &rev.Changes, &rev.Reason, &rev.Actor, &rev.ChangedAt)
+			return rev, err
+		})
+		return err
+	})
+	return out, err
+}
@@ -0,0 +1,68 @@
+package db
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+)
+
+func TestUpdateTransaction(t *testing.T) {
+	pool := testPool(t)
+	repo := NewTransactionRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "revisions")
+	if _, err := NewPlatformRepo(pool).Create(ctx, models.Platform{Name: "q", Enabled: true}); err != nil {
+		t.Fatalf("register platform: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	tx, err := repo.Insert(ctx, models.Transaction{
+		CoinID: "BTC", UserID: "u", DataID: "d", CoinUsed: 3,
+		TransactionTimestamp: now, ExpiryDate: now.Add(time.Hour), PlatformName: "p",
+	})
+	if err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+
+	if _, err := repo.Update(ctx, models.TransactionUpdate{ID: tx.ID, Version: tx.Version, Fields: []string{"coinused"}}, "ops"); !errors.Is(err, ErrInvalidUpdate) {
+		t.Fatalf("non-editable field: err=%v", err)
+	}
+	var platformErr *InvalidPlatformError
+	if _, err := repo.Update(ctx, models.TransactionUpdate{ID: tx.ID, Version: tx.Version, Fields: []string{models.FieldPlatformName}, PlatformName: "nope"}, "ops"); !errors.As(err, &platformErr) {
+		t.Fatalf("unknown platform: err=%v", err)
+	}
+
+	exp := now.Add(48 * time.Hour)
+	updated, err := repo.Update(ctx, models.TransactionUpdate{
+		ID: tx.ID, Version: tx.Version, Fields: []string{models.FieldExpiryDate, models.FieldPlatformName},
+		ExpiryDate: exp, PlatformName: "q", Reason: "typo",
+	}, "ops")
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if !updated.ExpiryDate.Equal(exp) || updated.PlatformName != "q" || updated.Version != tx.Version+1 {
+		t.Fatalf("updated = %+v", updated)
+	}
+
+	// A second edit based on the original read must not overwrite the first.
+	if _, err := repo.Update(ctx, models.TransactionUpdate{ID: tx.ID, Version: tx.Version, Fields: []string{models.FieldPlatformName}, PlatformName: "p"}, "ops"); !errors.Is(err, ErrVersionConflict) {
+		t.Fatalf("stale update: err=%v", err)
+	}
+
+	revs, err := repo.Revisions(ctx, tx.ID)
+	if err != nil || len(revs) != 1 {
+		t.Fatalf("revisions = %+v, err=%v", revs, err)
+	}
+	if revs[0].Version != updated.Version || len(revs[0].Changes) != 2 || revs[0].Changes[1] != (models.FieldChange{Field: models.FieldPlatformName, From: "p", To: "q"}) {
+		t.Fatalf("revision = %+v", revs[0])
+	}
+
+	ledger := NewLedgerRepo(pool)
+	for account, want := range map[string]float64{models.PlatformAccount("p"): 0, models.PlatformAccount("q"): -3, models.UserAccount("u"): 3} {
+		bs, err := ledger.Balances(ctx, account)
+		if err != nil || len(bs) != 1 || bs[0].Balance != want {
+			t.Fatalf("%s balances = %+v, err=%v; want %g", account, bs, err, want)
+		}
+	}
+}
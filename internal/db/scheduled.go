@@ -0,0 +1,313 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// MaxClockSkew is how far ahead of the server clock a transactionTimestamp
+// may be, to allow for clients whose clocks run slightly fast.
+const MaxClockSkew = time.Minute
+
+// ErrFutureTimestamp rejects transactions dated in the future; spends meant
+// to happen later are scheduled with ScheduledRepo.Schedule.
+var ErrFutureTimestamp = errors.New("transactionTimestamp is in the future; schedule the transaction instead")
+
+// ErrInvalidSchedule wraps validation failures of ScheduledRepo.
+var ErrInvalidSchedule = errors.New("invalid scheduled transaction")
+
+func invalidSchedule(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidSchedule, fmt.Sprintf(format, args...))
+}
+
+// checkTimestamp rejects future-dated transactions.
+func checkTimestamp(t models.Transaction) error {
+	if t.TransactionTimestamp.After(time.Now().Add(MaxClockSkew)) {
+		return ErrFutureTimestamp
+	}
+	return nil
+}
+
+// ScheduledRepo queues spends of the tenant in ctx for later execution.
+type ScheduledRepo struct {
+	pool *Pool
+}
+
+func NewScheduledRepo(pool *Pool) *ScheduledRepo {
+	return &ScheduledRepo{pool: pool}
+}
+
+const scheduledColumns = `id, coinid, userid, dataid, coinused, expiryDate, platformName, execute_at, status,
+	transaction_id, error, created_by, created_at, updated_at`
+
+func scanScheduled(row pgx.Row) (*models.ScheduledTransaction, error) {
+	var s models.ScheduledTransaction
+	if err := row.Scan(&s.ID, &s.CoinID, &s.UserID, &s.DataID, &s.CoinUsed, &s.ExpiryDate, &s.PlatformName, &s.ExecuteAt, &s.Status,
+		&s.TransactionID, &s.Error, &s.CreatedBy, &s.CreatedAt, &s.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// Schedule queues s for execution at s.ExecuteAt. The coin and platform are
+// checked now so obvious mistakes fail early; spending caps are checked
+// when the spend executes.
+func (r *ScheduledRepo) Schedule(ctx context.Context, s models.ScheduledTransaction, createdBy string) (*models.ScheduledTransaction, error) {
+	switch {
+	case strings.TrimSpace(s.CoinID) == "" || strings.TrimSpace(s.UserID) == "" ||
+		strings.TrimSpace(s.DataID) == "" || strings.TrimSpace(s.PlatformName) == "":
+		return nil, invalidSchedule("coinid, userid, dataid and platformName are required")
+	case checkAmount(s.CoinUsed) != nil:
+		return nil, invalidSchedule("coinused must be a finite, non-negative number")
+	case !s.ExecuteAt.After(time.Now()):
+		return nil, invalidSchedule("executeAt must be in the future")
+	case s.ExpiryDate != nil && !s.ExpiryDate.After(s.ExecuteAt):
+		return nil, invalidSchedule("expiryDate must be after executeAt")
+	}
+	var out *models.ScheduledTransaction
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		probe := models.Transaction{
+			CoinID:               s.CoinID,
+			CoinUsed:             s.CoinUsed,
+			PlatformName:         s.PlatformName,
+			TransactionTimestamp: s.ExecuteAt,
+			TenantID:             tenant,
+		}
+		if s.ExpiryDate != nil {
+			probe.ExpiryDate = *s.ExpiryDate
+		}
+		if err := validateCoin(ctx, tx, probe); err != nil {
+			return err
+		}
+		if err := resolvePlatform(ctx, tx, &probe); err != nil {
+			return err
+		}
+		var err error
+		out, err = scanScheduled(tx.QueryRow(ctx, `
+			INSERT INTO scheduled_transactions (tenant_id, coinid, userid, dataid, coinused, expiryDate, platformName, execute_at, created_by)
+			VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
+			RETURNING `+scheduledColumns,
+			tenant, s.CoinID, s.UserID, s.DataID, s.CoinUsed, s.ExpiryDate, s.PlatformName, s.ExecuteAt.UTC(), createdBy))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ownedBy matches scheduled transactions for or created by the user in the
+// given parameter; an empty owner matches every row.
+func ownedBy(param string) string {
+	return "(" + param + " = '' OR userid = " + param + " OR created_by = " + param + ")"
+}
+
+// Get returns a scheduled transaction or pgx.ErrNoRows. A non-empty owner
+// limits it to spends for or created by that user.
+func (r *ScheduledRepo) Get(ctx context.Context, id, owner string) (*models.ScheduledTransaction, error) {
+	var out *models.ScheduledTransaction
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		var err error
+		out, err = scanScheduled(tx.QueryRow(ctx,
+			"SELECT "+scheduledColumns+" FROM scheduled_transactions WHERE tenant_id = $1 AND id = $2 AND "+ownedBy("$3"),
+			tenant, id, owner))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// List returns scheduled transactions by execution time, optionally of one
+// user and/or in one status. A non-empty owner limits it as in Get.
+func (r *ScheduledRepo) List(ctx context.Context, owner, userID, status string, limit, offset int) ([]models.ScheduledTransaction, error) {
+	if limit <= 0 || limit > 1000 {
+		limit = 100
+	}
+	var out []models.ScheduledTransaction
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		rows, err := tx.Query(ctx, `
+			SELECT `+scheduledColumns+` FROM scheduled_transactions
+			WHERE tenant_id = $1 AND ($2 = '' OR userid = $2) AND ($3 = '' OR status = $3) AND `+ownedBy("$6")+`
+			ORDER BY execute_at, id
+			LIMIT $4 OFFSET $5`, tenant, userID, status, limit, offset, owner)
+		if err != nil {
+			return err
+		}
+		out, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.ScheduledTransaction, error) {
+			s, err := scanScheduled(row)
+			if err != nil {
+				return models.ScheduledTransaction{}, err
+			}
+			return *s, nil
+		})
+		return err
+	})
+	return out, err
+}
+
+// Cancel withdraws a pending scheduled transaction. Unknown ids, and ids
+// not owned by a non-empty owner as in Get, return pgx.ErrNoRows.
+func (r *ScheduledRepo) Cancel(ctx context.Context, id, owner string) (*models.ScheduledTransaction, error) {
+	var out *models.ScheduledTransaction
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		cur, err := scanScheduled(tx.QueryRow(ctx,
+			"SELECT "+scheduledColumns+" FROM scheduled_transactions WHERE tenant_id = $1 AND id = $2 AND "+ownedBy("$3")+" FOR UPDATE",
+			tenant, id, owner))
+		if err != nil {
+			return err
+		}
+		if cur.Status != models.ScheduledPending {
+			return invalidSchedule("scheduled transaction is %s", cur.Status)
+		}
+		out, err = scanScheduled(tx.QueryRow(ctx, `
+			UPDATE scheduled_transactions SET status = 'cancelled', updated_at = now()
+			WHERE tenant_id = $1 AND id = $2
+			RETURNING `+scheduledColumns, tenant, id))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DueScheduled returns ids of pending scheduled transactions of every
+// tenant whose execution time has come, oldest first.
+func (p *Pool) DueScheduled(ctx context.Context, limit int) ([]string, error) {
+	var out []string
+	err := p.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `
+			SELECT id::text FROM scheduled_transactions
+			WHERE status = 'pending' AND execute_at <= now()
+			ORDER BY execute_at
+			LIMIT $1`, limit)
+		if err != nil {
+			return err
+		}
+		out, err = pgx.CollectRows(rows, pgx.RowTo[string])
+		return err
+	})
+	return out, err
+}
+
+// rejected reports whether err is a business rejection of a scheduled
+// spend or subscription charge, which a retry cannot fix, rather than a transient failure such as a cancelled
+// context, a lost connection or a serialization failure.
+func rejected(err error) bool {
+	var (
+		capErr      *CapExceededError
+		coinErr     *InvalidCoinError
+		platformErr *InvalidPlatformError
+		fraudErr    *FraudRejectedError
+		ruleErr     *RuleError
+	)
+	return errors.As(err, &capErr) || errors.As(err, &coinErr) || errors.As(err, &platformErr) ||
+		errors.As(err, &fraudErr) || errors.As(err, &ruleErr) ||
+		errors.Is(err, ErrInvalidAmount) || errors.Is(err, ErrInvalidMetadata) || errors.Is(err, ErrFutureTimestamp)
+}
+
+// maxExecuteAttempts bounds the executions of a scheduled spend that fail
+// with an error other than a rejection.
+const maxExecuteAttempts = 5
+
+// ExecuteScheduled inserts the transaction of one due scheduled spend and
+// returns its new status: executed, or failed when the insert checks
+// reject it. Any other error is recorded as an attempt and returned,
+// leaving the spend pending so the next run retries it; after
+// maxExecuteAttempts the spend fails instead. It returns "" when the spend
+// is no longer pending or is being executed by another worker.
+func (p *Pool) ExecuteScheduled(ctx context.Context, id string) (string, error) {
+	var result string
+	var created *models.Transaction
+	var retryErr error
+	err := p.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+		var tenant string
+		var attempts int
+		var s models.ScheduledTransaction
+		err := tx.QueryRow(ctx, `
+			SELECT tenant_id, attempts, `+scheduledColumns+`
+			FROM scheduled_transactions
+			WHERE id = $1 AND status = 'pending' AND execute_at <= now()
+			FOR UPDATE SKIP LOCKED`, id).Scan(&tenant, &attempts,
+			&s.ID, &s.CoinID, &s.UserID, &s.DataID, &s.CoinUsed, &s.ExpiryDate, &s.PlatformName, &s.ExecuteAt, &s.Status,
+			&s.TransactionID, &s.Error, &s.CreatedBy, &s.CreatedAt, &s.UpdatedAt)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		t := models.Transaction{
+			CoinID:               s.CoinID,
+			UserID:               s.UserID,
+			DataID:               s.DataID,
+			CoinUsed:             s.CoinUsed,
+			TransactionTimestamp: time.Now().UTC(),
+			PlatformName:         s.PlatformName,
+			TenantID:             tenant,
+			Status:               models.StatusCaptured,
+		}
+		if s.ExpiryDate != nil {
+			t.ExpiryDate = *s.ExpiryDate
+		}
+		// The insert runs in a savepoint so a rejected spend leaves tx
+		// usable for recording the failure.
+		sp, err := tx.Begin(ctx)
+		if err != nil {
+			return err
+		}
+		out, insertErr := p.createTransaction(ctx, sp, t)
+		if insertErr != nil && !rejected(insertErr) {
+			// A cancelled context or a lost connection leaves nothing to
+			// record the attempt with.
+			if ctx.Err() != nil || sp.Rollback(ctx) != nil {
+				return insertErr
+			}
+			status := models.ScheduledPending
+			if attempts+1 >= maxExecuteAttempts {
+				status, result = models.ScheduledFailed, models.ScheduledFailed
+			} else {
+				retryErr = insertErr
+			}
+			_, err := tx.Exec(ctx, `
+				UPDATE scheduled_transactions SET status = $2, attempts = attempts + 1, error = $3, updated_at = now()
+				WHERE id = $1`, s.ID, status, insertErr.Error())
+			return err
+		}
+		if insertErr != nil {
+			if err := sp.Rollback(ctx); err != nil {
+				return err
+			}
+			result = models.ScheduledFailed
+			_, err := tx.Exec(ctx, `
+				UPDATE scheduled_transactions SET status = 'failed', error = $2, updated_at = now()
+				WHERE id = $1`, s.ID, insertErr.Error())
+			return err
+		}
+		if err := sp.Commit(ctx); err != nil {
+			return err
+		}
+		result, created = models.ScheduledExecuted, out
+		_, err = tx.Exec(ctx, `
+			UPDATE scheduled_transactions SET status = 'executed', transaction_id = $2, updated_at = now()
+			WHERE id = $1`, s.ID, out.ID)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	if retryErr != nil {
+		return "", retryErr
+	}
+	p.postCommit(ctx, created)
+	return result, nil
+}
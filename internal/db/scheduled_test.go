@@ -0,0 +1,202 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+func TestInsertRejectsFutureTimestamp(t *testing.T) {
+	pool := testPool(t)
+	repo := NewTransactionRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "future")
+
+	later := time.Now().UTC().Add(time.Hour)
+	_, err := repo.Insert(ctx, models.Transaction{
+		CoinID: "BTC", UserID: "alice", DataID: "d", CoinUsed: 1,
+		TransactionTimestamp: later, ExpiryDate: later.Add(time.Hour), PlatformName: "p",
+	})
+	if !errors.Is(err, ErrFutureTimestamp) {
+		t.Fatalf("future insert: err=%v, want ErrFutureTimestamp", err)
+	}
+}
+
+func TestScheduledTransactions(t *testing.T) {
+	pool := testPool(t)
+	sched := NewScheduledRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "sched")
+
+	in := models.ScheduledTransaction{CoinID: "BTC", UserID: "alice", DataID: "d", CoinUsed: 1, PlatformName: "p"}
+	in.ExecuteAt = time.Now().Add(-time.Minute)
+	if _, err := sched.Schedule(ctx, in, "tester"); !errors.Is(err, ErrInvalidSchedule) {
+		t.Fatalf("past executeAt: err=%v, want ErrInvalidSchedule", err)
+	}
+	in.ExecuteAt = time.Now().Add(time.Hour)
+	exp := in.ExecuteAt.Add(24 * time.Hour)
+	in.ExpiryDate = &exp
+	a, err := sched.Schedule(ctx, in, "tester")
+	if err != nil {
+		t.Fatalf("schedule: %v", err)
+	}
+	b, err := sched.Schedule(ctx, in, "tester")
+	if err != nil {
+		t.Fatalf("schedule: %v", err)
+	}
+	if a.Status != models.ScheduledPending || a.CreatedBy != "tester" {
+		t.Fatalf("scheduled = %+v", a)
+	}
+	if _, err := sched.Get(ctx, b.ID, "mallory"); !errors.Is(err, pgx.ErrNoRows) {
+		t.Fatalf("get by non-owner: err=%v, want ErrNoRows", err)
+	}
+	if list, err := sched.List(ctx, "mallory", "", "", 0, 0); err != nil || len(list) != 0 {
+		t.Fatalf("list by non-owner: %d rows, %v", len(list), err)
+	}
+	if list, err := sched.List(ctx, "alice", "", "", 0, 0); err != nil || len(list) != 2 {
+		t.Fatalf("list by owner: %d rows, %v", len(list), err)
+	}
+	if _, err := sched.Cancel(ctx, b.ID, "mallory"); !errors.Is(err, pgx.ErrNoRows) {
+		t.Fatalf("cancel by non-owner: err=%v, want ErrNoRows", err)
+	}
+	if _, err := sched.Cancel(ctx, b.ID, "tester"); err != nil {
+		t.Fatalf("cancel: %v", err)
+	}
+	if _, err := sched.Cancel(ctx, b.ID, ""); !errors.Is(err, ErrInvalidSchedule) {
+		t.Fatalf("cancel twice: err=%v", err)
+	}
+
+	// Not due yet: nothing happens.
+	if res, err := pool.ExecuteScheduled(ctx, a.ID); err != nil || res != "" {
+		t.Fatalf("execute early: %q, %v", res, err)
+	}
+	if err := pool.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, "UPDATE scheduled_transactions SET execute_at = now() WHERE id = ANY($1)", []string{a.ID, b.ID})
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if res, err := pool.ExecuteScheduled(ctx, b.ID); err != nil || res != "" {
+		t.Fatalf("execute cancelled: %q, %v", res, err)
+	}
+	res, err := pool.ExecuteScheduled(ctx, a.ID)
+	if err != nil || res != models.ScheduledExecuted {
+		t.Fatalf("execute: %q, %v", res, err)
+	}
+	got, err := sched.Get(ctx, a.ID, "")
+	if err != nil || got.TransactionID == nil {
+		t.Fatalf("after execute: %+v, %v", got, err)
+	}
+	tx, err := NewTransactionRepo(pool).GetByID(ctx, *got.TransactionID, false)
+	if err != nil || !tx.ExpiryDate.Equal(exp.Truncate(time.Microsecond)) {
+		t.Fatalf("executed transaction = %+v, %v", tx, err)
+	}
+
+	// Checks run at execution time.
+	c, err := sched.Schedule(ctx, in, "tester")
+	if err != nil {
+		t.Fatalf("schedule: %v", err)
+	}
+	if _, err := NewCoinRepo(pool).Set(ctx, models.Coin{ID: "BTC", Active: false, Precision: 8}); err != nil {
+		t.Fatal(err)
+	}
+	if err := pool.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, "UPDATE scheduled_transactions SET execute_at = now() WHERE id = $1", c.ID)
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if res, err := pool.ExecuteScheduled(ctx, c.ID); err != nil || res != models.ScheduledFailed {
+		t.Fatalf("execute with inactive coin: %q, %v", res, err)
+	}
+	if got, _ := sched.Get(ctx, c.ID, ""); got.Error == "" {
+		t.Fatalf("failed execution without error: %+v", got)
+	}
+}
+
+// cancellingHook cancels the executing context partway through the insert,
+// as a worker shutdown would.
+type cancellingHook struct{ cancel context.CancelFunc }
+
+func (h cancellingHook) PreValidate(context.Context, models.Transaction) error {
+	h.cancel()
+	return nil
+}
+func (cancellingHook) PreCommit(context.Context, pgx.Tx, models.Transaction) error { return nil }
+func (cancellingHook) PostCommit(context.Context, models.Transaction)              {}
+
+func TestExecuteScheduledKeepsPendingOnTransientErrors(t *testing.T) {
+	pool := testPool(t)
+	sched := NewScheduledRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "sched-retry")
+
+	s, err := sched.Schedule(ctx, models.ScheduledTransaction{CoinID: "BTC", UserID: "alice", DataID: "d", CoinUsed: 1,
+		PlatformName: "p", ExecuteAt: time.Now().Add(time.Hour)}, "alice")
+	if err != nil {
+		t.Fatalf("schedule: %v", err)
+	}
+	if err := pool.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, "UPDATE scheduled_transactions SET execute_at = now() WHERE id = $1", s.ID)
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	pool.AddHook(cancellingHook{cancel: cancel})
+	if res, err := pool.ExecuteScheduled(runCtx, s.ID); err == nil || res != "" {
+		t.Fatalf("execute with cancelled context: %q, %v", res, err)
+	}
+	got, err := sched.Get(ctx, s.ID, "")
+	if err != nil || got.Status != models.ScheduledPending || got.Error != "" {
+		t.Fatalf("after cancelled execution: %+v, %v", got, err)
+	}
+}
+
+// failingHook rejects every transaction with a plain error, which is not
+// one of the rejections ExecuteScheduled records as failed.
+type failingHook struct{}
+
+func (failingHook) PreValidate(context.Context, models.Transaction) error {
+	return errors.New("hook unavailable")
+}
+func (failingHook) PreCommit(context.Context, pgx.Tx, models.Transaction) error { return nil }
+func (failingHook) PostCommit(context.Context, models.Transaction)              {}
+
+func TestExecuteScheduledGivesUpAfterRepeatedErrors(t *testing.T) {
+	pool := testPool(t)
+	sched := NewScheduledRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "sched-poison")
+
+	s, err := sched.Schedule(ctx, models.ScheduledTransaction{CoinID: "BTC", UserID: "alice", DataID: "d", CoinUsed: 1,
+		PlatformName: "p", ExecuteAt: time.Now().Add(time.Hour)}, "alice")
+	if err != nil {
+		t.Fatalf("schedule: %v", err)
+	}
+	if err := pool.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, "UPDATE scheduled_transactions SET execute_at = now() WHERE id = $1", s.ID)
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	pool.AddHook(failingHook{})
+	for i := 1; i < maxExecuteAttempts; i++ {
+		if res, err := pool.ExecuteScheduled(ctx, s.ID); err == nil || res != "" {
+			t.Fatalf("attempt %d: %q, %v; want an error", i, res, err)
+		}
+		got, err := sched.Get(ctx, s.ID, "")
+		if err != nil || got.Status != models.ScheduledPending || got.Error == "" {
+			t.Fatalf("after attempt %d: %+v, %v", i, got, err)
+		}
+	}
+	if res, err := pool.ExecuteScheduled(ctx, s.ID); err != nil || res != models.ScheduledFailed {
+		t.Fatalf("last attempt: %q, %v; want failed", res, err)
+	}
+	if got, err := sched.Get(ctx, s.ID, ""); err != nil || got.Status != models.ScheduledFailed {
+		t.Fatalf("after last attempt: %+v, %v", got, err)
+	}
+}
@@ -0,0 +1,205 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// CapExceededError is returned by TransactionRepo.Insert when a
+// transaction would take the user over one of their spending caps.
+type CapExceededError struct {
+	Cap       models.SpendingCap
+	Spent     float64
+	Requested float64
+}
+
+func (e *CapExceededError) Error() string {
+	scope := "all coins"
+	if e.Cap.CoinID != "" {
+		scope = "coin " + e.Cap.CoinID
+	}
+	if e.Cap.PlatformName != "" {
+		scope += " on " + e.Cap.PlatformName
+	}
+	return fmt.Sprintf("%s spending cap of %g for %s exceeded: spent %g, requested %g, remaining %g",
+		e.Cap.Period, e.Cap.Amount, scope, e.Spent, e.Requested, e.Cap.Amount-e.Spent)
+}
+
+const capColumns = "id, userid, coinid, platformName, period, amount, updated_at"
+
+func scanCap(row pgx.Row) (*models.SpendingCap, error) {
+	var c models.SpendingCap
+	if err := row.Scan(&c.ID, &c.UserID, &c.CoinID, &c.PlatformName, &c.Period, &c.Amount, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+type CapRepo struct {
+	pool *Pool
+}
+
+func NewCapRepo(pool *Pool) *CapRepo {
+	return &CapRepo{pool: pool}
+}
+
+// Set creates or replaces the cap identified by (userid, coinid,
+// platformName, period) within the tenant in ctx.
+func (r *CapRepo) Set(ctx context.Context, c models.SpendingCap) (*models.SpendingCap, error) {
+	if _, ok := models.CapWindow(c.Period); !ok {
+		return nil, fmt.Errorf("unknown cap period %q", c.Period)
+	}
+	if c.Amount < 0 {
+		return nil, fmt.Errorf("cap amount must be non-negative")
+	}
+	var out *models.SpendingCap
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		q := `
+			INSERT INTO spending_caps (userid, coinid, platformName, period, amount, tenant_id)
+			VALUES ($1,$2,$3,$4,$5,$6)
+			ON CONFLICT (tenant_id, userid, coinid, platformName, period)
+			DO UPDATE SET amount = EXCLUDED.amount, updated_at = now()
+			RETURNING ` + capColumns
+		var err error
+		out, err = scanCap(tx.QueryRow(ctx, q, c.UserID, c.CoinID, c.PlatformName, c.Period, c.Amount, tenant))
+		return err
+	})
+	return out, err
+}
+
+// List returns all caps configured for a user.
+func (r *CapRepo) List(ctx context.Context, userID string) ([]models.SpendingCap, error) {
+	var out []models.SpendingCap
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		rows, err := tx.Query(ctx, "SELECT "+capColumns+" FROM spending_caps WHERE tenant_id = $1 AND userid = $2 ORDER BY period, coinid, platformName", tenant, userID)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			c, err := scanCap(rows)
+			if err != nil {
+				return err
+			}
+			out = append(out, *c)
+		}
+		return rows.Err()
+	})
+	return out, err
+}
+
+// Clear removes a cap and reports whether it existed.
+func (r *CapRepo) Clear(ctx context.Context, userID, coinID, platformName, period string) (bool, error) {
+	var cleared bool
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		tag, err := tx.Exec(ctx, `
+			DELETE FROM spending_caps
+			WHERE tenant_id = $1 AND userid = $2 AND coinid = $3 AND platformName = $4 AND period = $5`,
+			tenant, userID, coinID, platformName, period)
+		cleared = tag.RowsAffected() > 0
+		return err
+	})
+	return cleared, err
+}
+
+// Remaining reports the budget left under every cap that would apply to a
+// spend of coinID on platformName (empty strings match any cap scope).
+func (r *CapRepo) Remaining(ctx context.Context, userID, coinID, platformName string) ([]models.Budget, error) {
+	var out []models.Budget
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		var err error
+		out, err = budgets(ctx, tx, tenant, userID, coinID, platformName)
+		return err
+	})
+	return out, err
+}
+
+// querier is satisfied by both *Pool and pgx.Tx.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// budgets sums spend over each cap's window by server insertion time, so
+// backdating transactionTimestamp cannot move a spend out of the window.
+func budgets(ctx context.Context, q querier, tenant, userID, coinID, platformName string) ([]models.Budget, error) {
+	rows, err := q.Query(ctx, `
+		SELECT `+capColumns+`, now()
+		FROM spending_caps
+		WHERE tenant_id = $4
+		  AND userid = $1
+		  AND ($2 = '' OR coinid IN ('', $2))
+		  AND ($3 = '' OR platformName IN ('', $3))`,
+		userID, coinID, platformName, tenant)
+	if err != nil {
+		return nil, err
+	}
+	var caps []models.SpendingCap
+	var now time.Time
+	for rows.Next() {
+		var c models.SpendingCap
+		if err := rows.Scan(&c.ID, &c.UserID, &c.CoinID, &c.PlatformName, &c.Period, &c.Amount, &c.UpdatedAt, &now); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		caps = append(caps, c)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	out := make([]models.Budget, 0, len(caps))
+	for _, c := range caps {
+		window, _ := models.CapWindow(c.Period)
+		start := now.Add(-window)
+		var spent float64
+		if err := q.QueryRow(ctx, `
+			SELECT COALESCE(SUM(coinused), 0)
+			FROM transactions
+			WHERE tenant_id = $5
+			  AND userid = $1
+			  AND deleted_at IS NULL
+			  AND status <> 'voided'
+			  AND NOT (status = 'authorized' AND hold_expires_at IS NOT NULL AND hold_expires_at <= now())
+			  AND ($2 = '' OR coinid = $2)
+			  AND ($3 = '' OR platformName = $3)
+			  AND created_at > $4`,
+			c.UserID, c.CoinID, c.PlatformName, start, tenant).Scan(&spent); err != nil {
+			return nil, err
+		}
+		out = append(out, models.Budget{Cap: c, Spent: spent, Remaining: c.Amount - spent, WindowStart: start})
+	}
+	return out, nil
+}
+
+// lockUser serializes writes that depend on a user's spending history
+// until tx ends.
+func lockUser(ctx context.Context, tx pgx.Tx, tenant, userID string) error {
+	_, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtextextended('spend:' || $1 || ':' || $2, 0))", tenant, userID)
+	return err
+}
+
+// enforceCaps must run inside the inserting transaction. It serializes
+// concurrent spends by the same user with an advisory lock so two requests
+// cannot both fit under the cap.
+func enforceCaps(ctx context.Context, tx pgx.Tx, t models.Transaction) error {
+	if err := lockUser(ctx, tx, t.TenantID, t.UserID); err != nil {
+		return err
+	}
+	bs, err := budgets(ctx, tx, t.TenantID, t.UserID, t.CoinID, t.PlatformName)
+	if err != nil {
+		return err
+	}
+	for _, b := range bs {
+		// Written so that a NaN amount or total fails closed.
+		if !(b.Spent+t.CoinUsed <= b.Cap.Amount) {
+			return &CapExceededError{Cap: b.Cap, Spent: b.Spent, Requested: t.CoinUsed}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package db
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+)
+
+func TestCapsCountBackdatedSpends(t *testing.T) {
+	pool := testPool(t)
+	repo := NewTransactionRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "caps")
+	if _, err := NewCapRepo(pool).Set(ctx, models.SpendingCap{UserID: "u", Period: models.CapPeriodDaily, Amount: 10}); err != nil {
+		t.Fatalf("set cap: %v", err)
+	}
+
+	// Dated a year back, but recorded now: it must count towards today.
+	old := time.Now().UTC().AddDate(-1, 0, 0)
+	spend := models.Transaction{CoinID: "BTC", UserID: "u", DataID: "d1", CoinUsed: 8,
+		TransactionTimestamp: old, ExpiryDate: old.Add(time.Hour), PlatformName: "p"}
+	if _, err := repo.Insert(ctx, spend); err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+	spend.DataID = "d2"
+	var capErr *CapExceededError
+	if _, err := repo.Insert(ctx, spend); !errors.As(err, &capErr) {
+		t.Fatalf("second backdated spend: err = %v, want CapExceededError", err)
+	}
+}
+
+func TestInsertRejectsNonFiniteAmounts(t *testing.T) {
+	pool := testPool(t)
+	repo := NewTransactionRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "amounts")
+
+	now := time.Now().UTC()
+	for _, v := range []float64{math.NaN(), math.Inf(1), math.Inf(-1), -1} {
+		_, err := repo.Insert(ctx, models.Transaction{CoinID: "BTC", UserID: "u", DataID: "d", CoinUsed: v,
+			TransactionTimestamp: now, ExpiryDate: now.Add(time.Hour), PlatformName: "p"})
+		if !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("coinused %v: err = %v, want ErrInvalidAmount", v, err)
+		}
+	}
+	_, err := NewScheduledRepo(pool).Schedule(ctx, models.ScheduledTransaction{CoinID: "BTC", UserID: "u", DataID: "d",
+		CoinUsed: math.NaN(), PlatformName: "p", ExecuteAt: now.Add(time.Hour)}, "u")
+	if !errors.Is(err, ErrInvalidSchedule) {
+		t.Errorf("schedule NaN: err = %v, want ErrInvalidSchedule", err)
+	}
+}
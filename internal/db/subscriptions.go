@@ -0,0 +1,520 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrInvalidSubscription wraps validation failures of SubscriptionRepo.
+var ErrInvalidSubscription = errors.New("invalid subscription")
+
+func invalidSubscription(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidSubscription, fmt.Sprintf(format, args...))
+}
+
+// RetryPolicy decides what happens after a failed recurring charge: it is
+// retried every RetryInterval until GracePeriod after the missed due date,
+// then the subscription lapses.
+type RetryPolicy struct {
+	RetryInterval time.Duration
+	GracePeriod   time.Duration
+}
+
+// SubscriptionRepo manages plans and subscriptions of the tenant in ctx.
+type SubscriptionRepo struct {
+	pool *Pool
+}
+
+func NewSubscriptionRepo(pool *Pool) *SubscriptionRepo {
+	return &SubscriptionRepo{pool: pool}
+}
+
+const planColumns = "id, name, platformName, dataid, coinid, amount, period, active, created_at"
+
+func scanPlan(row pgx.Row) (*models.SubscriptionPlan, error) {
+	var p models.SubscriptionPlan
+	if err := row.Scan(&p.ID, &p.Name, &p.PlatformName, &p.DataID, &p.CoinID, &p.Amount, &p.Period, &p.Active, &p.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+const subscriptionColumns = `id, plan_id, userid, status, started_at, cycle, next_charge_at, failed_attempts,
+	grace_until, last_error, created_at, updated_at`
+
+func scanSubscription(row pgx.Row) (*models.Subscription, error) {
+	var s models.Subscription
+	if err := row.Scan(&s.ID, &s.PlanID, &s.UserID, &s.Status, &s.StartedAt, &s.Cycle, &s.NextChargeAt, &s.FailedAttempts,
+		&s.GraceUntil, &s.LastError, &s.CreatedAt, &s.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func addSubscriptionEvent(ctx context.Context, tx pgx.Tx, tenant, subID, typ string, txID *string, detail string) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO subscription_events (tenant_id, subscription_id, type, transaction_id, detail)
+		VALUES ($1,$2,$3,$4,$5)`, tenant, subID, typ, txID, detail)
+	return err
+}
+
+// CreatePlan registers a plan after checking its coin and platform.
+func (r *SubscriptionRepo) CreatePlan(ctx context.Context, p models.SubscriptionPlan) (*models.SubscriptionPlan, error) {
+	switch {
+	case strings.TrimSpace(p.Name) == "":
+		return nil, invalidSubscription("plan name is required")
+	case p.PlatformName == "" || p.DataID == "" || p.CoinID == "":
+		return nil, invalidSubscription("platformName, dataid and coinid are required")
+	case p.Amount < 0:
+		return nil, invalidSubscription("amount must be non-negative")
+	}
+	if _, ok := models.ChargeDate(time.Now(), p.Period, 1); !ok {
+		return nil, invalidSubscription("unknown period %q", p.Period)
+	}
+	var out *models.SubscriptionPlan
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		coin, err := getCoin(ctx, tx, tenant, p.CoinID)
+		if err != nil {
+			return err
+		}
+		if err := checkCoin(coin, p.CoinID, p.Amount); err != nil {
+			return err
+		}
+		platform, err := getPlatform(ctx, tx, tenant, p.PlatformName)
+		if err != nil {
+			return err
+		}
+		// The expiry is set so that only the platform and coin are checked.
+		probe := models.Transaction{PlatformName: p.PlatformName, CoinID: p.CoinID, ExpiryDate: time.Now()}
+		if err := applyPlatform(platform, &probe); err != nil {
+			return err
+		}
+		out, err = scanPlan(tx.QueryRow(ctx, `
+			INSERT INTO subscription_plans (tenant_id, name, platformName, dataid, coinid, amount, period, active)
+			VALUES ($1,$2,$3,$4,$5,$6,$7,$8)
+			RETURNING `+planColumns,
+			tenant, p.Name, p.PlatformName, p.DataID, p.CoinID, p.Amount, p.Period, p.Active))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetPlanActive opens or closes a plan to new subscribers; existing
+// subscriptions keep being charged. Unknown ids return pgx.ErrNoRows.
+func (r *SubscriptionRepo) SetPlanActive(ctx context.Context, id string, active bool) (*models.SubscriptionPlan, error) {
+	var out *models.SubscriptionPlan
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		var err error
+		out, err = scanPlan(tx.QueryRow(ctx, `
+			UPDATE subscription_plans SET active = $3
+			WHERE tenant_id = $1 AND id = $2
+			RETURNING `+planColumns, tenant, id, active))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListPlans returns plans by name, optionally including inactive ones.
+func (r *SubscriptionRepo) ListPlans(ctx context.Context, includeInactive bool) ([]models.SubscriptionPlan, error) {
+	var out []models.SubscriptionPlan
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		rows, err := tx.Query(ctx, `
+			SELECT `+planColumns+` FROM subscription_plans
+			WHERE tenant_id = $1 AND ($2 OR active)
+			ORDER BY name, id`, tenant, includeInactive)
+		if err != nil {
+			return err
+		}
+		out, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.SubscriptionPlan, error) {
+			p, err := scanPlan(row)
+			if err != nil {
+				return models.SubscriptionPlan{}, err
+			}
+			return *p, nil
+		})
+		return err
+	})
+	return out, err
+}
+
+// chargeSubscription charges the subscription's next cycle through the
+// normal insert path. The transaction grants access until the following
+// charge date.
+func (p *Pool) chargeSubscription(ctx context.Context, tx pgx.Tx, tenant string, plan *models.SubscriptionPlan, sub *models.Subscription, now time.Time) (*models.Transaction, error) {
+	until, _ := models.ChargeDate(sub.StartedAt, plan.Period, sub.Cycle+1)
+	return p.createTransaction(ctx, tx, models.Transaction{
+		CoinID:               plan.CoinID,
+		UserID:               sub.UserID,
+		DataID:               plan.DataID,
+		CoinUsed:             plan.Amount,
+		TransactionTimestamp: now,
+		ExpiryDate:           until,
+		PlatformName:         plan.PlatformName,
+		TenantID:             tenant,
+		Status:               models.StatusCaptured,
+	})
+}
+
+// Subscribe charges the first period of an active plan and starts the
+// subscription. Nothing is stored if the charge fails.
+func (r *SubscriptionRepo) Subscribe(ctx context.Context, planID, userID string) (*models.Subscription, *models.Transaction, error) {
+	if strings.TrimSpace(userID) == "" {
+		return nil, nil, invalidSubscription("userid is required")
+	}
+	var sub *models.Subscription
+	var charge *models.Transaction
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		plan, err := scanPlan(tx.QueryRow(ctx,
+			"SELECT "+planColumns+" FROM subscription_plans WHERE tenant_id = $1 AND id = $2", tenant, planID))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return invalidSubscription("unknown plan %q", planID)
+		}
+		if err != nil {
+			return err
+		}
+		if !plan.Active {
+			return invalidSubscription("plan %q is not open to new subscribers", plan.Name)
+		}
+		if err := lockUser(ctx, tx, tenant, userID); err != nil {
+			return err
+		}
+		var exists bool
+		if err := tx.QueryRow(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM subscriptions
+				WHERE tenant_id = $1 AND plan_id = $2 AND userid = $3 AND status IN ('active', 'past_due', 'paused'))`,
+			tenant, planID, userID).Scan(&exists); err != nil {
+			return err
+		}
+		if exists {
+			return invalidSubscription("user %q is already subscribed to plan %q", userID, plan.Name)
+		}
+
+		now := time.Now().UTC()
+		next, _ := models.ChargeDate(now, plan.Period, 1)
+		sub, err = scanSubscription(tx.QueryRow(ctx, `
+			INSERT INTO subscriptions (tenant_id, plan_id, userid, status, started_at, cycle, next_charge_at)
+			VALUES ($1,$2,$3,'active',$4,1,$5)
+			RETURNING `+subscriptionColumns, tenant, planID, userID, now, next))
+		if err != nil {
+			return err
+		}
+		first := *sub
+		first.Cycle = 0
+		if charge, err = r.pool.chargeSubscription(ctx, tx, tenant, plan, &first, now); err != nil {
+			return err
+		}
+		return addSubscriptionEvent(ctx, tx, tenant, sub.ID, models.EventCharged, &charge.ID, "")
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	r.pool.postCommit(ctx, charge)
+	return sub, charge, nil
+}
+
+// setSubscriptionStatus moves a subscription in one of the from statuses
+// to status to and records event. A non-empty owner limits it as in Get.
+func (r *SubscriptionRepo) setSubscriptionStatus(ctx context.Context, id, owner, to, event string, from ...string) (*models.Subscription, error) {
+	var out *models.Subscription
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		cur, err := scanSubscription(tx.QueryRow(ctx,
+			"SELECT "+subscriptionColumns+" FROM subscriptions WHERE tenant_id = $1 AND id = $2 AND ($3 = '' OR userid = $3) FOR UPDATE",
+			tenant, id, owner))
+		if err != nil {
+			return err
+		}
+		allowed := false
+		for _, s := range from {
+			allowed = allowed || cur.Status == s
+		}
+		if !allowed {
+			return invalidSubscription("subscription is %s", cur.Status)
+		}
+		// Periods that passed while paused are not charged: a resumed
+		// subscription whose charge date passed starts a new schedule now,
+		// and the next worker run charges one period from today.
+		if to == models.SubscriptionActive && cur.Status == models.SubscriptionPaused && cur.NextChargeAt.Before(time.Now()) {
+			if _, err := tx.Exec(ctx, `
+				UPDATE subscriptions
+				SET started_at = now(), cycle = 0, next_charge_at = now(), failed_attempts = 0, grace_until = NULL
+				WHERE tenant_id = $1 AND id = $2`, tenant, id); err != nil {
+				return err
+			}
+		}
+		out, err = scanSubscription(tx.QueryRow(ctx, `
+			UPDATE subscriptions SET status = $3, updated_at = now()
+			WHERE tenant_id = $1 AND id = $2
+			RETURNING `+subscriptionColumns, tenant, id, to))
+		if err != nil {
+			return err
+		}
+		return addSubscriptionEvent(ctx, tx, tenant, id, event, nil, "")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Cancel stops future charges; access already paid for is kept.
+func (r *SubscriptionRepo) Cancel(ctx context.Context, id, owner string) (*models.Subscription, error) {
+	return r.setSubscriptionStatus(ctx, id, owner, models.SubscriptionCancelled, models.EventCancelled,
+		models.SubscriptionActive, models.SubscriptionPastDue, models.SubscriptionPaused)
+}
+
+// Pause suspends charges until Resume.
+func (r *SubscriptionRepo) Pause(ctx context.Context, id, owner string) (*models.Subscription, error) {
+	return r.setSubscriptionStatus(ctx, id, owner, models.SubscriptionPaused, models.EventPaused,
+		models.SubscriptionActive, models.SubscriptionPastDue)
+}
+
+// Resume reactivates a paused subscription. Periods missed while paused
+// are skipped rather than charged.
+func (r *SubscriptionRepo) Resume(ctx context.Context, id, owner string) (*models.Subscription, error) {
+	return r.setSubscriptionStatus(ctx, id, owner, models.SubscriptionActive, models.EventResumed, models.SubscriptionPaused)
+}
+
+// Get returns a subscription or pgx.ErrNoRows. A non-empty owner limits
+// it to subscriptions of that user; Cancel, Pause, Resume, List and Events
+// take an owner in the same way.
+func (r *SubscriptionRepo) Get(ctx context.Context, id, owner string) (*models.Subscription, error) {
+	var out *models.Subscription
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		var err error
+		out, err = scanSubscription(tx.QueryRow(ctx,
+			"SELECT "+subscriptionColumns+" FROM subscriptions WHERE tenant_id = $1 AND id = $2 AND ($3 = '' OR userid = $3)",
+			tenant, id, owner))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// List returns subscriptions newest first, optionally of one user and/or
+// in one status.
+func (r *SubscriptionRepo) List(ctx context.Context, owner, userID, status string) ([]models.Subscription, error) {
+	var out []models.Subscription
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		rows, err := tx.Query(ctx, `
+			SELECT `+subscriptionColumns+` FROM subscriptions
+			WHERE tenant_id = $1 AND ($2 = '' OR userid = $2) AND ($3 = '' OR status = $3) AND ($4 = '' OR userid = $4)
+			ORDER BY created_at DESC, id
+			LIMIT 1000`, tenant, userID, status, owner)
+		if err != nil {
+			return err
+		}
+		out, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.Subscription, error) {
+			s, err := scanSubscription(row)
+			if err != nil {
+				return models.Subscription{}, err
+			}
+			return *s, nil
+		})
+		return err
+	})
+	return out, err
+}
+
+const eventColumns = `e.id, e.subscription_id, p.platformName, s.userid, e.type, e.transaction_id, e.detail, e.created_at, e.delivered_at`
+
+const eventFrom = `
+	FROM subscription_events e
+	JOIN subscriptions s ON s.id = e.subscription_id
+	JOIN subscription_plans p ON p.id = s.plan_id`
+
+func scanEvent(row pgx.Row) (models.SubscriptionEvent, error) {
+	var e models.SubscriptionEvent
+	err := row.Scan(&e.ID, &e.SubscriptionID, &e.PlatformName, &e.UserID, &e.Type, &e.TransactionID, &e.Detail, &e.CreatedAt, &e.DeliveredAt)
+	return e, err
+}
+
+// Events returns a subscription's events, oldest first.
+func (r *SubscriptionRepo) Events(ctx context.Context, subscriptionID, owner string) ([]models.SubscriptionEvent, error) {
+	var out []models.SubscriptionEvent
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		rows, err := tx.Query(ctx, `SELECT `+eventColumns+eventFrom+`
+			WHERE e.tenant_id = $1 AND e.subscription_id = $2 AND ($3 = '' OR s.userid = $3)
+			ORDER BY e.id`, tenant, subscriptionID, owner)
+		if err != nil {
+			return err
+		}
+		out, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.SubscriptionEvent, error) {
+			return scanEvent(row)
+		})
+		return err
+	})
+	return out, err
+}
+
+// DueSubscriptions returns ids of subscriptions of every tenant whose
+// next charge is due, oldest first.
+func (p *Pool) DueSubscriptions(ctx context.Context, limit int) ([]string, error) {
+	var out []string
+	err := p.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `
+			SELECT id::text FROM subscriptions
+			WHERE status IN ('active', 'past_due') AND next_charge_at <= now()
+			ORDER BY next_charge_at
+			LIMIT $1`, limit)
+		if err != nil {
+			return err
+		}
+		out, err = pgx.CollectRows(rows, pgx.RowTo[string])
+		return err
+	})
+	return out, err
+}
+
+// ChargeSubscription makes the due charge of one subscription and returns
+// the resulting event type, or "" when the subscription is no longer due
+// or is being charged by another worker. A rejected charge is retried per
+// policy and lapses the subscription once its grace period is over; other
+// errors are returned and leave the subscription untouched.
+func (p *Pool) ChargeSubscription(ctx context.Context, id string, policy RetryPolicy) (string, error) {
+	var event string
+	var charged *models.Transaction
+	err := p.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+		var tenant string
+		row := tx.QueryRow(ctx, `
+			SELECT tenant_id, `+subscriptionColumns+`
+			FROM subscriptions
+			WHERE id = $1 AND status IN ('active', 'past_due') AND next_charge_at <= now()
+			FOR UPDATE SKIP LOCKED`, id)
+		var sub models.Subscription
+		err := row.Scan(&tenant, &sub.ID, &sub.PlanID, &sub.UserID, &sub.Status, &sub.StartedAt, &sub.Cycle, &sub.NextChargeAt,
+			&sub.FailedAttempts, &sub.GraceUntil, &sub.LastError, &sub.CreatedAt, &sub.UpdatedAt)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		plan, err := scanPlan(tx.QueryRow(ctx, "SELECT "+planColumns+" FROM subscription_plans WHERE id = $1", sub.PlanID))
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		// The charge runs in a savepoint so a rejected insert leaves tx
+		// usable for recording the failure.
+		sp, err := tx.Begin(ctx)
+		if err != nil {
+			return err
+		}
+		charge, chargeErr := p.chargeSubscription(ctx, sp, tenant, plan, &sub, now)
+		if chargeErr == nil {
+			if err := sp.Commit(ctx); err != nil {
+				return err
+			}
+			next, _ := models.ChargeDate(sub.StartedAt, plan.Period, sub.Cycle+1)
+			if _, err := tx.Exec(ctx, `
+				UPDATE subscriptions
+				SET status = 'active', cycle = cycle + 1, next_charge_at = $2, failed_attempts = 0,
+				    grace_until = NULL, last_error = '', updated_at = now()
+				WHERE id = $1`, sub.ID, next); err != nil {
+				return err
+			}
+			event, charged = models.EventCharged, charge
+			return addSubscriptionEvent(ctx, tx, tenant, sub.ID, event, &charge.ID, "")
+		}
+		// Only rejections count as failed charges; anything else, such as a
+		// cancelled context or a lost connection, leaves the subscription
+		// due for the next run.
+		if !rejected(chargeErr) {
+			return chargeErr
+		}
+		if err := sp.Rollback(ctx); err != nil {
+			return err
+		}
+
+		grace := sub.NextChargeAt.Add(policy.GracePeriod)
+		if sub.GraceUntil != nil {
+			grace = *sub.GraceUntil
+		}
+		status, retryAt := models.SubscriptionPastDue, now.Add(policy.RetryInterval)
+		event = models.EventChargeFailed
+		if !retryAt.Before(grace) {
+			status, retryAt = models.SubscriptionLapsed, sub.NextChargeAt
+			event = models.EventLapsed
+		}
+		if _, err := tx.Exec(ctx, `
+			UPDATE subscriptions
+			SET status = $2, next_charge_at = $3, failed_attempts = failed_attempts + 1,
+			    grace_until = $4, last_error = $5, updated_at = now()
+			WHERE id = $1`, sub.ID, status, retryAt, grace, chargeErr.Error()); err != nil {
+			return err
+		}
+		return addSubscriptionEvent(ctx, tx, tenant, sub.ID, event, nil, chargeErr.Error())
+	})
+	if err != nil {
+		return "", err
+	}
+	p.postCommit(ctx, charged)
+	return event, nil
+}
+
+// PendingWebhook is an undelivered event of a platform with a webhook.
+type PendingWebhook struct {
+	TenantID string
+	URL      string
+	Secret   string // the platform's webhook secret
+	Event    models.SubscriptionEvent
+	Attempts int
+}
+
+// maxDeliveryAttempts bounds webhook retries per event.
+const maxDeliveryAttempts = 10
+
+// PendingWebhooks returns undelivered events of every tenant whose platform
+// has a webhook URL, oldest first.
+func (p *Pool) PendingWebhooks(ctx context.Context, limit int) ([]PendingWebhook, error) {
+	var out []PendingWebhook
+	err := p.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `
+			SELECT e.tenant_id, pl.webhook_url, pl.webhook_secret, e.delivery_attempts, `+eventColumns+eventFrom+`
+			JOIN platforms pl ON pl.tenant_id = p.tenant_id AND pl.name = p.platformName
+			WHERE e.delivered_at IS NULL AND e.delivery_attempts < $1 AND pl.webhook_url <> ''
+			ORDER BY e.id
+			LIMIT $2`, maxDeliveryAttempts, limit)
+		if err != nil {
+			return err
+		}
+		out, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (PendingWebhook, error) {
+			var w PendingWebhook
+			e := &w.Event
+			err := row.Scan(&w.TenantID, &w.URL, &w.Secret, &w.Attempts, &e.ID, &e.SubscriptionID, &e.PlatformName, &e.UserID, &e.Type,
+				&e.TransactionID, &e.Detail, &e.CreatedAt, &e.DeliveredAt)
+			return w, err
+		})
+		return err
+	})
+	return out, err
+}
+
+// MarkWebhook records a delivery attempt of an event; delivered marks it
+// done.
+func (p *Pool) MarkWebhook(ctx context.Context, eventID int64, delivered bool) error {
+	return p.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `
+			UPDATE subscription_events
+			SET delivery_attempts = delivery_attempts + 1,
+			    delivered_at = CASE WHEN $2 THEN now() END
+			WHERE id = $1`, eventID, delivered)
+		return err
+	})
+}
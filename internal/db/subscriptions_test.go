@@ -0,0 +1,266 @@
+package db
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+func TestChargeDate(t *testing.T) {
+	jan31 := time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC)
+	cases := []struct {
+		period string
+		n      int
+		want   time.Time
+	}{
+		{models.PlanPeriodDaily, 2, time.Date(2024, 2, 2, 10, 0, 0, 0, time.UTC)},
+		{models.PlanPeriodWeekly, 1, time.Date(2024, 2, 7, 10, 0, 0, 0, time.UTC)},
+		{models.PlanPeriodMonthly, 1, time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC)},
+		{models.PlanPeriodMonthly, 2, time.Date(2024, 3, 31, 10, 0, 0, 0, time.UTC)},
+		{models.PlanPeriodYearly, 1, time.Date(2025, 1, 31, 10, 0, 0, 0, time.UTC)},
+	}
+	for _, c := range cases {
+		got, ok := models.ChargeDate(jan31, c.period, c.n)
+		if !ok || !got.Equal(c.want) {
+			t.Errorf("ChargeDate(%s, %d) = %v, %v; want %v", c.period, c.n, got, ok, c.want)
+		}
+	}
+	if _, ok := models.ChargeDate(jan31, "hourly", 1); ok {
+		t.Error("unknown period accepted")
+	}
+}
+
+func TestSubscriptionCharges(t *testing.T) {
+	pool := testPool(t)
+	subs := NewSubscriptionRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "subs")
+	policy := RetryPolicy{RetryInterval: time.Hour, GracePeriod: 3 * time.Hour}
+
+	if _, err := subs.CreatePlan(ctx, models.SubscriptionPlan{Name: "x", PlatformName: "p", DataID: "d", CoinID: "BTC", Amount: 1, Period: "hourly"}); !errors.Is(err, ErrInvalidSubscription) {
+		t.Fatalf("unknown period: err=%v, want ErrInvalidSubscription", err)
+	}
+	plan, err := subs.CreatePlan(ctx, models.SubscriptionPlan{
+		Name: "monthly", PlatformName: "p", DataID: "d", CoinID: "BTC", Amount: 2, Period: models.PlanPeriodMonthly, Active: true,
+	})
+	if err != nil {
+		t.Fatalf("create plan: %v", err)
+	}
+	sub, first, err := subs.Subscribe(ctx, plan.ID, "alice")
+	if err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	if sub.Cycle != 1 || first.CoinUsed != 2 || !first.ExpiryDate.Equal(sub.NextChargeAt) {
+		t.Fatalf("first charge %+v for %+v", first, sub)
+	}
+	if _, _, err := subs.Subscribe(ctx, plan.ID, "alice"); !errors.Is(err, ErrInvalidSubscription) {
+		t.Fatalf("duplicate subscribe: err=%v", err)
+	}
+
+	makeDue := func() {
+		t.Helper()
+		if err := pool.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, "UPDATE subscriptions SET next_charge_at = now() - interval '1 minute' WHERE id = $1", sub.ID)
+			return err
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	makeDue()
+	if ev, err := pool.ChargeSubscription(ctx, sub.ID, policy); err != nil || ev != models.EventCharged {
+		t.Fatalf("charge: %q, %v", ev, err)
+	}
+	if got, _ := subs.Get(ctx, sub.ID, ""); got.Cycle != 2 || got.Status != models.SubscriptionActive {
+		t.Fatalf("after charge: %+v", got)
+	}
+	if ev, err := pool.ChargeSubscription(ctx, sub.ID, policy); err != nil || ev != "" {
+		t.Fatalf("charge before due: %q, %v", ev, err)
+	}
+
+	// A rejected charge is retried until the grace period is over.
+	if _, err := NewCoinRepo(pool).Set(ctx, models.Coin{ID: "BTC", Active: false, Precision: 8}); err != nil {
+		t.Fatal(err)
+	}
+	makeDue()
+	if ev, err := pool.ChargeSubscription(ctx, sub.ID, policy); err != nil || ev != models.EventChargeFailed {
+		t.Fatalf("failed charge: %q, %v", ev, err)
+	}
+	got, _ := subs.Get(ctx, sub.ID, "")
+	if got.Status != models.SubscriptionPastDue || got.FailedAttempts != 1 || got.GraceUntil == nil || got.LastError == "" {
+		t.Fatalf("after failure: %+v", got)
+	}
+	if err := pool.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, "UPDATE subscriptions SET grace_until = now() WHERE id = $1", sub.ID)
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+	makeDue()
+	if ev, err := pool.ChargeSubscription(ctx, sub.ID, policy); err != nil || ev != models.EventLapsed {
+		t.Fatalf("charge after grace: %q, %v", ev, err)
+	}
+	if _, err := subs.Pause(ctx, sub.ID, ""); !errors.Is(err, ErrInvalidSubscription) {
+		t.Fatalf("pause lapsed: err=%v", err)
+	}
+
+	events, err := subs.Events(ctx, sub.ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var types []string
+	for _, e := range events {
+		types = append(types, e.Type)
+	}
+	want := []string{models.EventCharged, models.EventCharged, models.EventChargeFailed, models.EventLapsed}
+	if len(types) != len(want) {
+		t.Fatalf("events = %v, want %v", types, want)
+	}
+	for i := range want {
+		if types[i] != want[i] {
+			t.Fatalf("events = %v, want %v", types, want)
+		}
+	}
+}
+
+func TestSubscriptionPauseCancel(t *testing.T) {
+	pool := testPool(t)
+	subs := NewSubscriptionRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "subs")
+
+	plan, err := subs.CreatePlan(ctx, models.SubscriptionPlan{
+		Name: "weekly", PlatformName: "p", DataID: "d", CoinID: "BTC", Amount: 1, Period: models.PlanPeriodWeekly, Active: true,
+	})
+	if err != nil {
+		t.Fatalf("create plan: %v", err)
+	}
+	sub, _, err := subs.Subscribe(ctx, plan.ID, "bob")
+	if err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	if _, err := subs.Get(ctx, sub.ID, "mallory"); !errors.Is(err, pgx.ErrNoRows) {
+		t.Fatalf("get by another user: err=%v, want ErrNoRows", err)
+	}
+	if list, err := subs.List(ctx, "mallory", "", ""); err != nil || len(list) != 0 {
+		t.Fatalf("list by another user: %d rows, %v", len(list), err)
+	}
+	if events, err := subs.Events(ctx, sub.ID, "mallory"); err != nil || len(events) != 0 {
+		t.Fatalf("events by another user: %d rows, %v", len(events), err)
+	}
+	if _, err := subs.Pause(ctx, sub.ID, "mallory"); !errors.Is(err, pgx.ErrNoRows) {
+		t.Fatalf("pause by another user: err=%v, want ErrNoRows", err)
+	}
+	if got, err := subs.Pause(ctx, sub.ID, "bob"); err != nil || got.Status != models.SubscriptionPaused {
+		t.Fatalf("pause: %+v, %v", got, err)
+	}
+	if got, err := subs.Resume(ctx, sub.ID, ""); err != nil || got.Status != models.SubscriptionActive {
+		t.Fatalf("resume: %+v, %v", got, err)
+	}
+	if got, err := subs.Cancel(ctx, sub.ID, ""); err != nil || got.Status != models.SubscriptionCancelled {
+		t.Fatalf("cancel: %+v, %v", got, err)
+	}
+	if _, err := subs.Resume(ctx, sub.ID, ""); !errors.Is(err, ErrInvalidSubscription) {
+		t.Fatalf("resume cancelled: err=%v", err)
+	}
+	// A cancelled subscription does not block subscribing again.
+	if _, _, err := subs.Subscribe(ctx, plan.ID, "bob"); err != nil {
+		t.Fatalf("resubscribe: %v", err)
+	}
+}
+
+func TestSubscriptionResumeSkipsPausedPeriods(t *testing.T) {
+	pool := testPool(t)
+	subs := NewSubscriptionRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "subs")
+
+	plan, err := subs.CreatePlan(ctx, models.SubscriptionPlan{
+		Name: "weekly", PlatformName: "p", DataID: "d", CoinID: "BTC", Amount: 1, Period: models.PlanPeriodWeekly, Active: true,
+	})
+	if err != nil {
+		t.Fatalf("create plan: %v", err)
+	}
+	sub, _, err := subs.Subscribe(ctx, plan.ID, "carol")
+	if err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	if _, err := subs.Pause(ctx, sub.ID, ""); err != nil {
+		t.Fatalf("pause: %v", err)
+	}
+	// Paused three weeks ago, so two weekly charge dates passed while paused.
+	if err := pool.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `
+			UPDATE subscriptions
+			SET started_at = started_at - interval '3 weeks', next_charge_at = next_charge_at - interval '3 weeks'
+			WHERE id = $1`, sub.ID)
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := subs.Resume(ctx, sub.ID, ""); err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+
+	charges := 0
+	for i := 0; i < 5; i++ {
+		ev, err := pool.ChargeSubscription(ctx, sub.ID, RetryPolicy{RetryInterval: time.Hour, GracePeriod: time.Hour})
+		if err != nil {
+			t.Fatalf("charge: %v", err)
+		}
+		if ev == "" {
+			break
+		}
+		charges++
+	}
+	if charges != 1 {
+		t.Fatalf("%d charges after resume, want 1", charges)
+	}
+	events, err := subs.Events(ctx, sub.ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := events[len(events)-1]
+	if last.Type != models.EventCharged || last.TransactionID == nil {
+		t.Fatalf("last event %+v", last)
+	}
+	charge, err := NewTransactionRepo(pool).GetByID(ctx, *last.TransactionID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !charge.ExpiryDate.After(time.Now().Add(6 * 24 * time.Hour)) {
+		t.Fatalf("charge after resume expires %v, want a week from now", charge.ExpiryDate)
+	}
+}
+
+func TestChargeSubscriptionTransientError(t *testing.T) {
+	pool := testPool(t)
+	subs := NewSubscriptionRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "subs")
+
+	plan, err := subs.CreatePlan(ctx, models.SubscriptionPlan{
+		Name: "daily", PlatformName: "p", DataID: "d", CoinID: "BTC", Amount: 1, Period: models.PlanPeriodDaily, Active: true,
+	})
+	if err != nil {
+		t.Fatalf("create plan: %v", err)
+	}
+	sub, _, err := subs.Subscribe(ctx, plan.ID, "dave")
+	if err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	if err := pool.MaintenanceTx(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, "UPDATE subscriptions SET next_charge_at = now() - interval '1 minute' WHERE id = $1", sub.ID)
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	pool.AddHook(failingHook{})
+	if ev, err := pool.ChargeSubscription(ctx, sub.ID, RetryPolicy{RetryInterval: time.Hour}); err == nil || ev != "" {
+		t.Fatalf("charge with failing hook: %q, %v; want an error", ev, err)
+	}
+	got, err := subs.Get(ctx, sub.ID, "")
+	if err != nil || got.Status != models.SubscriptionActive || got.FailedAttempts != 0 || got.GraceUntil != nil || got.Cycle != sub.Cycle {
+		t.Fatalf("after transient error: %+v, %v", got, err)
+	}
+}
@@ -0,0 +1,205 @@
+package db
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrNoTenant is returned by tenant-scoped repositories when the context
+// carries no tenant.
+var ErrNoTenant = errors.New("no tenant in context")
+
+// AllTenants is a pseudo tenant id for maintenance jobs that must see every
+// row; it is never resolved from API credentials.
+const AllTenants = "*"
+
+var allTenants = models.Tenant{ID: AllTenants, Name: "maintenance"}
+
+type tenantKey struct{}
+
+// WithTenant scopes repository calls made with ctx to t.
+func WithTenant(ctx context.Context, t *models.Tenant) context.Context {
+	return context.WithValue(ctx, tenantKey{}, t)
+}
+
+// TenantFromContext returns the tenant set by WithTenant, or nil.
+func TenantFromContext(ctx context.Context) *models.Tenant {
+	t, _ := ctx.Value(tenantKey{}).(*models.Tenant)
+	return t
+}
+
+func tenantID(ctx context.Context) (string, error) {
+	if t := TenantFromContext(ctx); t != nil && t.ID != "" {
+		return t.ID, nil
+	}
+	return "", ErrNoTenant
+}
+
+// tenantTx runs fn in a transaction with app.tenant_id set for row-level
+// security. Repositories still filter on tenant_id explicitly.
+func (p *Pool) tenantTx(ctx context.Context, fn func(tx pgx.Tx, tenant string) error) error {
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+	return pgx.BeginFunc(ctx, p, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, "SELECT set_config('app.tenant_id', $1, true)", tenant); err != nil {
+			return err
+		}
+		return fn(tx, tenant)
+	})
+}
+
+// HashAPIKey is how tenant API keys are stored.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func newAPIKey() string {
+	b := make([]byte, 24)
+	_, _ = rand.Read(b)
+	return "tk_" + hex.EncodeToString(b)
+}
+
+const tenantColumns = "id, name, ip_rate_per_minute, user_rate_per_minute, retention_days, created_at"
+
+func scanTenant(row pgx.Row) (*models.Tenant, error) {
+	var t models.Tenant
+	if err := row.Scan(&t.ID, &t.Name, &t.IPRatePerMinute, &t.UserRatePerMinute, &t.RetentionDays, &t.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// TenantRepo manages tenants and resolves API keys, caching lookups for a
+// short time since every request performs one.
+type TenantRepo struct {
+	pool *Pool
+
+	mu    sync.Mutex
+	cache map[string]cachedTenant
+}
+
+type cachedTenant struct {
+	t       *models.Tenant
+	expires time.Time
+}
+
+const tenantCacheTTL = 30 * time.Second
+
+func NewTenantRepo(pool *Pool) *TenantRepo {
+	return &TenantRepo{pool: pool, cache: map[string]cachedTenant{}}
+}
+
+// lookup returns the tenant matching column = value through the cache;
+// unknown values resolve to nil without error. Misses are not cached, so
+// the cache only ever holds live tenant ids and keys.
+func (r *TenantRepo) lookup(ctx context.Context, column, value string) (*models.Tenant, error) {
+	key := column + ":" + value
+	r.mu.Lock()
+	c, ok := r.cache[key]
+	r.mu.Unlock()
+	if ok && time.Now().Before(c.expires) {
+		return c.t, nil
+	}
+
+	t, err := scanTenant(r.pool.QueryRow(ctx, "SELECT "+tenantColumns+" FROM tenants WHERE "+column+" = $1", value))
+	if errors.Is(err, pgx.ErrNoRows) {
+		t, err = nil, nil
+	}
+	if err != nil || t == nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	r.cache[key] = cachedTenant{t: t, expires: time.Now().Add(tenantCacheTTL)}
+	r.mu.Unlock()
+	return t, nil
+}
+
+// ByAPIKey returns the tenant owning key, or nil if the key is unknown.
+func (r *TenantRepo) ByAPIKey(ctx context.Context, key string) (*models.Tenant, error) {
+	return r.lookup(ctx, "api_key_hash", HashAPIKey(key))
+}
+
+// Get returns a tenant by id, or nil if there is none.
+func (r *TenantRepo) Get(ctx context.Context, id string) (*models.Tenant, error) {
+	return r.lookup(ctx, "id", id)
+}
+
+func (r *TenantRepo) List(ctx context.Context) ([]models.Tenant, error) {
+	rows, err := r.pool.Query(ctx, "SELECT "+tenantColumns+" FROM tenants ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []models.Tenant
+	for rows.Next() {
+		t, err := scanTenant(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *t)
+	}
+	return out, rows.Err()
+}
+
+// Create registers a tenant and returns it with its API key, which is not
+// stored in clear and cannot be retrieved later.
+func (r *TenantRepo) Create(ctx context.Context, t models.Tenant) (*models.Tenant, string, error) {
+	if t.ID == "" || t.ID == AllTenants {
+		return nil, "", errors.New("invalid tenant id")
+	}
+	key := newAPIKey()
+	out, err := scanTenant(r.pool.QueryRow(ctx, `
+		INSERT INTO tenants (id, name, api_key_hash, ip_rate_per_minute, user_rate_per_minute, retention_days)
+		VALUES ($1,$2,$3,$4,$5,$6)
+		RETURNING `+tenantColumns,
+		t.ID, t.Name, HashAPIKey(key), t.IPRatePerMinute, t.UserRatePerMinute, t.RetentionDays))
+	if err != nil {
+		return nil, "", err
+	}
+	return out, key, nil
+}
+
+// Update replaces a tenant's name and per-tenant settings.
+func (r *TenantRepo) Update(ctx context.Context, t models.Tenant) (*models.Tenant, error) {
+	out, err := scanTenant(r.pool.QueryRow(ctx, `
+		UPDATE tenants
+		SET name = $2, ip_rate_per_minute = $3, user_rate_per_minute = $4, retention_days = $5
+		WHERE id = $1
+		RETURNING `+tenantColumns,
+		t.ID, t.Name, t.IPRatePerMinute, t.UserRatePerMinute, t.RetentionDays))
+	if err == nil {
+		r.flush()
+	}
+	return out, err
+}
+
+// RotateKey issues a new API key, invalidating the old one.
+func (r *TenantRepo) RotateKey(ctx context.Context, id string) (string, error) {
+	key := newAPIKey()
+	tag, err := r.pool.Exec(ctx, "UPDATE tenants SET api_key_hash = $2 WHERE id = $1", id, HashAPIKey(key))
+	if err != nil {
+		return "", err
+	}
+	if tag.RowsAffected() == 0 {
+		return "", pgx.ErrNoRows
+	}
+	r.flush()
+	return key, nil
+}
+
+func (r *TenantRepo) flush() {
+	r.mu.Lock()
+	r.cache = map[string]cachedTenant{}
+	r.mu.Unlock()
+}
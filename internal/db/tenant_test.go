@@ -0,0 +1,148 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// These tests need a disposable database: TEST_DATABASE_URL=postgres://...
+func testPool(t *testing.T) *Pool {
+	t.Helper()
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+	ctx := context.Background()
+	pool, err := NewPool(ctx, url)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(pool.Close)
+	if err := pool.Migrate(ctx); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return pool
+}
+
+func testTenant(t *testing.T, tenants *TenantRepo, prefix string) context.Context {
+	t.Helper()
+	id := fmt.Sprintf("%s-%d", prefix, time.Now().UnixNano())
+	tenant, _, err := tenants.Create(context.Background(), models.Tenant{ID: id, Name: id})
+	if err != nil {
+		t.Fatalf("create tenant: %v", err)
+	}
+	ctx := WithTenant(context.Background(), tenant)
+	if _, err := NewCoinRepo(tenants.pool).Set(ctx, models.Coin{ID: "BTC", Active: true, Precision: 8}); err != nil {
+		t.Fatalf("register coin: %v", err)
+	}
+	if _, err := NewPlatformRepo(tenants.pool).Create(ctx, models.Platform{Name: "p", Enabled: true}); err != nil {
+		t.Fatalf("register platform: %v", err)
+	}
+	return ctx
+}
+
+func TestTenantIsolation(t *testing.T) {
+	pool := testPool(t)
+	tenants := NewTenantRepo(pool)
+	repo := NewTransactionRepo(pool)
+	ctxA := testTenant(t, tenants, "a")
+	ctxB := testTenant(t, tenants, "b")
+
+	now := time.Now().UTC()
+	userID := "shared-user"
+	a, err := repo.Insert(ctxA, models.Transaction{
+		CoinID: "BTC", UserID: userID, DataID: "d1", CoinUsed: 1,
+		TransactionTimestamp: now, ExpiryDate: now.Add(time.Hour), PlatformName: "p",
+	})
+	if err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+
+	if _, err := repo.GetByID(ctxB, a.ID, false); !errors.Is(err, pgx.ErrNoRows) {
+		t.Fatalf("tenant B read tenant A's row by id: err=%v", err)
+	}
+	list, err := repo.List(ctxB, TransactionFilter{UserID: &userID, ID: &a.ID})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(list) != 0 {
+		t.Fatalf("tenant B listed %d of tenant A's rows", len(list))
+	}
+	if got, err := repo.GetByID(ctxA, a.ID, false); err != nil || got.TenantID != TenantFromContext(ctxA).ID {
+		t.Fatalf("tenant A cannot read its own row: %v", err)
+	}
+
+	if _, err := repo.GetByID(context.Background(), a.ID, false); !errors.Is(err, ErrNoTenant) {
+		t.Fatalf("unscoped read: err=%v, want ErrNoTenant", err)
+	}
+}
+
+// TestTenantRowLevelSecurity checks the database policy on its own, as a
+// non-owner role issuing a query without any tenant_id predicate.
+func TestTenantRowLevelSecurity(t *testing.T) {
+	pool := testPool(t)
+	tenants := NewTenantRepo(pool)
+	repo := NewTransactionRepo(pool)
+	ctxA := testTenant(t, tenants, "rls-a")
+	ctxB := testTenant(t, tenants, "rls-b")
+
+	now := time.Now().UTC()
+	a, err := repo.Insert(ctxA, models.Transaction{
+		CoinID: "BTC", UserID: "u", DataID: "d", CoinUsed: 1,
+		TransactionTimestamp: now, ExpiryDate: now.Add(time.Hour), PlatformName: "p",
+	})
+	if err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+
+	ctx := context.Background()
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback(ctx)
+	role := fmt.Sprintf("rls_probe_%d", time.Now().UnixNano())
+	if _, err := tx.Exec(ctx, "CREATE ROLE "+role); err != nil {
+		t.Skipf("cannot create role: %v", err)
+	}
+	if _, err := tx.Exec(ctx, "GRANT SELECT ON transactions TO "+role); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(ctx, "SET LOCAL ROLE "+role); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(ctx, "SELECT set_config('app.tenant_id', $1, true)", TenantFromContext(ctxB).ID); err != nil {
+		t.Fatal(err)
+	}
+	var n int
+	if err := tx.QueryRow(ctx, "SELECT count(*) FROM transactions WHERE id = $1", a.ID).Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Fatalf("row-level security exposed tenant A's row to tenant B")
+	}
+}
+
+func TestTenantLookupDoesNotCacheMisses(t *testing.T) {
+	pool := testPool(t)
+	tenants := NewTenantRepo(pool)
+	for i := 0; i < 10; i++ {
+		got, err := tenants.ByAPIKey(context.Background(), fmt.Sprintf("tk_unknown_%d", i))
+		if err != nil || got != nil {
+			t.Fatalf("unknown key: got %+v, err=%v", got, err)
+		}
+	}
+	tenants.mu.Lock()
+	n := len(tenants.cache)
+	tenants.mu.Unlock()
+	if n != 0 {
+		t.Fatalf("%d cache entries after unknown keys, want 0", n)
+	}
+}
@@ -2,41 +2,199 @@ package db
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
 )
 
+// Sort fields of TransactionFilter.SortBy.
+const (
+	SortByTimestamp = "transactionTimestamp"
+	SortByExpiry    = "expiryDate"
+	SortByCoinUsed  = "coinused"
+)
+
+// ErrInvalidFilter wraps inconsistent TransactionFilter values.
+var ErrInvalidFilter = errors.New("invalid transaction filter")
+
+// TransactionFilter selects transactions; every set condition must hold.
+// Lists match any of their values and combine with the matching
+// single-value field.
 type TransactionFilter struct {
 	ID            *string
 	UserID        *string
 	CoinID        *string
 	DataID        *string
 	PlatformName  *string
-	FromTimestamp *time.Time // inclusive
-	ToTimestamp   *time.Time // inclusive
+	Status        *string
+	UserIDs       []string
+	CoinIDs       []string
+	DataIDs       []string
+	PlatformNames []string
+	DataIDPrefix  string
+	MinCoinUsed   *float64          // inclusive
+	MaxCoinUsed   *float64          // inclusive
+	FromTimestamp *time.Time        // inclusive
+	ToTimestamp   *time.Time        // inclusive
+	ExpiresFrom   *time.Time        // inclusive, on expiryDate
+	ExpiresTo     *time.Time        // inclusive, on expiryDate
+	ActiveAt      *time.Time        // transactionTimestamp <= ActiveAt < expiryDate
+	Metadata      map[string]string // every key must have the given value
+	Tags          []string          // every tag must be present
+	SortBy        string            // SortByTimestamp when empty
+	Ascending     bool              // newest/largest first unless set
 	Limit         int
 	Offset        int
+
+	IncludeDeleted bool // admin only: also return soft-deleted rows
 }
 
 type TransactionRepo struct {
 	pool *Pool
+
+	// HoldTimeout is how long authorized holds stay open before they are
+	// voided; zero uses DefaultHoldTimeout.
+	HoldTimeout time.Duration
 }
 
 func NewTransactionRepo(pool *Pool) *TransactionRepo {
 	return &TransactionRepo{pool: pool}
 }
 
+// txColumns is the column list every transaction query returns.
+const txColumns = "id, coinid, userid, dataid, coinused, transactionTimestamp, expiryDate, platformName, tenant_id, deleted_at, status, hold_expires_at, renews_id, version, created_at, metadata, tags"
+
+func scanTransaction(row pgx.Row) (*models.Transaction, error) {
+	var out models.Transaction
+	if err := row.Scan(
+		&out.ID, &out.CoinID, &out.UserID, &out.DataID, &out.CoinUsed, &out.TransactionTimestamp, &out.ExpiryDate, &out.PlatformName, &out.TenantID, &out.DeletedAt, &out.Status, &out.HoldExpiresAt, &out.RenewsID, &out.Version, &out.CreatedAt, &out.Metadata, &out.Tags,
+	); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Insert stores t as a captured transaction for the tenant in ctx after
+// validating its timestamp, coin and platform and checking the user's
+// spending caps. Future timestamps are rejected with ErrFutureTimestamp. A
+// zero ExpiryDate is derived from the platform's default access duration.
+// The checks, the insert and the ledger postings share one database
+// transaction. On platforms with a dedup window, a repeat of a recent
+// transaction returns that transaction unchanged, or a
+// DuplicateTransactionError if the platform rejects duplicates.
 func (r *TransactionRepo) Insert(ctx context.Context, t models.Transaction) (*models.Transaction, error) {
-	const q = `
+	return r.insert(ctx, t, models.StatusCaptured)
+}
+
+// Authorize stores t as an authorized hold that expires after HoldTimeout.
+// Holds count against spending caps but are posted to the ledger only
+// when captured.
+func (r *TransactionRepo) Authorize(ctx context.Context, t models.Transaction) (*models.Transaction, error) {
+	return r.insert(ctx, t, models.StatusAuthorized)
+}
+
+func (r *TransactionRepo) insert(ctx context.Context, t models.Transaction, status string) (*models.Transaction, error) {
+	t.Status, t.HoldExpiresAt, t.RenewsID = status, nil, nil
+	if status == models.StatusAuthorized {
+		timeout := r.HoldTimeout
+		if timeout <= 0 {
+			timeout = DefaultHoldTimeout
+		}
+		exp := time.Now().UTC().Add(timeout)
+		t.HoldExpiresAt = &exp
+	}
+	var out *models.Transaction
+	var duplicate bool
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		t.TenantID = tenant
+		var err error
+		if out, err = findDuplicate(ctx, tx, t); err != nil || out != nil {
+			duplicate = out != nil
+			return err
+		}
+		out, err = r.pool.createTransaction(ctx, tx, t)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !duplicate {
+		r.pool.postCommit(ctx, out)
+	}
+	return out, nil
+}
+
+// createTransaction runs the hooks, the checks, fraud screening, the
+// insert, the status history and, for captured transactions, the ledger
+// postings inside tx. Callers run postCommit once tx is committed.
+func (p *Pool) createTransaction(ctx context.Context, tx pgx.Tx, t models.Transaction) (*models.Transaction, error) {
+	for _, h := range p.hooks {
+		if err := h.PreValidate(ctx, t); err != nil {
+			return nil, err
+		}
+	}
+	if err := checkAmount(t.CoinUsed); err != nil {
+		return nil, err
+	}
+	if err := checkTimestamp(t); err != nil {
+		return nil, err
+	}
+	if err := prepareMetadata(&t); err != nil {
+		return nil, err
+	}
+	if err := validateCoin(ctx, tx, t); err != nil {
+		return nil, err
+	}
+	if err := resolvePlatform(ctx, tx, &t); err != nil {
+		return nil, err
+	}
+	if err := enforceCaps(ctx, tx, t); err != nil {
+		return nil, err
+	}
+	action, findings, err := p.screen(ctx, tx, t)
+	if err != nil {
+		return nil, err
+	}
+	reason := "created"
+	if action == models.FraudHold {
+		t.Status, t.HoldExpiresAt, reason = models.StatusAuthorized, nil, "held for fraud review"
+	}
+	out, err := insertTransaction(ctx, tx, t)
+	if err != nil {
+		return nil, err
+	}
+	if err := recordStatus(ctx, tx, out, models.StatusPending, reason, ""); err != nil {
+		return nil, err
+	}
+	if out.Status == models.StatusCaptured {
+		if err := postTransaction(ctx, tx, out); err != nil {
+			return nil, err
+		}
+	}
+	if p.screener != nil {
+		if err := recordScreening(ctx, tx, out, clientIP(ctx), action, findings); err != nil {
+			return nil, err
+		}
+	}
+	for _, h := range p.hooks {
+		if err := h.PreCommit(ctx, tx, *out); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func insertTransaction(ctx context.Context, tx pgx.Tx, t models.Transaction) (*models.Transaction, error) {
+	q := `
 		INSERT INTO transactions (
-			coinid, userid, dataid, coinused, transactionTimestamp, expiryDate, platformName
-		) VALUES ($1,$2,$3,$4,$5,$6,$7)
-		RETURNING id, coinid, userid, dataid, coinused, transactionTimestamp, expiryDate, platformName
-	`
-	row := r.pool.QueryRow(ctx, q,
+			coinid, userid, dataid, coinused, transactionTimestamp, expiryDate, platformName, tenant_id, status, hold_expires_at, renews_id, metadata, tags
+		) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13)
+		RETURNING ` + txColumns
+	return scanTransaction(tx.QueryRow(ctx, q,
 		t.CoinID,
 		t.UserID,
 		t.DataID,
@@ -44,37 +202,68 @@ func (r *TransactionRepo) Insert(ctx context.Context, t models.Transaction) (*mo
 		t.TransactionTimestamp,
 		t.ExpiryDate,
 		t.PlatformName,
-	)
-	var out models.Transaction
-	if err := row.Scan(
-		&out.ID, &out.CoinID, &out.UserID, &out.DataID, &out.CoinUsed, &out.TransactionTimestamp, &out.ExpiryDate, &out.PlatformName,
-	); err != nil {
+		t.TenantID,
+		t.Status,
+		t.HoldExpiresAt,
+		t.RenewsID,
+		t.Metadata,
+		t.Tags,
+	))
+}
+
+// GetByID returns the transaction or pgx.ErrNoRows. Soft-deleted rows are
+// only returned with includeDeleted.
+func (r *TransactionRepo) GetByID(ctx context.Context, id string, includeDeleted bool) (*models.Transaction, error) {
+	var out *models.Transaction
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		q := `
+			SELECT ` + txColumns + `
+			FROM transactions
+			WHERE id = $1 AND tenant_id = $2
+		`
+		if !includeDeleted {
+			q += " AND deleted_at IS NULL"
+		}
+		var err error
+		out, err = scanTransaction(tx.QueryRow(ctx, q, id, tenant))
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
-	return &out, nil
+	return out, nil
 }
 
-func (r *TransactionRepo) GetByID(ctx context.Context, id string) (*models.Transaction, error) {
-	const q = `
-		SELECT id, coinid, userid, dataid, coinused, transactionTimestamp, expiryDate, platformName
-		FROM transactions
-		WHERE id = $1
-	`
-	row := r.pool.QueryRow(ctx, q, id)
-	var out models.Transaction
-	if err := row.Scan(
-		&out.ID, &out.CoinID, &out.UserID, &out.DataID, &out.CoinUsed, &out.TransactionTimestamp, &out.ExpiryDate, &out.PlatformName,
-	); err != nil {
-		return nil, err
+func (f TransactionFilter) validate() error {
+	invalid := func(reason string) error {
+		return fmt.Errorf("%w: %s", ErrInvalidFilter, reason)
 	}
-	return &out, nil
+	switch {
+	case f.SortBy != "" && f.SortBy != SortByTimestamp && f.SortBy != SortByExpiry && f.SortBy != SortByCoinUsed:
+		return invalid(fmt.Sprintf("cannot sort by %q", f.SortBy))
+	case f.MinCoinUsed != nil && f.MaxCoinUsed != nil && *f.MinCoinUsed > *f.MaxCoinUsed:
+		return invalid("minimum coinused exceeds the maximum")
+	case f.FromTimestamp != nil && f.ToTimestamp != nil && f.FromTimestamp.After(*f.ToTimestamp):
+		return invalid("fromTimestamp is after toTimestamp")
+	case f.ExpiresFrom != nil && f.ExpiresTo != nil && f.ExpiresFrom.After(*f.ExpiresTo):
+		return invalid("expiry range start is after its end")
+	}
+	return nil
 }
 
-func (r *TransactionRepo) List(ctx context.Context, f TransactionFilter) ([]models.Transaction, error) {
+// likeEscaper escapes LIKE wildcards with the default escape character.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// where renders f as a WHERE clause (without the keyword) for the tenant,
+// with tenant as $1.
+func (f TransactionFilter) where(tenant string) (string, []any) {
 	sb := strings.Builder{}
-	sb.WriteString("SELECT id, coinid, userid, dataid, coinused, transactionTimestamp, expiryDate, platformName FROM transactions WHERE 1=1")
-	args := []any{}
-	idx := 1
+	sb.WriteString("tenant_id = $1")
+	args := []any{tenant}
+	idx := 2
+	if !f.IncludeDeleted {
+		sb.WriteString(" AND deleted_at IS NULL")
+	}
 
 	add := func(clause string, val any) {
 		sb.WriteString(" AND ")
@@ -98,36 +287,148 @@ func (r *TransactionRepo) List(ctx context.Context, f TransactionFilter) ([]mode
 	if f.PlatformName != nil && *f.PlatformName != "" {
 		add(fmt.Sprintf("platformName = $%d", idx), *f.PlatformName)
 	}
+	if f.Status != nil && *f.Status != "" {
+		add(fmt.Sprintf("status = $%d", idx), *f.Status)
+	}
+	for _, in := range []struct {
+		column string
+		values []string
+	}{
+		{"userid", f.UserIDs},
+		{"coinid", f.CoinIDs},
+		{"dataid", f.DataIDs},
+		{"platformName", f.PlatformNames},
+	} {
+		if len(in.values) > 0 {
+			add(fmt.Sprintf("%s = ANY($%d)", in.column, idx), in.values)
+		}
+	}
+	if f.DataIDPrefix != "" {
+		add(fmt.Sprintf("dataid LIKE $%d", idx), likeEscaper.Replace(f.DataIDPrefix)+"%")
+	}
+	if f.MinCoinUsed != nil {
+		add(fmt.Sprintf("coinused >= $%d", idx), *f.MinCoinUsed)
+	}
+	if f.MaxCoinUsed != nil {
+		add(fmt.Sprintf("coinused <= $%d", idx), *f.MaxCoinUsed)
+	}
+	// Bounds on the partition key let Postgres skip monthly partitions
+	// outside the range, including at execution time for parameters.
 	if f.FromTimestamp != nil {
 		add(fmt.Sprintf("transactionTimestamp >= $%d", idx), *f.FromTimestamp)
 	}
 	if f.ToTimestamp != nil {
 		add(fmt.Sprintf("transactionTimestamp <= $%d", idx), *f.ToTimestamp)
 	}
+	if f.ActiveAt != nil {
+		add(fmt.Sprintf("transactionTimestamp <= $%d", idx), *f.ActiveAt)
+		add(fmt.Sprintf("expiryDate > $%d", idx), *f.ActiveAt)
+	}
+	if f.ExpiresFrom != nil {
+		add(fmt.Sprintf("expiryDate >= $%d", idx), *f.ExpiresFrom)
+	}
+	if f.ExpiresTo != nil {
+		add(fmt.Sprintf("expiryDate <= $%d", idx), *f.ExpiresTo)
+	}
+	// Containment matches the GIN indexes on metadata and tags.
+	if len(f.Metadata) > 0 {
+		add(fmt.Sprintf("metadata @> $%d", idx), f.Metadata)
+	}
+	if len(f.Tags) > 0 {
+		add(fmt.Sprintf("tags @> $%d", idx), f.Tags)
+	}
+	return sb.String(), args
+}
 
-	sb.WriteString(" ORDER BY transactionTimestamp DESC")
-	limit := 100
-	if f.Limit > 0 && f.Limit <= 1000 {
-		limit = f.Limit
+// List returns the transactions matching f, sorted by f.SortBy with id as
+// the tie-breaker. Inconsistent filters return ErrInvalidFilter.
+func (r *TransactionRepo) List(ctx context.Context, f TransactionFilter) ([]models.Transaction, error) {
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+	sortBy, dir := f.SortBy, "DESC"
+	if sortBy == "" {
+		sortBy = SortByTimestamp
 	}
-	sb.WriteString(fmt.Sprintf(" LIMIT %d", limit))
-	if f.Offset > 0 {
-		sb.WriteString(fmt.Sprintf(" OFFSET %d", f.Offset))
+	if f.Ascending {
+		dir = "ASC"
 	}
+	var out []models.Transaction
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		where, args := f.where(tenant)
+		sb := strings.Builder{}
+		sb.WriteString("SELECT " + txColumns + " FROM transactions WHERE " + where)
+		sb.WriteString(fmt.Sprintf(" ORDER BY %s %s, id %s", sortBy, dir, dir))
+		limit := 100
+		if f.Limit > 0 && f.Limit <= 1000 {
+			limit = f.Limit
+		}
+		sb.WriteString(fmt.Sprintf(" LIMIT %d", limit))
+		if f.Offset > 0 {
+			sb.WriteString(fmt.Sprintf(" OFFSET %d", f.Offset))
+		}
+
+		rows, err := tx.Query(ctx, sb.String(), args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
 
-	rows, err := r.pool.Query(ctx, sb.String(), args...)
+		for rows.Next() {
+			t, err := scanTransaction(rows)
+			if err != nil {
+				return err
+			}
+			out = append(out, *t)
+		}
+		return rows.Err()
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
+	return out, nil
+}
 
-	var out []models.Transaction
-	for rows.Next() {
-		var t models.Transaction
-		if err := rows.Scan(&t.ID, &t.CoinID, &t.UserID, &t.DataID, &t.CoinUsed, &t.TransactionTimestamp, &t.ExpiryDate, &t.PlatformName); err != nil {
-			return nil, err
+// Totals aggregates the transactions matching f per coin and converts each
+// row into ref at the rate effective at its transactionTimestamp. The sort
+// order, Limit and Offset of f are ignored.
+func (r *TransactionRepo) Totals(ctx context.Context, f TransactionFilter, ref string) (*models.Totals, error) {
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+	out := &models.Totals{ReferenceCoin: ref, ByCoin: []models.CoinTotal{}, Complete: true}
+	err := r.pool.tenantTx(ctx, func(tx pgx.Tx, tenant string) error {
+		where, args := f.where(tenant)
+		args = append(args, ref)
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+			SELECT coinid, count(*), COALESCE(SUM(coinused), 0),
+			       COALESCE(SUM(coinused * rate), 0), count(*) - count(rate)
+			FROM (
+				SELECT coinid, coinused, exchange_rate(tenant_id, coinid, $%d, transactionTimestamp) AS rate
+				FROM transactions
+				WHERE %s
+			) t
+			GROUP BY coinid
+			ORDER BY coinid`, len(args), where), args...)
+		if err != nil {
+			return err
 		}
-		out = append(out, t)
+		defer rows.Close()
+		for rows.Next() {
+			var c models.CoinTotal
+			if err := rows.Scan(&c.CoinID, &c.Count, &c.Total, &c.Value, &c.Unconverted); err != nil {
+				return err
+			}
+			out.ByCoin = append(out.ByCoin, c)
+			out.Value += c.Value
+			if c.Unconverted > 0 {
+				out.Complete = false
+			}
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
 	}
-	return out, rows.Err()
+	return out, nil
 }
@@ -0,0 +1,83 @@
+package db
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+)
+
+func TestFilterValidate(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Hour)
+	one, two := 1.0, 2.0
+	valid := []TransactionFilter{
+		{},
+		{SortBy: SortByCoinUsed, MinCoinUsed: &one, MaxCoinUsed: &two, FromTimestamp: &now, ToTimestamp: &later},
+	}
+	for i, f := range valid {
+		if err := f.validate(); err != nil {
+			t.Errorf("valid filter %d rejected: %v", i, err)
+		}
+	}
+	invalid := map[string]TransactionFilter{
+		"sort field":     {SortBy: "userid"},
+		"coinused range": {MinCoinUsed: &two, MaxCoinUsed: &one},
+		"time range":     {FromTimestamp: &later, ToTimestamp: &now},
+		"expiry range":   {ExpiresFrom: &later, ExpiresTo: &now},
+	}
+	for name, f := range invalid {
+		if err := f.validate(); !errors.Is(err, ErrInvalidFilter) {
+			t.Errorf("%s: err = %v, want ErrInvalidFilter", name, err)
+		}
+	}
+}
+
+func TestListFilters(t *testing.T) {
+	pool := testPool(t)
+	repo := NewTransactionRepo(pool)
+	ctx := testTenant(t, NewTenantRepo(pool), "filters")
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, tx := range []models.Transaction{
+		{UserID: "u1", DataID: "song-1", CoinUsed: 1, TransactionTimestamp: now.Add(-3 * time.Hour), ExpiryDate: now.Add(-time.Hour)},
+		{UserID: "u2", DataID: "song-2", CoinUsed: 5, TransactionTimestamp: now.Add(-2 * time.Hour), ExpiryDate: now.Add(time.Hour)},
+		{UserID: "u3", DataID: "song_3", CoinUsed: 9, TransactionTimestamp: now.Add(-time.Hour), ExpiryDate: now.Add(2 * time.Hour)},
+	} {
+		tx.CoinID, tx.PlatformName = "BTC", "p"
+		if _, err := repo.Insert(ctx, tx); err != nil {
+			t.Fatalf("insert: %v", err)
+		}
+	}
+
+	five, nine := 5.0, 9.0
+	cases := []struct {
+		name string
+		f    TransactionFilter
+		want []string // dataids in order
+	}{
+		{"default order", TransactionFilter{}, []string{"song_3", "song-2", "song-1"}},
+		{"user list", TransactionFilter{UserIDs: []string{"u1", "u3"}}, []string{"song_3", "song-1"}},
+		{"prefix", TransactionFilter{DataIDPrefix: "song-"}, []string{"song-2", "song-1"}},
+		{"prefix wildcard is literal", TransactionFilter{DataIDPrefix: "song_"}, []string{"song_3"}},
+		{"coinused range", TransactionFilter{MinCoinUsed: &five, MaxCoinUsed: &nine}, []string{"song_3", "song-2"}},
+		{"active now", TransactionFilter{ActiveAt: &now}, []string{"song_3", "song-2"}},
+		{"expiry ascending", TransactionFilter{SortBy: SortByExpiry, Ascending: true}, []string{"song-1", "song-2", "song_3"}},
+		{"coinused descending", TransactionFilter{SortBy: SortByCoinUsed}, []string{"song_3", "song-2", "song-1"}},
+	}
+	for _, c := range cases {
+		got, err := repo.List(ctx, c.f)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		var ids []string
+		for _, tx := range got {
+			ids = append(ids, tx.DataID)
+		}
+		if !slices.Equal(ids, c.want) {
+			t.Errorf("%s: got %v, want %v", c.name, ids, c.want)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+// Package fraud screens new transactions against configurable rules and
+// implements db.Screener. Rules are read from a JSON file
+// (FRAUD_RULES_FILE):
+//
+//	{"rules": [
+//	  {"name": "burst", "type": "velocity", "window": "10m", "max": 5, "action": "hold"},
+//	  {"name": "big", "type": "unusual_amount", "factor": 5, "minHistory": 5, "lookback": "720h", "action": "flag"},
+//	  {"name": "hopping", "type": "distinct_platforms", "window": "1h", "max": 3, "action": "flag"},
+//	  {"name": "shared-ip", "type": "users_per_ip", "window": "1h", "max": 10, "action": "reject"}
+//	]}
+//
+// Actions are flag, hold and reject; when several rules match, the
+// strongest action applies. Tenant and platform selectors restrict a rule
+// as in internal/rules.
+package fraud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// Rule types.
+const (
+	// Velocity matches when the user makes more than Max transactions
+	// within Window.
+	Velocity = "velocity"
+	// UnusualAmount matches when coinused exceeds Factor times the user's
+	// average spend of the same coin over Lookback, once the user has at
+	// least MinHistory such transactions.
+	UnusualAmount = "unusual_amount"
+	// DistinctPlatforms matches when the user spends on more than Max
+	// platforms within Window.
+	DistinctPlatforms = "distinct_platforms"
+	// UsersPerIP matches when more than Max users spend from the client's
+	// address within Window.
+	UsersPerIP = "users_per_ip"
+)
+
+type Rule struct {
+	Name       string  `json:"name"`
+	Type       string  `json:"type"`
+	Action     string  `json:"action"`
+	Tenant     string  `json:"tenant,omitempty"`
+	Platform   string  `json:"platform,omitempty"`
+	Window     string  `json:"window,omitempty"`     // Go duration; velocity, distinct_platforms, users_per_ip
+	Max        int     `json:"max,omitempty"`        // velocity, distinct_platforms, users_per_ip
+	Factor     float64 `json:"factor,omitempty"`     // unusual_amount
+	MinHistory int     `json:"minHistory,omitempty"` // unusual_amount
+	Lookback   string  `json:"lookback,omitempty"`   // Go duration; unusual_amount
+
+	window   time.Duration
+	lookback time.Duration
+}
+
+// Config is the rule file.
+type Config struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Parse reads and validates a rule file.
+func Parse(raw []byte) (*Config, error) {
+	var c Config
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, err
+	}
+	for i := range c.Rules {
+		r := &c.Rules[i]
+		if r.Name == "" {
+			return nil, fmt.Errorf("rule %d: name is required", i)
+		}
+		if models.FraudActionRank(r.Action) == 0 {
+			return nil, fmt.Errorf("rule %q: action must be flag, hold or reject", r.Name)
+		}
+		var err error
+		switch r.Type {
+		case Velocity, DistinctPlatforms, UsersPerIP:
+			if r.window, err = time.ParseDuration(r.Window); err != nil || r.window <= 0 {
+				return nil, fmt.Errorf("rule %q: window must be a positive duration", r.Name)
+			}
+			if r.Max <= 0 {
+				return nil, fmt.Errorf("rule %q: max must be positive", r.Name)
+			}
+		case UnusualAmount:
+			if r.lookback, err = time.ParseDuration(r.Lookback); err != nil || r.lookback <= 0 {
+				return nil, fmt.Errorf("rule %q: lookback must be a positive duration", r.Name)
+			}
+			if r.Factor <= 1 {
+				return nil, fmt.Errorf("rule %q: factor must be greater than 1", r.Name)
+			}
+		default:
+			return nil, fmt.Errorf("rule %q: unknown type %q", r.Name, r.Type)
+		}
+	}
+	return &c, nil
+}
+
+// Screener applies a Config. It implements db.Screener.
+type Screener struct {
+	rules []Rule
+}
+
+func NewScreener(c *Config) *Screener {
+	return &Screener{rules: c.Rules}
+}
+
+// Load builds a Screener from the rule file at path, or returns nil when
+// path is empty.
+func Load(path string) (*Screener, error) {
+	if path == "" {
+		return nil, nil
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c, err := Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("parse fraud rules %s: %w", path, err)
+	}
+	return NewScreener(c), nil
+}
+
+func matches(selector, value string) bool {
+	return selector == "" || selector == "*" || selector == value
+}
+
+// Screen evaluates every matching rule against t, which is not stored yet.
+// Voided transactions and expired holds do not count as history. Windows
+// are on when transactions were stored, since transactionTimestamp is set
+// by the client and may be backdated.
+func (s *Screener) Screen(ctx context.Context, tx pgx.Tx, t models.Transaction, ip string) ([]models.FraudFinding, error) {
+	var out []models.FraudFinding
+	for _, r := range s.rules {
+		if !matches(r.Tenant, t.TenantID) || !matches(r.Platform, t.PlatformName) {
+			continue
+		}
+		reason, err := evaluate(ctx, tx, r, t, ip)
+		if err != nil {
+			return nil, fmt.Errorf("fraud rule %q: %w", r.Name, err)
+		}
+		if reason != "" {
+			out = append(out, models.FraudFinding{Rule: r.Name, Action: r.Action, Reason: reason})
+		}
+	}
+	return out, nil
+}
+
+const live = `deleted_at IS NULL
+	AND status <> 'voided'
+	AND NOT (status = 'authorized' AND hold_expires_at IS NOT NULL AND hold_expires_at <= now())`
+
+// evaluate returns why r matches t, or "".
+func evaluate(ctx context.Context, tx pgx.Tx, r Rule, t models.Transaction, ip string) (string, error) {
+	switch r.Type {
+	case Velocity:
+		var n int
+		if err := tx.QueryRow(ctx, `
+			SELECT count(*) FROM transactions
+			WHERE tenant_id = $1 AND userid = $2 AND created_at > now() - $3::interval AND `+live,
+			t.TenantID, t.UserID, r.window).Scan(&n); err != nil {
+			return "", err
+		}
+		if n+1 > r.Max {
+			return fmt.Sprintf("%d transactions within %s", n+1, r.window), nil
+		}
+	case UnusualAmount:
+		var n int
+		var avg float64
+		if err := tx.QueryRow(ctx, `
+			SELECT count(*), COALESCE(avg(coinused), 0) FROM transactions
+			WHERE tenant_id = $1 AND userid = $2 AND coinid = $3 AND created_at > now() - $4::interval AND `+live,
+			t.TenantID, t.UserID, t.CoinID, r.lookback).Scan(&n, &avg); err != nil {
+			return "", err
+		}
+		if n >= r.MinHistory && n > 0 && t.CoinUsed > r.Factor*avg {
+			return fmt.Sprintf("amount %g is over %g times the average of %g", t.CoinUsed, r.Factor, avg), nil
+		}
+	case DistinctPlatforms:
+		var n int
+		if err := tx.QueryRow(ctx, `
+			SELECT count(DISTINCT platformName) FILTER (WHERE platformName <> $4) FROM transactions
+			WHERE tenant_id = $1 AND userid = $2 AND created_at > now() - $3::interval AND `+live,
+			t.TenantID, t.UserID, r.window, t.PlatformName).Scan(&n); err != nil {
+			return "", err
+		}
+		if n+1 > r.Max {
+			return fmt.Sprintf("%d platforms within %s", n+1, r.window), nil
+		}
+	case UsersPerIP:
+		if ip == "" {
+			return "", nil
+		}
+		var n int
+		if err := tx.QueryRow(ctx, `
+			SELECT count(DISTINCT userid) FILTER (WHERE userid <> $4) FROM transaction_signals
+			WHERE tenant_id = $1 AND ip = $2 AND created_at > now() - $3::interval`,
+			t.TenantID, ip, r.window, t.UserID).Scan(&n); err != nil {
+			return "", err
+		}
+		if n+1 > r.Max {
+			return fmt.Sprintf("%d users from %s within %s", n+1, ip, r.window), nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,106 @@
+package fraud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+)
+
+var _ db.Screener = (*Screener)(nil)
+
+func TestParse(t *testing.T) {
+	c, err := Parse([]byte(`{"rules": [
+		{"name": "burst", "type": "velocity", "window": "10m", "max": 5, "action": "hold"},
+		{"name": "big", "type": "unusual_amount", "factor": 5, "lookback": "720h", "action": "flag"}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Rules[0].window != 10*time.Minute || c.Rules[1].lookback != 720*time.Hour {
+		t.Fatalf("durations not parsed: %+v", c.Rules)
+	}
+
+	bad := []string{
+		`{"rules": [{"type": "velocity", "window": "1m", "max": 1, "action": "flag"}]}`,
+		`{"rules": [{"name": "x", "type": "velocity", "window": "1m", "max": 1, "action": "block"}]}`,
+		`{"rules": [{"name": "x", "type": "velocity", "window": "soon", "max": 1, "action": "flag"}]}`,
+		`{"rules": [{"name": "x", "type": "users_per_ip", "window": "1h", "action": "flag"}]}`,
+		`{"rules": [{"name": "x", "type": "unusual_amount", "factor": 1, "lookback": "1h", "action": "flag"}]}`,
+		`{"rules": [{"name": "x", "type": "geo", "action": "flag"}]}`,
+	}
+	for _, raw := range bad {
+		if _, err := Parse([]byte(raw)); err == nil {
+			t.Errorf("Parse(%s) succeeded", raw)
+		}
+	}
+}
+
+// Needs a disposable database: TEST_DATABASE_URL=postgres://...
+func TestRulesCountBackdatedSpends(t *testing.T) {
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+	bg := context.Background()
+	pool, err := db.NewPool(bg, url)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(pool.Close)
+	if err := pool.Migrate(bg); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	tenant, _, err := db.NewTenantRepo(pool).Create(bg, models.Tenant{ID: fmt.Sprintf("fraud-%d", time.Now().UnixNano()), Name: "fraud"})
+	if err != nil {
+		t.Fatalf("create tenant: %v", err)
+	}
+	ctx := db.WithTenant(bg, tenant)
+	if _, err := db.NewCoinRepo(pool).Set(ctx, models.Coin{ID: "BTC", Active: true, Precision: 8}); err != nil {
+		t.Fatalf("register coin: %v", err)
+	}
+	for _, name := range []string{"p", "q"} {
+		if _, err := db.NewPlatformRepo(pool).Create(ctx, models.Platform{Name: name, Enabled: true}); err != nil {
+			t.Fatalf("register platform: %v", err)
+		}
+	}
+	repo := db.NewTransactionRepo(pool)
+
+	// Every spend is dated well outside the rules' windows.
+	backdated := func(user, platform string, amount float64) error {
+		at := time.Now().UTC().Add(-48 * time.Hour)
+		_, err := repo.Insert(ctx, models.Transaction{CoinID: "BTC", UserID: user, DataID: "d", CoinUsed: amount,
+			TransactionTimestamp: at, ExpiryDate: at.Add(72 * time.Hour), PlatformName: platform})
+		return err
+	}
+	// Each user spends 1 on p, then the second spend below.
+	tests := []struct {
+		rule     string
+		user     string
+		platform string
+		amount   float64
+	}{
+		{`{"name": "burst", "type": "velocity", "window": "10m", "max": 1, "action": "reject"}`, "velocity", "p", 1},
+		{`{"name": "big", "type": "unusual_amount", "factor": 2, "minHistory": 1, "lookback": "1h", "action": "reject"}`, "amount", "p", 10},
+		{`{"name": "hopping", "type": "distinct_platforms", "window": "1h", "max": 1, "action": "reject"}`, "platforms", "q", 1},
+	}
+	for _, tt := range tests {
+		c, err := Parse([]byte(`{"rules": [` + tt.rule + `]}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		pool.SetScreener(NewScreener(c))
+		if err := backdated(tt.user, "p", 1); err != nil {
+			t.Fatalf("%s: first spend: %v", tt.user, err)
+		}
+		var fraudErr *db.FraudRejectedError
+		if err := backdated(tt.user, tt.platform, tt.amount); !errors.As(err, &fraudErr) {
+			t.Fatalf("%s: backdated spend: err=%v, want FraudRejectedError", tt.user, err)
+		}
+	}
+}
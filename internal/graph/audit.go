@@ -0,0 +1,108 @@
+package graph
+
+import (
+	"errors"
+
+	"github.com/devifyX/go-back-transaction-service/internal/audit"
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/graphql-go/graphql"
+)
+
+var errAuditDisabled = errors.New("audit log is not configured")
+
+// withAudit wraps every resolver in fields so completed calls are written
+// to the audit log. read marks the fields as queries, which are only
+// recorded when read auditing is enabled.
+func (r *Resolver) withAudit(fields graphql.Fields, read bool) graphql.Fields {
+	if !r.Audit.Enabled(read) {
+		return fields
+	}
+	for name, f := range fields {
+		resolve := f.Resolve
+		f.Resolve = func(p graphql.ResolveParams) (any, error) {
+			out, err := resolve(p)
+			r.Audit.Record(p.Context, audit.TransportGraphQL, name, p.Args, out, err)
+			return out, err
+		}
+	}
+	return fields
+}
+
+// auditFields returns the admin-only audit log query, scoped to the
+// caller's tenant.
+func (r *Resolver) auditFields() graphql.Fields {
+	eventType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "AuditEvent",
+		Fields: graphql.Fields{
+			"seq":        &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"tenantId":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"occurredAt": timeField(graphql.NewNonNull(graphql.String)),
+			"actor":      &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"admin":      &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+			"transport":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"operation":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"args":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"resultIds":  &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String)))},
+			"outcome":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"clientIp":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"requestId":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"prevHash":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"hash":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		},
+	})
+
+	filterInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "AuditFilter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"actor":     &graphql.InputObjectFieldConfig{Type: graphql.String, Description: "user id; matched against its stored pseudonym"},
+			"operation": &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"resultId":  &graphql.InputObjectFieldConfig{Type: graphql.String, Description: "e.g. a transaction id"},
+			"from":      &graphql.InputObjectFieldConfig{Type: graphql.String}, // RFC3339
+			"to":        &graphql.InputObjectFieldConfig{Type: graphql.String}, // RFC3339
+			"limit":     &graphql.InputObjectFieldConfig{Type: graphql.Int},
+			"offset":    &graphql.InputObjectFieldConfig{Type: graphql.Int},
+		},
+	})
+
+	return graphql.Fields{
+		"auditEvents": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(eventType))),
+			Args: graphql.FieldConfigArgument{
+				"filter": &graphql.ArgumentConfig{Type: filterInput},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				if r.Audit == nil {
+					return nil, errAuditDisabled
+				}
+				f := audit.Filter{TenantID: middleware.TenantIDFromContext(p.Context)}
+				if raw, ok := p.Args["filter"].(map[string]any); ok {
+					if v := optString(raw, "actor"); v != "" {
+						f.Actor = r.Audit.Actor(p.Context, v)
+					}
+					f.Operation = optString(raw, "operation")
+					f.ResultID = optString(raw, "resultId")
+					if v := optString(raw, "from"); v != "" {
+						t, err := ParseISO(v)
+						if err != nil {
+							return nil, err
+						}
+						f.From = &t
+					}
+					if v := optString(raw, "to"); v != "" {
+						t, err := ParseISO(v)
+						if err != nil {
+							return nil, err
+						}
+						f.To = &t
+					}
+					f.Limit, _ = raw["limit"].(int)
+					f.Offset, _ = raw["offset"].(int)
+				}
+				return r.Audit.Log.List(p.Context, f)
+			},
+		},
+	}
+}
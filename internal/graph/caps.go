@@ -0,0 +1,114 @@
+package graph
+
+import (
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/graphql-go/graphql"
+)
+
+// capFields returns the spending cap queries and mutations.
+func (r *Resolver) capFields() (queries, mutations graphql.Fields) {
+	periodEnum := graphql.NewEnum(graphql.EnumConfig{
+		Name: "CapPeriod",
+		Values: graphql.EnumValueConfigMap{
+			"DAILY":   &graphql.EnumValueConfig{Value: models.CapPeriodDaily, Description: "rolling 24 hours"},
+			"MONTHLY": &graphql.EnumValueConfig{Value: models.CapPeriodMonthly, Description: "rolling 30 days"},
+		},
+	})
+
+	capType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "SpendingCap",
+		Fields: graphql.Fields{
+			"id":           &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"userid":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"coinid":       &graphql.Field{Type: graphql.String, Description: "empty for all coins"},
+			"platformName": &graphql.Field{Type: graphql.String, Description: "empty for all platforms"},
+			"period":       &graphql.Field{Type: graphql.NewNonNull(periodEnum)},
+			"amount":       &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+			"updatedAt":    timeField(graphql.NewNonNull(graphql.String)),
+		},
+	})
+
+	budgetType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Budget",
+		Fields: graphql.Fields{
+			"cap":         &graphql.Field{Type: graphql.NewNonNull(capType)},
+			"spent":       &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+			"remaining":   &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+			"windowStart": timeField(graphql.NewNonNull(graphql.String)),
+		},
+	})
+
+	capInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "SpendingCapInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"userid":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"coinid":       &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"platformName": &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"period":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(periodEnum)},
+			"amount":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Float)},
+		},
+	})
+
+	scopeArgs := func() graphql.FieldConfigArgument {
+		return graphql.FieldConfigArgument{
+			"userid":       &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			"coinid":       &graphql.ArgumentConfig{Type: graphql.String},
+			"platformName": &graphql.ArgumentConfig{Type: graphql.String},
+		}
+	}
+
+	clearArgs := scopeArgs()
+	clearArgs["period"] = &graphql.ArgumentConfig{Type: graphql.NewNonNull(periodEnum)}
+
+	queries = graphql.Fields{
+		"spendingCaps": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(capType))),
+			Args: graphql.FieldConfigArgument{
+				"userid": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return r.Caps.List(p.Context, p.Args["userid"].(string))
+			},
+		},
+		"remainingBudget": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(budgetType))),
+			Args: scopeArgs(),
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return r.Caps.Remaining(p.Context, p.Args["userid"].(string), optString(p.Args, "coinid"), optString(p.Args, "platformName"))
+			},
+		},
+	}
+
+	mutations = graphql.Fields{
+		"setSpendingCap": &graphql.Field{
+			Type: capType,
+			Args: graphql.FieldConfigArgument{
+				"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(capInput)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				in := p.Args["input"].(map[string]any)
+				return r.Caps.Set(p.Context, models.SpendingCap{
+					UserID:       in["userid"].(string),
+					CoinID:       optString(in, "coinid"),
+					PlatformName: optString(in, "platformName"),
+					Period:       in["period"].(string),
+					Amount:       in["amount"].(float64),
+				})
+			},
+		},
+		"clearSpendingCap": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Boolean),
+			Args: clearArgs,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				return r.Caps.Clear(p.Context, p.Args["userid"].(string), optString(p.Args, "coinid"), optString(p.Args, "platformName"), p.Args["period"].(string))
+			},
+		},
+	}
+	return queries, mutations
+}
@@ -0,0 +1,80 @@
+package graph
+
+import (
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/graphql-go/graphql"
+)
+
+// coinFields returns the Coin type (also used by Transaction.coin) and the
+// catalog queries and mutations.
+func (r *Resolver) coinFields() (coinType *graphql.Object, queries, mutations graphql.Fields) {
+	coinType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Coin",
+		Fields: graphql.Fields{
+			"id":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"name":      &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"precision": &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "decimal places allowed in coinused"},
+			"active":    &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+			"minSpend":  &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+			"maxSpend":  &graphql.Field{Type: graphql.NewNonNull(graphql.Float), Description: "0 means no maximum"},
+			"createdAt": timeField(graphql.NewNonNull(graphql.String)),
+			"updatedAt": timeField(graphql.NewNonNull(graphql.String)),
+		},
+	})
+
+	coinInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CoinInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"id":        &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"name":      &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"precision": &graphql.InputObjectFieldConfig{Type: graphql.Int, DefaultValue: 8},
+			"active":    &graphql.InputObjectFieldConfig{Type: graphql.Boolean, DefaultValue: true},
+			"minSpend":  &graphql.InputObjectFieldConfig{Type: graphql.Float},
+			"maxSpend":  &graphql.InputObjectFieldConfig{Type: graphql.Float},
+		},
+	})
+
+	queries = graphql.Fields{
+		"coins": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(coinType))),
+			Args: graphql.FieldConfigArgument{
+				"includeInactive": &graphql.ArgumentConfig{Type: graphql.Boolean, DefaultValue: false},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return r.Coins.List(p.Context, p.Args["includeInactive"] == true)
+			},
+		},
+		"coin": &graphql.Field{
+			Type: coinType,
+			Args: graphql.FieldConfigArgument{
+				"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return r.Coins.Get(p.Context, p.Args["id"].(string))
+			},
+		},
+	}
+
+	mutations = graphql.Fields{
+		"setCoin": &graphql.Field{
+			Type: coinType,
+			Args: graphql.FieldConfigArgument{
+				"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(coinInput)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				in := p.Args["input"].(map[string]any)
+				c := models.Coin{ID: in["id"].(string)}
+				c.Name, _ = in["name"].(string)
+				c.Precision, _ = in["precision"].(int)
+				c.Active, _ = in["active"].(bool)
+				c.MinSpend, _ = in["minSpend"].(float64)
+				c.MaxSpend, _ = in["maxSpend"].(float64)
+				return r.Coins.Set(p.Context, c)
+			},
+		},
+	}
+	return coinType, queries, mutations
+}
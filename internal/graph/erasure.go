@@ -0,0 +1,51 @@
+package graph
+
+import (
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/graphql-go/graphql"
+)
+
+// erasureFields returns the admin-only eraseUser and deleteTransaction
+// mutations.
+func (r *Resolver) erasureFields(transactionType *graphql.Object) graphql.Fields {
+	receiptType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ErasureReceipt",
+		Fields: graphql.Fields{
+			"id":           &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"tenantId":     &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"pseudonym":    &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "replaces the userid on every affected row"},
+			"subjectHash":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"transactions": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"spendingCaps": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"requestedBy":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"erasedAt":     timeField(graphql.NewNonNull(graphql.String)),
+		},
+	})
+
+	return graphql.Fields{
+		"eraseUser": &graphql.Field{
+			Type: graphql.NewNonNull(receiptType),
+			Args: graphql.FieldConfigArgument{
+				"userid": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				return r.Repo.EraseUser(p.Context, p.Args["userid"].(string), middleware.UserIDFromContext(p.Context))
+			},
+		},
+		"deleteTransaction": &graphql.Field{
+			Type: transactionType,
+			Args: graphql.FieldConfigArgument{
+				"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				return r.Repo.SoftDelete(p.Context, p.Args["id"].(string))
+			},
+		},
+	}
+}
@@ -0,0 +1,102 @@
+package graph
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/graphql-go/graphql"
+)
+
+// fraudFields returns the admin-only fraud review queue: transactions
+// flagged or held by fraud screening.
+func (r *Resolver) fraudFields() (queries, mutations graphql.Fields) {
+	findingType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "FraudFinding",
+		Fields: graphql.Fields{
+			"rule":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"action": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "flag, hold or reject"},
+			"reason": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		},
+	})
+
+	reviewType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "FraudReview",
+		Fields: graphql.Fields{
+			"id":            &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"transactionId": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"userid":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"platformName":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"coinused":      &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+			"action":        &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "flag (already captured) or hold (captured on approval, voided on denial)"},
+			"findings":      &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(findingType)))},
+			"status":        &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "open, approved or denied"},
+			"reviewedBy":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"note":          &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"createdAt":     timeField(graphql.NewNonNull(graphql.String)),
+			"reviewedAt":    timeField(graphql.String),
+		},
+	})
+
+	queries = graphql.Fields{
+		"fraudReviews": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(reviewType))),
+			Args: graphql.FieldConfigArgument{
+				"status": &graphql.ArgumentConfig{Type: graphql.String},
+				"limit":  &graphql.ArgumentConfig{Type: graphql.Int},
+				"offset": &graphql.ArgumentConfig{Type: graphql.Int},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				limit, _ := p.Args["limit"].(int)
+				offset, _ := p.Args["offset"].(int)
+				return r.FraudReviews.List(p.Context, optString(p.Args, "status"), limit, offset)
+			},
+		},
+	}
+
+	decideArgs := graphql.FieldConfigArgument{
+		"id":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+		"note": &graphql.ArgumentConfig{Type: graphql.String},
+	}
+	reviewID := func(p graphql.ResolveParams) (int64, error) {
+		if err := requireAdmin(p); err != nil {
+			return 0, err
+		}
+		id, err := strconv.ParseInt(p.Args["id"].(string), 10, 64)
+		if err != nil {
+			return 0, errors.New("invalid fraud review id")
+		}
+		return id, nil
+	}
+
+	mutations = graphql.Fields{
+		"approveFraudReview": &graphql.Field{
+			Type:        reviewType,
+			Description: "closes a review; a held transaction is captured",
+			Args:        decideArgs,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				id, err := reviewID(p)
+				if err != nil {
+					return nil, err
+				}
+				return r.FraudReviews.Approve(p.Context, id, optString(p.Args, "note"), middleware.UserIDFromContext(p.Context))
+			},
+		},
+		"denyFraudReview": &graphql.Field{
+			Type:        reviewType,
+			Description: "closes a review; a held transaction is voided",
+			Args:        decideArgs,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				id, err := reviewID(p)
+				if err != nil {
+					return nil, err
+				}
+				return r.FraudReviews.Deny(p.Context, id, optString(p.Args, "note"), middleware.UserIDFromContext(p.Context))
+			},
+		},
+	}
+	return queries, mutations
+}
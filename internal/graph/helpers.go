@@ -0,0 +1,167 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var errAdminRequired = errors.New("admin token required")
+
+// requireAdmin rejects callers that did not present X-Admin-Token.
+func requireAdmin(p graphql.ResolveParams) error {
+	if !middleware.IsAdmin(p.Context) {
+		return errAdminRequired
+	}
+	return nil
+}
+
+var errOwnerRequired = errors.New("X-User-ID or admin token required")
+
+// ownerScope limits non-admin callers to the user-owned records (scheduled
+// spends, subscriptions) of the user in X-User-ID; admins see every record
+// of the tenant.
+func ownerScope(p graphql.ResolveParams) (string, error) {
+	if middleware.IsAdmin(p.Context) {
+		return "", nil
+	}
+	uid := middleware.UserIDFromContext(p.Context)
+	if uid == "" {
+		return "", errOwnerRequired
+	}
+	return uid, nil
+}
+
+// merge copies every field of srcs into dst.
+func merge(dst graphql.Fields, srcs ...graphql.Fields) graphql.Fields {
+	for _, src := range srcs {
+		for name, f := range src {
+			dst[name] = f
+		}
+	}
+	return dst
+}
+
+// timeField exposes a time.Time struct field as an RFC3339 string; zero and
+// nil times resolve to null.
+func timeField(t graphql.Output) *graphql.Field {
+	return &graphql.Field{
+		Type: t,
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			v, err := graphql.DefaultResolveFn(p)
+			if err != nil {
+				return nil, err
+			}
+			switch t := v.(type) {
+			case time.Time:
+				if t.IsZero() {
+					return nil, nil
+				}
+				return t.UTC().Format(time.RFC3339), nil
+			case *time.Time:
+				if t == nil || t.IsZero() {
+					return nil, nil
+				}
+				return t.UTC().Format(time.RFC3339), nil
+			default:
+				return nil, nil
+			}
+		},
+	}
+}
+
+// optString returns args[name] or "" when it is absent.
+func optString(args map[string]any, name string) string {
+	v, _ := args[name].(string)
+	return v
+}
+
+// sourceTransaction returns the transaction a Transaction field resolves
+// against, or nil.
+func sourceTransaction(v any) *models.Transaction {
+	switch t := v.(type) {
+	case models.Transaction:
+		return &t
+	case *models.Transaction:
+		return t
+	default:
+		return nil
+	}
+}
+
+// jsonScalar passes arbitrary JSON values through unchanged.
+var jsonScalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name:         "JSON",
+	Description:  "arbitrary JSON value",
+	Serialize:    func(v any) any { return v },
+	ParseValue:   func(v any) any { return v },
+	ParseLiteral: parseJSONLiteral,
+})
+
+func parseJSONLiteral(v ast.Value) any {
+	switch v := v.(type) {
+	case *ast.ObjectValue:
+		out := make(map[string]any, len(v.Fields))
+		for _, f := range v.Fields {
+			out[f.Name.Value] = parseJSONLiteral(f.Value)
+		}
+		return out
+	case *ast.ListValue:
+		out := make([]any, 0, len(v.Values))
+		for _, item := range v.Values {
+			out = append(out, parseJSONLiteral(item))
+		}
+		return out
+	case *ast.StringValue:
+		return v.Value
+	case *ast.EnumValue:
+		return v.Value
+	case *ast.BooleanValue:
+		return v.Value
+	case *ast.IntValue:
+		n, _ := strconv.ParseInt(v.Value, 10, 64)
+		return n
+	case *ast.FloatValue:
+		f, _ := strconv.ParseFloat(v.Value, 64)
+		return f
+	default:
+		return nil
+	}
+}
+
+// stringMap converts a JSON object argument with string values; nil stays
+// nil.
+func stringMap(name string, v any) (map[string]string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an object", name)
+	}
+	out := make(map[string]string, len(obj))
+	for k, val := range obj {
+		s, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s.%s must be a string", name, k)
+		}
+		out[k] = s
+	}
+	return out, nil
+}
+
+// stringList converts a [String!] argument; nil stays nil.
+func stringList(v any) []string {
+	items, _ := v.([]any)
+	var out []string
+	for _, item := range items {
+		out = append(out, item.(string))
+	}
+	return out
+}
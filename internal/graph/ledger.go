@@ -0,0 +1,92 @@
+package graph
+
+import (
+	"time"
+
+	"github.com/graphql-go/graphql"
+)
+
+// ledgerFields returns the admin-only account balance and statement
+// queries.
+func (r *Resolver) ledgerFields() graphql.Fields {
+	balanceType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "AccountBalance",
+		Fields: graphql.Fields{
+			"account": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"coinid":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"balance": &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+		},
+	})
+
+	postingType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Posting",
+		Fields: graphql.Fields{
+			"id":            &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"entryId":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"transactionId": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"account":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"coinid":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"amount":        &graphql.Field{Type: graphql.NewNonNull(graphql.Float), Description: "debits are positive, credits negative"},
+			"postedAt":      timeField(graphql.NewNonNull(graphql.String)),
+		},
+	})
+
+	statementType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "AccountStatement",
+		Fields: graphql.Fields{
+			"account":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"coinid":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"from":     timeField(graphql.String),
+			"to":       timeField(graphql.String),
+			"opening":  &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+			"closing":  &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+			"postings": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(postingType)))},
+		},
+	})
+
+	const accountDoc = "user:<userid> or platform:<platformName>"
+
+	return graphql.Fields{
+		"accountBalance": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(balanceType))),
+			Args: graphql.FieldConfigArgument{
+				"account": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String), Description: accountDoc},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				return r.Ledger.Balances(p.Context, p.Args["account"].(string))
+			},
+		},
+		"accountStatement": &graphql.Field{
+			Type: statementType,
+			Args: graphql.FieldConfigArgument{
+				"account": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String), Description: accountDoc},
+				"coinid":  &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"from":    &graphql.ArgumentConfig{Type: graphql.String}, // RFC3339
+				"to":      &graphql.ArgumentConfig{Type: graphql.String}, // RFC3339
+				"limit":   &graphql.ArgumentConfig{Type: graphql.Int},
+				"offset":  &graphql.ArgumentConfig{Type: graphql.Int},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				var from, to *time.Time
+				for name, dst := range map[string]**time.Time{"from": &from, "to": &to} {
+					if v := optString(p.Args, name); v != "" {
+						t, err := ParseISO(v)
+						if err != nil {
+							return nil, err
+						}
+						*dst = &t
+					}
+				}
+				limit, _ := p.Args["limit"].(int)
+				offset, _ := p.Args["offset"].(int)
+				return r.Ledger.Statement(p.Context, p.Args["account"].(string), p.Args["coinid"].(string), from, to, limit, offset)
+			},
+		},
+	}
+}
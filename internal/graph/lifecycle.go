@@ -0,0 +1,59 @@
+package graph
+
+import (
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/graphql-go/graphql"
+)
+
+func newStatusChangeType() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "StatusChange",
+		Fields: graphql.Fields{
+			"from":      &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "pending for the creation"},
+			"to":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"reason":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"actor":     &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"changedAt": timeField(graphql.NewNonNull(graphql.String)),
+		},
+	})
+}
+
+// lifecycleFields returns the hold mutations: authorize a transaction, then
+// capture or void it. Uncaptured holds are voided after the hold timeout.
+func (r *Resolver) lifecycleFields(transactionType *graphql.Object, addInput *graphql.InputObject) graphql.Fields {
+	return graphql.Fields{
+		"authorizeTransaction": &graphql.Field{
+			Type:        transactionType,
+			Description: "holds coinused against the user's caps without charging it",
+			Args: graphql.FieldConfigArgument{
+				"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(addInput)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				model, err := transactionFromInput(p.Args["input"].(map[string]any))
+				if err != nil {
+					return nil, err
+				}
+				return r.Repo.Authorize(p.Context, model)
+			},
+		},
+		"captureTransaction": &graphql.Field{
+			Type: transactionType,
+			Args: graphql.FieldConfigArgument{
+				"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return r.Repo.Capture(p.Context, p.Args["id"].(string), middleware.UserIDFromContext(p.Context))
+			},
+		},
+		"voidTransaction": &graphql.Field{
+			Type: transactionType,
+			Args: graphql.FieldConfigArgument{
+				"id":     &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"reason": &graphql.ArgumentConfig{Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return r.Repo.Void(p.Context, p.Args["id"].(string), optString(p.Args, "reason"), middleware.UserIDFromContext(p.Context))
+			},
+		},
+	}
+}
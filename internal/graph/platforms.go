@@ -0,0 +1,167 @@
+package graph
+
+import (
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/graphql-go/graphql"
+)
+
+// platformFields returns the admin-only platform registry queries and
+// mutations.
+func (r *Resolver) platformFields() (queries, mutations graphql.Fields) {
+	platformType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Platform",
+		Fields: graphql.Fields{
+			"name":         &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"displayName":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"ownerContact": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"allowedCoins": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))), Description: "empty allows every coin"},
+			"defaultAccessSeconds": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.Int),
+				Description: "fills a missing expiryDate; 0 requires one",
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					if pl, ok := p.Source.(*models.Platform); ok {
+						return int(pl.DefaultAccess / time.Second), nil
+					}
+					if pl, ok := p.Source.(models.Platform); ok {
+						return int(pl.DefaultAccess / time.Second), nil
+					}
+					return nil, nil
+				},
+			},
+			"webhookUrl":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"webhookSecret": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "signs webhook deliveries"},
+			"enabled":       &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+			"dedupWindowSeconds": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.Int),
+				Description: "repeats of a transaction within this many seconds are deduplicated; 0 disables",
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					if pl, ok := p.Source.(*models.Platform); ok {
+						return int(pl.DedupWindow / time.Second), nil
+					}
+					if pl, ok := p.Source.(models.Platform); ok {
+						return int(pl.DedupWindow / time.Second), nil
+					}
+					return nil, nil
+				},
+			},
+			"dedupAction": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "return or reject"},
+			"createdAt":   timeField(graphql.NewNonNull(graphql.String)),
+			"updatedAt":   timeField(graphql.NewNonNull(graphql.String)),
+		},
+	})
+
+	platformInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "PlatformInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"name":                 &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"displayName":          &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"ownerContact":         &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"allowedCoins":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+			"defaultAccessSeconds": &graphql.InputObjectFieldConfig{Type: graphql.Int},
+			"webhookUrl":           &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"enabled":              &graphql.InputObjectFieldConfig{Type: graphql.Boolean, DefaultValue: true},
+			"dedupWindowSeconds":   &graphql.InputObjectFieldConfig{Type: graphql.Int},
+			"dedupAction":          &graphql.InputObjectFieldConfig{Type: graphql.String, Description: "return (default) or reject"},
+		},
+	})
+
+	platformFromInput := func(in map[string]any) models.Platform {
+		p := models.Platform{Name: in["name"].(string)}
+		p.DisplayName, _ = in["displayName"].(string)
+		p.OwnerContact, _ = in["ownerContact"].(string)
+		if coins, ok := in["allowedCoins"].([]any); ok {
+			for _, c := range coins {
+				p.AllowedCoins = append(p.AllowedCoins, c.(string))
+			}
+		}
+		seconds, _ := in["defaultAccessSeconds"].(int)
+		p.DefaultAccess = time.Duration(seconds) * time.Second
+		p.WebhookURL, _ = in["webhookUrl"].(string)
+		p.Enabled, _ = in["enabled"].(bool)
+		dedupSeconds, _ := in["dedupWindowSeconds"].(int)
+		p.DedupWindow = time.Duration(dedupSeconds) * time.Second
+		p.DedupAction, _ = in["dedupAction"].(string)
+		return p
+	}
+
+	queries = graphql.Fields{
+		"platforms": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(platformType))),
+			Args: graphql.FieldConfigArgument{
+				"includeDisabled": &graphql.ArgumentConfig{Type: graphql.Boolean, DefaultValue: false},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				return r.Platforms.List(p.Context, p.Args["includeDisabled"] == true)
+			},
+		},
+		"platform": &graphql.Field{
+			Type: platformType,
+			Args: graphql.FieldConfigArgument{
+				"name": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				return r.Platforms.Get(p.Context, p.Args["name"].(string))
+			},
+		},
+	}
+
+	mutations = graphql.Fields{
+		"createPlatform": &graphql.Field{
+			Type: platformType,
+			Args: graphql.FieldConfigArgument{
+				"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(platformInput)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				return r.Platforms.Create(p.Context, platformFromInput(p.Args["input"].(map[string]any)))
+			},
+		},
+		"updatePlatform": &graphql.Field{
+			Type: platformType,
+			Args: graphql.FieldConfigArgument{
+				"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(platformInput)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				return r.Platforms.Update(p.Context, platformFromInput(p.Args["input"].(map[string]any)))
+			},
+		},
+		"rotateWebhookSecret": &graphql.Field{
+			Type: platformType,
+			Args: graphql.FieldConfigArgument{
+				"name": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				return r.Platforms.RotateWebhookSecret(p.Context, p.Args["name"].(string))
+			},
+		},
+		"deletePlatform": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Boolean),
+			Args: graphql.FieldConfigArgument{
+				"name": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				return r.Platforms.Delete(p.Context, p.Args["name"].(string))
+			},
+		},
+	}
+	return queries, mutations
+}
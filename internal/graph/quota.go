@@ -0,0 +1,45 @@
+package graph
+
+import (
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/devifyX/go-back-transaction-service/internal/quota"
+	"github.com/graphql-go/graphql"
+)
+
+// withQuota wraps every resolver in fields with a quota check keyed by the
+// field name and the platformName found in its input or filter argument.
+func (r *Resolver) withQuota(fields graphql.Fields) graphql.Fields {
+	for name, f := range fields {
+		resolve := f.Resolve
+		f.Resolve = func(p graphql.ResolveParams) (any, error) {
+			req := quota.Request{
+				Tenant:    middleware.TenantIDFromContext(p.Context),
+				Operation: name,
+				Platform:  platformArg(p.Args),
+				Caller:    middleware.UserIDFromContext(p.Context),
+				IP:        middleware.ClientIPFromContext(p.Context),
+				Admin:     middleware.IsAdmin(p.Context),
+				APIKey:    middleware.HasAPIKey(p.Context),
+			}
+			if err := r.Quota.Check(p.Context, req); err != nil {
+				return nil, err
+			}
+			return resolve(p)
+		}
+	}
+	return fields
+}
+
+func platformArg(args map[string]any) string {
+	for _, name := range []string{"input", "filter"} {
+		if m, ok := args[name].(map[string]any); ok {
+			if v, ok := m["platformName"].(string); ok {
+				return v
+			}
+		}
+	}
+	if v, ok := args["platformName"].(string); ok {
+		return v
+	}
+	return ""
+}
@@ -0,0 +1,130 @@
+package graph
+
+import (
+	"strings"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/devifyX/go-back-transaction-service/internal/rates"
+	"github.com/graphql-go/graphql"
+)
+
+// rateFields returns the exchange rate queries and mutations and the
+// normalized transactionTotals aggregation, which takes filterInput.
+func (r *Resolver) rateFields(filterInput *graphql.InputObject) (queries, mutations graphql.Fields) {
+	rateType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "ExchangeRate",
+		Description: "1 baseCoin is worth rate quoteCoin from effectiveAt until the pair's next rate",
+		Fields: graphql.Fields{
+			"baseCoin":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"quoteCoin":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"rate":        &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+			"effectiveAt": timeField(graphql.NewNonNull(graphql.String)),
+		},
+	})
+
+	rateInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "ExchangeRateInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"baseCoin":    &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"quoteCoin":   &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"rate":        &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Float)},
+			"effectiveAt": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)}, // RFC3339
+		},
+	})
+
+	coinTotalType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "CoinTotal",
+		Fields: graphql.Fields{
+			"coinid":      &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"count":       &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"total":       &graphql.Field{Type: graphql.NewNonNull(graphql.Float), Description: "in coinid"},
+			"value":       &graphql.Field{Type: graphql.NewNonNull(graphql.Float), Description: "in the reference coin"},
+			"unconverted": &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "rows without a rate, missing from value"},
+		},
+	})
+
+	totalsType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TransactionTotals",
+		Fields: graphql.Fields{
+			"referenceCoin": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"byCoin":        &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(coinTotalType)))},
+			"value":         &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+			"complete":      &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean), Description: "false when some rows had no rate"},
+		},
+	})
+
+	queries = graphql.Fields{
+		"exchangeRates": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(rateType))),
+			Args: graphql.FieldConfigArgument{
+				"baseCoin":  &graphql.ArgumentConfig{Type: graphql.String},
+				"quoteCoin": &graphql.ArgumentConfig{Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return r.Rates.List(p.Context, optString(p.Args, "baseCoin"), optString(p.Args, "quoteCoin"))
+			},
+		},
+		"transactionTotals": &graphql.Field{
+			Type: graphql.NewNonNull(totalsType),
+			Args: graphql.FieldConfigArgument{
+				"filter":        &graphql.ArgumentConfig{Type: filterInput},
+				"referenceCoin": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				f, err := filterFromArgs(p)
+				if err != nil {
+					return nil, err
+				}
+				return r.Repo.Totals(p.Context, f, p.Args["referenceCoin"].(string))
+			},
+		},
+	}
+
+	mutations = graphql.Fields{
+		"setExchangeRate": &graphql.Field{
+			Type: rateType,
+			Args: graphql.FieldConfigArgument{
+				"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(rateInput)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				in := p.Args["input"].(map[string]any)
+				at, err := time.Parse(time.RFC3339, in["effectiveAt"].(string))
+				if err != nil {
+					return nil, err
+				}
+				rt := models.ExchangeRate{
+					BaseCoin:    in["baseCoin"].(string),
+					QuoteCoin:   in["quoteCoin"].(string),
+					Rate:        in["rate"].(float64),
+					EffectiveAt: at,
+				}
+				if _, err := r.Rates.Import(p.Context, []models.ExchangeRate{rt}); err != nil {
+					return nil, err
+				}
+				return rt, nil
+			},
+		},
+		"importExchangeRates": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.Int),
+			Description: "imports a CSV file with header base,quote,rate,effective_at; returns the number of rates stored",
+			Args: graphql.FieldConfigArgument{
+				"csv": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				rs, err := rates.ParseCSV(strings.NewReader(p.Args["csv"].(string)))
+				if err != nil {
+					return nil, err
+				}
+				return r.Rates.Import(p.Context, rs)
+			},
+		},
+	}
+	return queries, mutations
+}
@@ -0,0 +1,195 @@
+package graph
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/devifyX/go-back-transaction-service/internal/reconcile"
+	"github.com/graphql-go/graphql"
+)
+
+// reconcileFields returns the admin-only settlement reconciliation
+// queries and mutations.
+func (r *Resolver) reconcileFields() (queries, mutations graphql.Fields) {
+	runType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ReconciliationRun",
+		Fields: graphql.Fields{
+			"id":              &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"platformName":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"fileName":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"from":            timeField(graphql.NewNonNull(graphql.String)),
+			"to":              timeField(graphql.NewNonNull(graphql.String)),
+			"amountTolerance": &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+			"timeToleranceSeconds": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					run, _ := p.Source.(*models.ReconciliationRun)
+					if run == nil {
+						if v, ok := p.Source.(models.ReconciliationRun); ok {
+							run = &v
+						}
+					}
+					if run == nil {
+						return nil, nil
+					}
+					return int(run.TimeTolerance / time.Second), nil
+				},
+			},
+			"matched":          &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"missingOurs":      &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "settlement lines without a transaction"},
+			"missingTheirs":    &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "transactions absent from the settlement file"},
+			"amountMismatches": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"createdBy":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"createdAt":        timeField(graphql.NewNonNull(graphql.String)),
+		},
+	})
+
+	itemType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ReconciliationItem",
+		Fields: graphql.Fields{
+			"id":             &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"runId":          &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"kind":           &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "matched, missing_ours, missing_theirs or amount_mismatch"},
+			"status":         &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "open or resolved"},
+			"dataid":         &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"userid":         &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"theirAmount":    &graphql.Field{Type: graphql.Float},
+			"ourAmount":      &graphql.Field{Type: graphql.Float},
+			"theirTimestamp": timeField(graphql.String),
+			"ourTimestamp":   timeField(graphql.String),
+			"transactionId":  &graphql.Field{Type: graphql.String},
+			"resolution":     &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"resolvedBy":     &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"resolvedAt":     timeField(graphql.String),
+		},
+	})
+
+	queries = graphql.Fields{
+		"reconciliationRuns": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(runType))),
+			Args: graphql.FieldConfigArgument{
+				"platformName": &graphql.ArgumentConfig{Type: graphql.String},
+				"limit":        &graphql.ArgumentConfig{Type: graphql.Int},
+				"offset":       &graphql.ArgumentConfig{Type: graphql.Int},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				limit, _ := p.Args["limit"].(int)
+				offset, _ := p.Args["offset"].(int)
+				return r.Reconcile.Repo.Runs(p.Context, optString(p.Args, "platformName"), limit, offset)
+			},
+		},
+		"reconciliationRun": &graphql.Field{
+			Type: runType,
+			Args: graphql.FieldConfigArgument{
+				"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				return r.Reconcile.Repo.GetRun(p.Context, p.Args["id"].(string))
+			},
+		},
+		"reconciliationItems": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(itemType))),
+			Description: "items of one run, or of every run when runId is omitted",
+			Args: graphql.FieldConfigArgument{
+				"runId":  &graphql.ArgumentConfig{Type: graphql.String},
+				"kind":   &graphql.ArgumentConfig{Type: graphql.String},
+				"status": &graphql.ArgumentConfig{Type: graphql.String},
+				"limit":  &graphql.ArgumentConfig{Type: graphql.Int},
+				"offset": &graphql.ArgumentConfig{Type: graphql.Int},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				f := db.ItemFilter{
+					RunID:  optString(p.Args, "runId"),
+					Kind:   optString(p.Args, "kind"),
+					Status: optString(p.Args, "status"),
+				}
+				f.Limit, _ = p.Args["limit"].(int)
+				f.Offset, _ = p.Args["offset"].(int)
+				return r.Reconcile.Repo.Items(p.Context, f)
+			},
+		},
+	}
+
+	mutations = graphql.Fields{
+		"reconcileSettlement": &graphql.Field{
+			Type:        graphql.NewNonNull(runType),
+			Description: "checks a settlement CSV (header dataid,userid,coinused,timestamp) against the platform's transactions",
+			Args: graphql.FieldConfigArgument{
+				"platformName":         &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"csv":                  &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"fileName":             &graphql.ArgumentConfig{Type: graphql.String},
+				"amountTolerance":      &graphql.ArgumentConfig{Type: graphql.Float},
+				"timeToleranceSeconds": &graphql.ArgumentConfig{Type: graphql.Int},
+				"from":                 &graphql.ArgumentConfig{Type: graphql.String, Description: "RFC3339; defaults to the first line minus the time tolerance"},
+				"to":                   &graphql.ArgumentConfig{Type: graphql.String, Description: "RFC3339; defaults to the last line plus the time tolerance"},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				lines, err := reconcile.ParseCSV(strings.NewReader(p.Args["csv"].(string)))
+				if err != nil {
+					return nil, err
+				}
+				req := reconcile.Request{
+					Platform:  p.Args["platformName"].(string),
+					FileName:  optString(p.Args, "fileName"),
+					Lines:     lines,
+					Tolerance: r.Reconcile.Defaults,
+					CreatedBy: middleware.UserIDFromContext(p.Context),
+				}
+				if v, ok := p.Args["amountTolerance"].(float64); ok {
+					req.Tolerance.Amount = v
+				}
+				if v, ok := p.Args["timeToleranceSeconds"].(int); ok {
+					req.Tolerance.Time = time.Duration(v) * time.Second
+				}
+				for name, dst := range map[string]**time.Time{"from": &req.From, "to": &req.To} {
+					if s := optString(p.Args, name); s != "" {
+						t, err := ParseISO(s)
+						if err != nil {
+							return nil, errors.New("invalid " + name + " timestamp")
+						}
+						*dst = &t
+					}
+				}
+				return r.Reconcile.Run(p.Context, req)
+			},
+		},
+		"resolveReconciliationItem": &graphql.Field{
+			Type: itemType,
+			Args: graphql.FieldConfigArgument{
+				"id":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"note": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				id, err := strconv.ParseInt(p.Args["id"].(string), 10, 64)
+				if err != nil {
+					return nil, errors.New("invalid reconciliation item id")
+				}
+				if strings.TrimSpace(p.Args["note"].(string)) == "" {
+					return nil, errors.New("note is required")
+				}
+				return r.Reconcile.Repo.Resolve(p.Context, id, p.Args["note"].(string), middleware.UserIDFromContext(p.Context))
+			},
+		},
+	}
+	return queries, mutations
+}
@@ -0,0 +1,57 @@
+package graph
+
+import (
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/graphql-go/graphql"
+)
+
+// renewalFields returns the extendAccess mutation.
+func (r *Resolver) renewalFields(transactionType *graphql.Object) graphql.Fields {
+	renewalType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Renewal",
+		Fields: graphql.Fields{
+			"transaction":    &graphql.Field{Type: graphql.NewNonNull(transactionType), Description: "the renewal charge"},
+			"originalId":     &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"previousExpiry": timeField(graphql.NewNonNull(graphql.String)),
+			"expiryDate":     timeField(graphql.NewNonNull(graphql.String)),
+		},
+	})
+
+	input := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "ExtendAccessInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"userid":          &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"dataid":          &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"platformName":    &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"coinid":          &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"coinused":        &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Float)},
+			"durationSeconds": &graphql.InputObjectFieldConfig{Type: graphql.Int}, // defaults to the platform's default access
+		},
+	})
+
+	return graphql.Fields{
+		"extendAccess": &graphql.Field{
+			Type:        renewalType,
+			Description: "charges a renewal and extends the entitlement from its current expiry, or from now if it lapsed",
+			Args: graphql.FieldConfigArgument{
+				"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(input)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				in := p.Args["input"].(map[string]any)
+				req := models.RenewalRequest{
+					UserID:       in["userid"].(string),
+					DataID:       in["dataid"].(string),
+					PlatformName: in["platformName"].(string),
+					CoinID:       in["coinid"].(string),
+					CoinUsed:     in["coinused"].(float64),
+				}
+				if v, ok := in["durationSeconds"].(int); ok {
+					req.Duration = time.Duration(v) * time.Second
+				}
+				return r.Repo.ExtendAccess(p.Context, req)
+			},
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package graph
+
+import (
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/graphql-go/graphql"
+)
+
+func newRevisionType() *graphql.Object {
+	fieldChangeType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "FieldChange",
+		Fields: graphql.Fields{
+			"field": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"from":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"to":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		},
+	})
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "TransactionRevision",
+		Fields: graphql.Fields{
+			"version":   &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "version the edit produced"},
+			"changes":   &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(fieldChangeType)))},
+			"reason":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"actor":     &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"changedAt": timeField(graphql.NewNonNull(graphql.String)),
+		},
+	})
+}
+
+// revisionFields returns the admin-only updateTransaction mutation.
+func (r *Resolver) revisionFields(transactionType *graphql.Object) graphql.Fields {
+	input := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateTransactionInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"id":           &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"version":      &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Int), Description: "version the edit is based on"},
+			"fields":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))), Description: "expiryDate, platformName"},
+			"expiryDate":   &graphql.InputObjectFieldConfig{Type: graphql.String}, // RFC3339
+			"platformName": &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"reason":       &graphql.InputObjectFieldConfig{Type: graphql.String},
+		},
+	})
+
+	return graphql.Fields{
+		"updateTransaction": &graphql.Field{
+			Type:        transactionType,
+			Description: "edits the listed fields; fails if the transaction is no longer at version",
+			Args: graphql.FieldConfigArgument{
+				"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(input)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				in := p.Args["input"].(map[string]any)
+				u := models.TransactionUpdate{
+					ID:           in["id"].(string),
+					Version:      int64(in["version"].(int)),
+					PlatformName: optString(in, "platformName"),
+					Reason:       optString(in, "reason"),
+				}
+				for _, f := range in["fields"].([]any) {
+					u.Fields = append(u.Fields, f.(string))
+				}
+				exp, err := ParseISO(optString(in, "expiryDate"))
+				if err != nil {
+					return nil, err
+				}
+				u.ExpiryDate = exp
+				return r.Repo.Update(p.Context, u, middleware.UserIDFromContext(p.Context))
+			},
+		},
+	}
+}
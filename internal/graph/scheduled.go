@@ -0,0 +1,127 @@
+package graph
+
+import (
+	"errors"
+
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/graphql-go/graphql"
+)
+
+// scheduledFields returns the queries and mutations for spends queued to
+// execute at a future time; cmd/worker executes them when due.
+func (r *Resolver) scheduledFields() (queries, mutations graphql.Fields) {
+	scheduledType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ScheduledTransaction",
+		Fields: graphql.Fields{
+			"id":            &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"coinid":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"userid":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"dataid":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"coinused":      &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+			"expiryDate":    timeField(graphql.String),
+			"platformName":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"executeAt":     timeField(graphql.NewNonNull(graphql.String)),
+			"status":        &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "pending, executed, failed or cancelled"},
+			"transactionId": &graphql.Field{Type: graphql.String, Description: "set once executed"},
+			"error":         &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "why the last execution failed"},
+			"createdBy":     &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"createdAt":     timeField(graphql.NewNonNull(graphql.String)),
+			"updatedAt":     timeField(graphql.NewNonNull(graphql.String)),
+		},
+	})
+
+	input := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "ScheduleTransactionInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"coinid":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"userid":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"dataid":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"coinused":     &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Float)},
+			"executeAt":    &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)}, // RFC3339
+			"expiryDate":   &graphql.InputObjectFieldConfig{Type: graphql.String},                     // RFC3339; defaults from the platform at execution
+			"platformName": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+		},
+	})
+
+	queries = graphql.Fields{
+		"scheduledTransactions": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(scheduledType))),
+			Args: graphql.FieldConfigArgument{
+				"userid": &graphql.ArgumentConfig{Type: graphql.String},
+				"status": &graphql.ArgumentConfig{Type: graphql.String},
+				"limit":  &graphql.ArgumentConfig{Type: graphql.Int},
+				"offset": &graphql.ArgumentConfig{Type: graphql.Int},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				owner, err := ownerScope(p)
+				if err != nil {
+					return nil, err
+				}
+				limit, _ := p.Args["limit"].(int)
+				offset, _ := p.Args["offset"].(int)
+				return r.Scheduled.List(p.Context, owner, optString(p.Args, "userid"), optString(p.Args, "status"), limit, offset)
+			},
+		},
+		"scheduledTransaction": &graphql.Field{
+			Type: scheduledType,
+			Args: graphql.FieldConfigArgument{
+				"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				owner, err := ownerScope(p)
+				if err != nil {
+					return nil, err
+				}
+				return r.Scheduled.Get(p.Context, p.Args["id"].(string), owner)
+			},
+		},
+	}
+
+	mutations = graphql.Fields{
+		"scheduleTransaction": &graphql.Field{
+			Type:        scheduledType,
+			Description: "queues a spend for executeAt; spending caps are checked when it executes",
+			Args: graphql.FieldConfigArgument{
+				"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(input)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				in := p.Args["input"].(map[string]any)
+				at, err := ParseISO(in["executeAt"].(string))
+				if err != nil {
+					return nil, errors.New("invalid executeAt timestamp")
+				}
+				s := models.ScheduledTransaction{
+					CoinID:       in["coinid"].(string),
+					UserID:       in["userid"].(string),
+					DataID:       in["dataid"].(string),
+					CoinUsed:     in["coinused"].(float64),
+					PlatformName: in["platformName"].(string),
+					ExecuteAt:    at,
+				}
+				if raw, _ := in["expiryDate"].(string); raw != "" {
+					exp, err := ParseISO(raw)
+					if err != nil {
+						return nil, errors.New("invalid expiryDate timestamp")
+					}
+					s.ExpiryDate = &exp
+				}
+				return r.Scheduled.Schedule(p.Context, s, middleware.UserIDFromContext(p.Context))
+			},
+		},
+		"cancelScheduledTransaction": &graphql.Field{
+			Type: scheduledType,
+			Args: graphql.FieldConfigArgument{
+				"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				owner, err := ownerScope(p)
+				if err != nil {
+					return nil, err
+				}
+				return r.Scheduled.Cancel(p.Context, p.Args["id"].(string), owner)
+			},
+		},
+	}
+	return queries, mutations
+}
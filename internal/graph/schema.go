@@ -1,15 +1,35 @@
 package graph
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/devifyX/go-back-transaction-service/internal/archive"
+	"github.com/devifyX/go-back-transaction-service/internal/audit"
 	"github.com/devifyX/go-back-transaction-service/internal/db"
 	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/devifyX/go-back-transaction-service/internal/quota"
+	"github.com/devifyX/go-back-transaction-service/internal/reconcile"
 	"github.com/graphql-go/graphql"
+	"github.com/jackc/pgx/v5"
 )
 
 type Resolver struct {
-	Repo *db.TransactionRepo
+	Repo          *db.TransactionRepo
+	Caps          *db.CapRepo
+	Tenants       *db.TenantRepo
+	Quota         *quota.Enforcer // optional; nil disables quota checks
+	Audit         *audit.Recorder // optional; nil disables auditing
+	Coins         *db.CoinRepo
+	Platforms     *db.PlatformRepo
+	Rates         *db.RateRepo
+	Ledger        *db.LedgerRepo
+	Reconcile     *reconcile.Reconciler
+	Subscriptions *db.SubscriptionRepo
+	Scheduled     *db.ScheduledRepo
+	FraudReviews  *db.FraudReviewRepo
+	Archive       *archive.Archiver // optional; enables includeArchived lookups
 }
 
 func ParseISO(s string) (time.Time, error) {
@@ -20,6 +40,10 @@ func ParseISO(s string) (time.Time, error) {
 }
 
 func NewSchema(res *Resolver) (graphql.Schema, error) {
+	coinType, coinQueries, coinMutations := res.coinFields()
+	statusChangeType := newStatusChangeType()
+	revisionType := newRevisionType()
+
 	// GraphQL type for a transaction. We resolve time fields as RFC3339 strings.
 	transactionType := graphql.NewObject(graphql.ObjectConfig{
 		Name: "Transaction",
@@ -30,6 +54,62 @@ func NewSchema(res *Resolver) (graphql.Schema, error) {
 			"dataid":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
 			"coinused":     &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
 			"platformName": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"tenantId":     &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"deletedAt":    timeField(graphql.String),
+			"status": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.String),
+				Description: "authorized, captured or voided",
+			},
+			"holdExpiresAt": timeField(graphql.String),
+			"renewsId":      &graphql.Field{Type: graphql.String, Description: "original purchase of a renewal"},
+			"metadata":      &graphql.Field{Type: jsonScalar, Description: "string map of caller-defined context"},
+			"tags":          &graphql.Field{Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+			"version":       &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "bumped on every update; send it back with updateTransaction"},
+			"statusHistory": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(statusChangeType))),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					t := sourceTransaction(p.Source)
+					if t == nil {
+						return nil, nil
+					}
+					return res.Repo.History(p.Context, t.ID)
+				},
+			},
+			"revisions": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(revisionType))),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					t := sourceTransaction(p.Source)
+					if t == nil {
+						return nil, nil
+					}
+					return res.Repo.Revisions(p.Context, t.ID)
+				},
+			},
+			"coin": &graphql.Field{
+				Type:        coinType,
+				Description: "catalog entry for coinid",
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					t := sourceTransaction(p.Source)
+					if t == nil {
+						return nil, nil
+					}
+					return res.Coins.Get(p.Context, t.CoinID)
+				},
+			},
+			"valueIn": &graphql.Field{
+				Type:        graphql.Float,
+				Description: "coinused converted into coin at the rate effective at transactionTimestamp",
+				Args: graphql.FieldConfigArgument{
+					"coin": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					t := sourceTransaction(p.Source)
+					if t == nil {
+						return nil, nil
+					}
+					return res.Rates.Convert(p.Context, t.CoinUsed, t.CoinID, p.Args["coin"].(string), t.TransactionTimestamp)
+				},
+			},
 
 			"transactionTimestamp": &graphql.Field{
 				Type: graphql.NewNonNull(graphql.String),
@@ -60,6 +140,23 @@ func NewSchema(res *Resolver) (graphql.Schema, error) {
 		},
 	})
 
+	sortFieldEnum := graphql.NewEnum(graphql.EnumConfig{
+		Name: "TransactionSortField",
+		Values: graphql.EnumValueConfigMap{
+			"TRANSACTION_TIMESTAMP": &graphql.EnumValueConfig{Value: db.SortByTimestamp},
+			"EXPIRY_DATE":           &graphql.EnumValueConfig{Value: db.SortByExpiry},
+			"COINUSED":              &graphql.EnumValueConfig{Value: db.SortByCoinUsed},
+		},
+	})
+	sortDirectionEnum := graphql.NewEnum(graphql.EnumConfig{
+		Name: "SortDirection",
+		Values: graphql.EnumValueConfigMap{
+			"ASC":  &graphql.EnumValueConfig{Value: "asc"},
+			"DESC": &graphql.EnumValueConfig{Value: "desc"},
+		},
+	})
+	stringListType := graphql.NewList(graphql.NewNonNull(graphql.String))
+
 	filterInput := graphql.NewInputObject(graphql.InputObjectConfig{
 		Name: "TransactionFilter",
 		Fields: graphql.InputObjectConfigFieldMap{
@@ -68,10 +165,27 @@ func NewSchema(res *Resolver) (graphql.Schema, error) {
 			"coinid":        &graphql.InputObjectFieldConfig{Type: graphql.String},
 			"dataid":        &graphql.InputObjectFieldConfig{Type: graphql.String},
 			"platformName":  &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"status":        &graphql.InputObjectFieldConfig{Type: graphql.String},
 			"fromTimestamp": &graphql.InputObjectFieldConfig{Type: graphql.String}, // RFC3339
 			"toTimestamp":   &graphql.InputObjectFieldConfig{Type: graphql.String}, // RFC3339
+			"userids":       &graphql.InputObjectFieldConfig{Type: stringListType, Description: "matches any"},
+			"coinids":       &graphql.InputObjectFieldConfig{Type: stringListType, Description: "matches any"},
+			"dataids":       &graphql.InputObjectFieldConfig{Type: stringListType, Description: "matches any"},
+			"platformNames": &graphql.InputObjectFieldConfig{Type: stringListType, Description: "matches any"},
+			"dataidPrefix":  &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"minCoinused":   &graphql.InputObjectFieldConfig{Type: graphql.Float, Description: "inclusive"},
+			"maxCoinused":   &graphql.InputObjectFieldConfig{Type: graphql.Float, Description: "inclusive"},
+			"expiresFrom":   &graphql.InputObjectFieldConfig{Type: graphql.String, Description: "RFC3339, inclusive, on expiryDate"},
+			"expiresTo":     &graphql.InputObjectFieldConfig{Type: graphql.String, Description: "RFC3339, inclusive, on expiryDate"},
+			"activeAt":      &graphql.InputObjectFieldConfig{Type: graphql.String, Description: "RFC3339; bought by then and not yet expired"},
+			"sortBy":        &graphql.InputObjectFieldConfig{Type: sortFieldEnum, DefaultValue: db.SortByTimestamp},
+			"sortDirection": &graphql.InputObjectFieldConfig{Type: sortDirectionEnum, DefaultValue: "desc"},
+			"metadata":      &graphql.InputObjectFieldConfig{Type: jsonScalar, Description: "string map; every key must have the given value"},
+			"tags":          &graphql.InputObjectFieldConfig{Type: stringListType, Description: "every tag must be present"},
 			"limit":         &graphql.InputObjectFieldConfig{Type: graphql.Int},
 			"offset":        &graphql.InputObjectFieldConfig{Type: graphql.Int},
+
+			"includeDeleted": &graphql.InputObjectFieldConfig{Type: graphql.Boolean, Description: "admin only"},
 		},
 	})
 
@@ -83,22 +197,45 @@ func NewSchema(res *Resolver) (graphql.Schema, error) {
 			"dataid":               &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
 			"coinused":             &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Float)},
 			"transactionTimestamp": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)}, // RFC3339
-			"expiryDate":           &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)}, // RFC3339
+			"expiryDate":           &graphql.InputObjectFieldConfig{Type: graphql.String},                     // RFC3339; defaults from the platform
 			"platformName":         &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"metadata":             &graphql.InputObjectFieldConfig{Type: jsonScalar, Description: "string map, at most 32 keys"},
+			"tags":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.String)), Description: "at most 16"},
 		},
 	})
 
+	capQueries, capMutations := res.capFields()
+	rateQueries, rateMutations := res.rateFields(filterInput)
+	platformQueries, platformMutations := res.platformFields()
+	tenantQueries, tenantMutations := res.tenantFields()
+	reconcileQueries, reconcileMutations := res.reconcileFields()
+	subscriptionQueries, subscriptionMutations := res.subscriptionFields(transactionType)
+	scheduledQueries, scheduledMutations := res.scheduledFields()
+	fraudQueries, fraudMutations := res.fraudFields()
+
 	rootQuery := graphql.NewObject(graphql.ObjectConfig{
 		Name: "Query",
-		Fields: graphql.Fields{
+		Fields: res.withQuota(res.withAudit(merge(graphql.Fields{
 			"getTransactionByID": &graphql.Field{
 				Type: transactionType,
 				Args: graphql.FieldConfigArgument{
-					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"id":              &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"includeArchived": &graphql.ArgumentConfig{Type: graphql.Boolean, DefaultValue: false},
+					"includeDeleted":  &graphql.ArgumentConfig{Type: graphql.Boolean, DefaultValue: false, Description: "admin only"},
 				},
 				Resolve: func(p graphql.ResolveParams) (any, error) {
 					id := p.Args["id"].(string)
-					return res.Repo.GetByID(p.Context, id)
+					withDeleted := p.Args["includeDeleted"] == true
+					if withDeleted {
+						if err := requireAdmin(p); err != nil {
+							return nil, err
+						}
+					}
+					t, err := res.Repo.GetByID(p.Context, id, withDeleted)
+					if errors.Is(err, pgx.ErrNoRows) && p.Args["includeArchived"] == true && res.Archive != nil {
+						return res.Archive.GetByID(p.Context, id, withDeleted)
+					}
+					return t, err
 				},
 			},
 			"getTransactions": &graphql.Field{
@@ -107,79 +244,33 @@ func NewSchema(res *Resolver) (graphql.Schema, error) {
 					"filter": &graphql.ArgumentConfig{Type: filterInput},
 				},
 				Resolve: func(p graphql.ResolveParams) (any, error) {
-					var f db.TransactionFilter
-					if raw, ok := p.Args["filter"].(map[string]any); ok {
-						if v, ok := raw["id"].(string); ok {
-							f.ID = &v
-						}
-						if v, ok := raw["userid"].(string); ok {
-							f.UserID = &v
-						}
-						if v, ok := raw["coinid"].(string); ok {
-							f.CoinID = &v
-						}
-						if v, ok := raw["dataid"].(string); ok {
-							f.DataID = &v
-						}
-						if v, ok := raw["platformName"].(string); ok {
-							f.PlatformName = &v
-						}
-						if v, ok := raw["fromTimestamp"].(string); ok && v != "" {
-							if t, err := ParseISO(v); err == nil {
-								f.FromTimestamp = &t
-							}
-						}
-						if v, ok := raw["toTimestamp"].(string); ok && v != "" {
-							if t, err := ParseISO(v); err == nil {
-								f.ToTimestamp = &t
-							}
-						}
-						if v, ok := raw["limit"].(int); ok {
-							f.Limit = v
-						}
-						if v, ok := raw["offset"].(int); ok {
-							f.Offset = v
-						}
+					f, err := filterFromArgs(p)
+					if err != nil {
+						return nil, err
 					}
 					return res.Repo.List(p.Context, f)
 				},
 			},
-		},
+		}, capQueries, coinQueries, fraudQueries, platformQueries, rateQueries, reconcileQueries, scheduledQueries, subscriptionQueries, tenantQueries, res.ledgerFields(), res.auditFields()), true)),
 	})
 
 	rootMutation := graphql.NewObject(graphql.ObjectConfig{
 		Name: "Mutation",
-		Fields: graphql.Fields{
+		Fields: res.withQuota(res.withAudit(merge(graphql.Fields{
 			"addTransaction": &graphql.Field{
 				Type: transactionType,
 				Args: graphql.FieldConfigArgument{
 					"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(addInput)},
 				},
 				Resolve: func(p graphql.ResolveParams) (any, error) {
-					in := p.Args["input"].(map[string]any)
-
-					txTime, err := ParseISO(in["transactionTimestamp"].(string))
-					if err != nil {
-						return nil, err
-					}
-					exp, err := ParseISO(in["expiryDate"].(string))
+					model, err := transactionFromInput(p.Args["input"].(map[string]any))
 					if err != nil {
 						return nil, err
 					}
-
-					model := models.Transaction{
-						CoinID:               in["coinid"].(string),
-						UserID:               in["userid"].(string),
-						DataID:               in["dataid"].(string),
-						CoinUsed:             in["coinused"].(float64),
-						TransactionTimestamp: txTime,
-						ExpiryDate:           exp,
-						PlatformName:         in["platformName"].(string),
-					}
 					return res.Repo.Insert(p.Context, model)
 				},
 			},
-		},
+		}, capMutations, coinMutations, fraudMutations, platformMutations, rateMutations, reconcileMutations, scheduledMutations, subscriptionMutations, tenantMutations, res.lifecycleFields(transactionType, addInput), res.renewalFields(transactionType), res.revisionFields(transactionType), res.erasureFields(transactionType)), false)),
 	})
 
 	return graphql.NewSchema(graphql.SchemaConfig{
@@ -187,3 +278,103 @@ func NewSchema(res *Resolver) (graphql.Schema, error) {
 		Mutation: rootMutation,
 	})
 }
+
+// transactionFromInput converts an AddTransactionInput.
+func transactionFromInput(in map[string]any) (models.Transaction, error) {
+	txTime, err := ParseISO(in["transactionTimestamp"].(string))
+	if err != nil {
+		return models.Transaction{}, err
+	}
+	expRaw, _ := in["expiryDate"].(string)
+	exp, err := ParseISO(expRaw)
+	if err != nil {
+		return models.Transaction{}, err
+	}
+	metadata, err := stringMap("metadata", in["metadata"])
+	if err != nil {
+		return models.Transaction{}, err
+	}
+	return models.Transaction{
+		CoinID:               in["coinid"].(string),
+		UserID:               in["userid"].(string),
+		DataID:               in["dataid"].(string),
+		CoinUsed:             in["coinused"].(float64),
+		TransactionTimestamp: txTime,
+		ExpiryDate:           exp,
+		PlatformName:         in["platformName"].(string),
+		Metadata:             metadata,
+		Tags:                 stringList(in["tags"]),
+	}, nil
+}
+
+// filterFromArgs reads the optional TransactionFilter argument "filter".
+func filterFromArgs(p graphql.ResolveParams) (db.TransactionFilter, error) {
+	var f db.TransactionFilter
+	if raw, ok := p.Args["filter"].(map[string]any); ok {
+		if v, ok := raw["id"].(string); ok {
+			f.ID = &v
+		}
+		if v, ok := raw["userid"].(string); ok {
+			f.UserID = &v
+		}
+		if v, ok := raw["coinid"].(string); ok {
+			f.CoinID = &v
+		}
+		if v, ok := raw["dataid"].(string); ok {
+			f.DataID = &v
+		}
+		if v, ok := raw["platformName"].(string); ok {
+			f.PlatformName = &v
+		}
+		if v, ok := raw["status"].(string); ok {
+			f.Status = &v
+		}
+		for name, dst := range map[string]**time.Time{
+			"fromTimestamp": &f.FromTimestamp,
+			"toTimestamp":   &f.ToTimestamp,
+			"expiresFrom":   &f.ExpiresFrom,
+			"expiresTo":     &f.ExpiresTo,
+			"activeAt":      &f.ActiveAt,
+		} {
+			if v, ok := raw[name].(string); ok && v != "" {
+				t, err := ParseISO(v)
+				if err != nil {
+					return f, fmt.Errorf("filter.%s: %w", name, err)
+				}
+				*dst = &t
+			}
+		}
+		f.UserIDs = stringList(raw["userids"])
+		f.CoinIDs = stringList(raw["coinids"])
+		f.DataIDs = stringList(raw["dataids"])
+		f.PlatformNames = stringList(raw["platformNames"])
+		f.DataIDPrefix, _ = raw["dataidPrefix"].(string)
+		if v, ok := raw["minCoinused"].(float64); ok {
+			f.MinCoinUsed = &v
+		}
+		if v, ok := raw["maxCoinused"].(float64); ok {
+			f.MaxCoinUsed = &v
+		}
+		f.SortBy, _ = raw["sortBy"].(string)
+		f.Ascending = raw["sortDirection"] == "asc"
+		metadata, err := stringMap("metadata", raw["metadata"])
+		if err != nil {
+			return f, err
+		}
+		f.Metadata = metadata
+		f.Tags = stringList(raw["tags"])
+		if v, ok := raw["limit"].(int); ok {
+			f.Limit = v
+		}
+		if v, ok := raw["offset"].(int); ok {
+			f.Offset = v
+		}
+		if v, ok := raw["includeDeleted"].(bool); ok && v {
+			if err := requireAdmin(p); err != nil {
+				return f, err
+			}
+			f.IncludeDeleted = true
+		}
+	}
+	return f, nil
+}
@@ -0,0 +1,37 @@
+package graph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/graphql-go/graphql"
+)
+
+// TestNewSchema catches type-name clashes and invalid field configs, which
+// graphql-go only reports when the schema is assembled.
+func TestNewSchema(t *testing.T) {
+	if _, err := NewSchema(&Resolver{}); err != nil {
+		t.Fatalf("NewSchema: %v", err)
+	}
+}
+
+func TestFilterFromArgs(t *testing.T) {
+	params := func(filter map[string]any) graphql.ResolveParams {
+		return graphql.ResolveParams{Context: context.Background(), Args: map[string]any{"filter": filter}}
+	}
+	f, err := filterFromArgs(params(map[string]any{
+		"activeAt": "2024-05-01T00:00:00Z", "userids": []any{"a", "b"}, "minCoinused": 1.5, "sortBy": db.SortByExpiry, "sortDirection": "asc",
+	}))
+	if err != nil {
+		t.Fatalf("valid filter rejected: %v", err)
+	}
+	if f.ActiveAt == nil || len(f.UserIDs) != 2 || *f.MinCoinUsed != 1.5 || f.SortBy != db.SortByExpiry || !f.Ascending {
+		t.Errorf("filter = %+v", f)
+	}
+	for _, name := range []string{"fromTimestamp", "toTimestamp", "expiresFrom", "expiresTo", "activeAt"} {
+		if _, err := filterFromArgs(params(map[string]any{name: "yesterday"})); err == nil {
+			t.Errorf("%s: invalid timestamp accepted", name)
+		}
+	}
+}
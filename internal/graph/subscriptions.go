@@ -0,0 +1,208 @@
+package graph
+
+import (
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/graphql-go/graphql"
+)
+
+// subscriptionFields returns the recurring charge queries and mutations.
+// Plans are managed by admins; subscriptions are charged by cmd/worker.
+func (r *Resolver) subscriptionFields(transactionType *graphql.Object) (queries, mutations graphql.Fields) {
+	planType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "SubscriptionPlan",
+		Fields: graphql.Fields{
+			"id":           &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"name":         &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"platformName": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"dataid":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"coinid":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"amount":       &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+			"period":       &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "daily, weekly, monthly or yearly"},
+			"active":       &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean), Description: "open to new subscribers"},
+			"createdAt":    timeField(graphql.NewNonNull(graphql.String)),
+		},
+	})
+
+	eventType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "SubscriptionEvent",
+		Fields: graphql.Fields{
+			"id":            &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"type":          &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "charged, charge_failed, lapsed, paused, resumed or cancelled"},
+			"transactionId": &graphql.Field{Type: graphql.String},
+			"detail":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"createdAt":     timeField(graphql.NewNonNull(graphql.String)),
+			"deliveredAt":   timeField(graphql.String),
+		},
+	})
+
+	subType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "UserSubscription",
+		Fields: graphql.Fields{
+			"id":             &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"planId":         &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"userid":         &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"status":         &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "active, past_due, paused, cancelled or lapsed"},
+			"startedAt":      timeField(graphql.NewNonNull(graphql.String)),
+			"cycle":          &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "periods charged so far"},
+			"nextChargeAt":   timeField(graphql.NewNonNull(graphql.String)),
+			"failedAttempts": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"graceUntil":     timeField(graphql.String),
+			"lastError":      &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"createdAt":      timeField(graphql.NewNonNull(graphql.String)),
+			"updatedAt":      timeField(graphql.NewNonNull(graphql.String)),
+			"events": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(eventType))),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					switch s := p.Source.(type) {
+					case *models.Subscription:
+						return r.Subscriptions.Events(p.Context, s.ID, "")
+					case models.Subscription:
+						return r.Subscriptions.Events(p.Context, s.ID, "")
+					}
+					return nil, nil
+				},
+			},
+		},
+	})
+
+	subscribeResult := graphql.NewObject(graphql.ObjectConfig{
+		Name: "SubscribeResult",
+		Fields: graphql.Fields{
+			"subscription": &graphql.Field{Type: graphql.NewNonNull(subType)},
+			"transaction":  &graphql.Field{Type: graphql.NewNonNull(transactionType), Description: "the charge for the first period"},
+		},
+	})
+
+	idArgs := graphql.FieldConfigArgument{
+		"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+	}
+
+	queries = graphql.Fields{
+		"subscriptionPlans": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(planType))),
+			Args: graphql.FieldConfigArgument{
+				"includeInactive": &graphql.ArgumentConfig{Type: graphql.Boolean, DefaultValue: false},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return r.Subscriptions.ListPlans(p.Context, p.Args["includeInactive"] == true)
+			},
+		},
+		"subscription": &graphql.Field{
+			Type: subType,
+			Args: idArgs,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				owner, err := ownerScope(p)
+				if err != nil {
+					return nil, err
+				}
+				return r.Subscriptions.Get(p.Context, p.Args["id"].(string), owner)
+			},
+		},
+		"subscriptions": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(subType))),
+			Args: graphql.FieldConfigArgument{
+				"userid": &graphql.ArgumentConfig{Type: graphql.String},
+				"status": &graphql.ArgumentConfig{Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				owner, err := ownerScope(p)
+				if err != nil {
+					return nil, err
+				}
+				return r.Subscriptions.List(p.Context, owner, optString(p.Args, "userid"), optString(p.Args, "status"))
+			},
+		},
+	}
+
+	mutations = graphql.Fields{
+		"createSubscriptionPlan": &graphql.Field{
+			Type: planType,
+			Args: graphql.FieldConfigArgument{
+				"name":         &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"platformName": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"dataid":       &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"coinid":       &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"amount":       &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Float)},
+				"period":       &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"active":       &graphql.ArgumentConfig{Type: graphql.Boolean, DefaultValue: true},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				return r.Subscriptions.CreatePlan(p.Context, models.SubscriptionPlan{
+					Name:         p.Args["name"].(string),
+					PlatformName: p.Args["platformName"].(string),
+					DataID:       p.Args["dataid"].(string),
+					CoinID:       p.Args["coinid"].(string),
+					Amount:       p.Args["amount"].(float64),
+					Period:       p.Args["period"].(string),
+					Active:       p.Args["active"] == true,
+				})
+			},
+		},
+		"setSubscriptionPlanActive": &graphql.Field{
+			Type: planType,
+			Args: graphql.FieldConfigArgument{
+				"id":     &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"active": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Boolean)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				return r.Subscriptions.SetPlanActive(p.Context, p.Args["id"].(string), p.Args["active"].(bool))
+			},
+		},
+		"subscribe": &graphql.Field{
+			Type:        subscribeResult,
+			Description: "charges the first period and starts the subscription",
+			Args: graphql.FieldConfigArgument{
+				"planId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"userid": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				sub, charge, err := r.Subscriptions.Subscribe(p.Context, p.Args["planId"].(string), p.Args["userid"].(string))
+				if err != nil {
+					return nil, err
+				}
+				return map[string]any{"subscription": sub, "transaction": charge}, nil
+			},
+		},
+		"cancelSubscription": &graphql.Field{
+			Type:        subType,
+			Description: "stops future charges; access already paid for is kept",
+			Args:        idArgs,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				owner, err := ownerScope(p)
+				if err != nil {
+					return nil, err
+				}
+				return r.Subscriptions.Cancel(p.Context, p.Args["id"].(string), owner)
+			},
+		},
+		"pauseSubscription": &graphql.Field{
+			Type: subType,
+			Args: idArgs,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				owner, err := ownerScope(p)
+				if err != nil {
+					return nil, err
+				}
+				return r.Subscriptions.Pause(p.Context, p.Args["id"].(string), owner)
+			},
+		},
+		"resumeSubscription": &graphql.Field{
+			Type: subType,
+			Args: idArgs,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				owner, err := ownerScope(p)
+				if err != nil {
+					return nil, err
+				}
+				return r.Subscriptions.Resume(p.Context, p.Args["id"].(string), owner)
+			},
+		},
+	}
+	return queries, mutations
+}
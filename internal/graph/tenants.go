@@ -0,0 +1,117 @@
+package graph
+
+import (
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/graphql-go/graphql"
+)
+
+// tenantFields returns the admin-only tenant management API.
+func (r *Resolver) tenantFields() (queries, mutations graphql.Fields) {
+	tenantType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Tenant",
+		Fields: graphql.Fields{
+			"id":                &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"name":              &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"ipRatePerMinute":   &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "0 inherits the global limit"},
+			"userRatePerMinute": &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "0 inherits the global limit"},
+			"retentionDays":     &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "0 keeps history forever"},
+			"createdAt":         timeField(graphql.NewNonNull(graphql.String)),
+		},
+	})
+
+	credentialsType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TenantCredentials",
+		Fields: graphql.Fields{
+			"tenant": &graphql.Field{Type: graphql.NewNonNull(tenantType)},
+			"apiKey": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "shown once; send as X-API-Key"},
+		},
+	})
+
+	tenantInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "TenantInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"id":                &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"name":              &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"ipRatePerMinute":   &graphql.InputObjectFieldConfig{Type: graphql.Int},
+			"userRatePerMinute": &graphql.InputObjectFieldConfig{Type: graphql.Int},
+			"retentionDays":     &graphql.InputObjectFieldConfig{Type: graphql.Int},
+		},
+	})
+
+	tenantFromInput := func(in map[string]any) models.Tenant {
+		t := models.Tenant{ID: in["id"].(string), Name: in["name"].(string)}
+		t.IPRatePerMinute, _ = in["ipRatePerMinute"].(int)
+		t.UserRatePerMinute, _ = in["userRatePerMinute"].(int)
+		t.RetentionDays, _ = in["retentionDays"].(int)
+		return t
+	}
+
+	credentials := func(t *models.Tenant, key string) map[string]any {
+		return map[string]any{"tenant": t, "apiKey": key}
+	}
+
+	queries = graphql.Fields{
+		"tenants": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(tenantType))),
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				return r.Tenants.List(p.Context)
+			},
+		},
+	}
+
+	mutations = graphql.Fields{
+		"createTenant": &graphql.Field{
+			Type: credentialsType,
+			Args: graphql.FieldConfigArgument{
+				"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(tenantInput)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				t, key, err := r.Tenants.Create(p.Context, tenantFromInput(p.Args["input"].(map[string]any)))
+				if err != nil {
+					return nil, err
+				}
+				return credentials(t, key), nil
+			},
+		},
+		"updateTenant": &graphql.Field{
+			Type: tenantType,
+			Args: graphql.FieldConfigArgument{
+				"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(tenantInput)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				return r.Tenants.Update(p.Context, tenantFromInput(p.Args["input"].(map[string]any)))
+			},
+		},
+		"rotateTenantKey": &graphql.Field{
+			Type: credentialsType,
+			Args: graphql.FieldConfigArgument{
+				"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				if err := requireAdmin(p); err != nil {
+					return nil, err
+				}
+				id := p.Args["id"].(string)
+				key, err := r.Tenants.RotateKey(p.Context, id)
+				if err != nil {
+					return nil, err
+				}
+				t, err := r.Tenants.Get(p.Context, id)
+				if err != nil {
+					return nil, err
+				}
+				return credentials(t, key), nil
+			},
+		},
+	}
+	return queries, mutations
+}
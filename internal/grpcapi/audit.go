@@ -0,0 +1,106 @@
+package grpcapi
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"strings"
+
+	"github.com/devifyX/go-back-transaction-service/internal/audit"
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	transactionsv1 "github.com/devifyX/go-back-transaction-service/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// isRead classifies methods by naming convention: Get*/List* are reads.
+func isRead(method string) bool {
+	return strings.HasPrefix(method, "Get") || strings.HasPrefix(method, "List")
+}
+
+// AuditInterceptor records every mutating call (and reads if enabled on
+// rec) to the audit log after it completes.
+func AuditInterceptor(rec *audit.Recorder) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		method := path.Base(info.FullMethod)
+		if !rec.Enabled(isRead(method)) {
+			return handler(ctx, req)
+		}
+		resp, err := handler(ctx, req)
+
+		var args any
+		if m, ok := req.(proto.Message); ok {
+			if raw, mErr := protojson.Marshal(m); mErr == nil {
+				args = json.RawMessage(raw)
+			}
+		}
+		var result any = resp
+		if list, ok := resp.(interface {
+			GetTransactions() []*transactionsv1.Transaction
+		}); ok {
+			ids := []string{}
+			for _, t := range list.GetTransactions() {
+				ids = append(ids, t.GetId())
+			}
+			result = ids
+		}
+		rec.Record(ctx, audit.TransportGRPC, method, args, result, err)
+		return resp, err
+	}
+}
+
+func (s *Server) ListAuditEvents(ctx context.Context, req *transactionsv1.ListAuditEventsRequest) (*transactionsv1.ListAuditEventsResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if s.Audit == nil {
+		return nil, status.Error(codes.Unavailable, "audit log is not configured")
+	}
+	f := audit.Filter{
+		TenantID:  middleware.TenantIDFromContext(ctx),
+		Operation: req.GetOperation(),
+		ResultID:  req.GetResultId(),
+		Limit:     int(req.GetLimit()),
+		Offset:    int(req.GetOffset()),
+	}
+	if v := req.GetActor(); v != "" {
+		f.Actor = s.Audit.Actor(ctx, v)
+	}
+	if req.GetFrom() != nil {
+		t := req.GetFrom().AsTime()
+		f.From = &t
+	}
+	if req.GetTo() != nil {
+		t := req.GetTo().AsTime()
+		f.To = &t
+	}
+	events, err := s.Audit.Log.List(ctx, f)
+	if err != nil {
+		return nil, toStatus(err, "list audit events")
+	}
+	resp := &transactionsv1.ListAuditEventsResponse{}
+	for _, e := range events {
+		resp.Events = append(resp.Events, &transactionsv1.AuditEvent{
+			Seq:        e.Seq,
+			TenantId:   e.TenantID,
+			OccurredAt: timestamppb.New(e.OccurredAt),
+			Actor:      e.Actor,
+			Admin:      e.Admin,
+			Transport:  e.Transport,
+			Operation:  e.Operation,
+			Args:       e.Args,
+			ResultIds:  e.ResultIDs,
+			Outcome:    e.Outcome,
+			ClientIp:   e.ClientIP,
+			RequestId:  e.RequestID,
+			PrevHash:   e.PrevHash,
+			Hash:       e.Hash,
+		})
+	}
+	return resp, nil
+}
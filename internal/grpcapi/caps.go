@@ -0,0 +1,123 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	transactionsv1 "github.com/devifyX/go-back-transaction-service/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var capPeriods = map[transactionsv1.CapPeriod]string{
+	transactionsv1.CapPeriod_CAP_PERIOD_DAILY:   models.CapPeriodDaily,
+	transactionsv1.CapPeriod_CAP_PERIOD_MONTHLY: models.CapPeriodMonthly,
+}
+
+func capPeriodFromProto(p transactionsv1.CapPeriod) (string, error) {
+	if s, ok := capPeriods[p]; ok {
+		return s, nil
+	}
+	return "", status.Errorf(codes.InvalidArgument, "period is required")
+}
+
+func capPeriodToProto(s string) transactionsv1.CapPeriod {
+	for p, name := range capPeriods {
+		if name == s {
+			return p
+		}
+	}
+	return transactionsv1.CapPeriod_CAP_PERIOD_UNSPECIFIED
+}
+
+func capToProto(c models.SpendingCap) *transactionsv1.SpendingCap {
+	return &transactionsv1.SpendingCap{
+		Id:           c.ID,
+		Userid:       c.UserID,
+		Coinid:       c.CoinID,
+		PlatformName: c.PlatformName,
+		Period:       capPeriodToProto(c.Period),
+		Amount:       c.Amount,
+	}
+}
+
+func (s *Server) SetSpendingCap(ctx context.Context, req *transactionsv1.SetSpendingCapRequest) (*transactionsv1.SpendingCap, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	c := req.GetCap()
+	if strings.TrimSpace(c.GetUserid()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cap.userid is required")
+	}
+	if c.GetAmount() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "cap.amount must be non-negative")
+	}
+	period, err := capPeriodFromProto(c.GetPeriod())
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.Caps.Set(ctx, models.SpendingCap{
+		UserID:       c.GetUserid(),
+		CoinID:       c.GetCoinid(),
+		PlatformName: c.GetPlatformName(),
+		Period:       period,
+		Amount:       c.GetAmount(),
+	})
+	if err != nil {
+		return nil, toStatus(err, "set spending cap")
+	}
+	return capToProto(*out), nil
+}
+
+func (s *Server) ListSpendingCaps(ctx context.Context, req *transactionsv1.ListSpendingCapsRequest) (*transactionsv1.ListSpendingCapsResponse, error) {
+	if strings.TrimSpace(req.GetUserid()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "userid is required")
+	}
+	caps, err := s.Caps.List(ctx, req.GetUserid())
+	if err != nil {
+		return nil, toStatus(err, "list spending caps")
+	}
+	resp := &transactionsv1.ListSpendingCapsResponse{}
+	for _, c := range caps {
+		resp.Caps = append(resp.Caps, capToProto(c))
+	}
+	return resp, nil
+}
+
+func (s *Server) ClearSpendingCap(ctx context.Context, req *transactionsv1.ClearSpendingCapRequest) (*transactionsv1.ClearSpendingCapResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	period, err := capPeriodFromProto(req.GetPeriod())
+	if err != nil {
+		return nil, err
+	}
+	cleared, err := s.Caps.Clear(ctx, req.GetUserid(), req.GetCoinid(), req.GetPlatformName(), period)
+	if err != nil {
+		return nil, toStatus(err, "clear spending cap")
+	}
+	return &transactionsv1.ClearSpendingCapResponse{Cleared: cleared}, nil
+}
+
+func (s *Server) GetRemainingBudget(ctx context.Context, req *transactionsv1.GetRemainingBudgetRequest) (*transactionsv1.GetRemainingBudgetResponse, error) {
+	if strings.TrimSpace(req.GetUserid()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "userid is required")
+	}
+	budgets, err := s.Caps.Remaining(ctx, req.GetUserid(), req.GetCoinid(), req.GetPlatformName())
+	if err != nil {
+		return nil, toStatus(err, "get remaining budget")
+	}
+	resp := &transactionsv1.GetRemainingBudgetResponse{}
+	for _, b := range budgets {
+		resp.Budgets = append(resp.Budgets, &transactionsv1.Budget{
+			Cap:         capToProto(b.Cap),
+			Spent:       b.Spent,
+			Remaining:   b.Remaining,
+			WindowStart: timestamppb.New(b.WindowStart.UTC()),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,59 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	transactionsv1 "github.com/devifyX/go-back-transaction-service/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func coinToProto(c models.Coin) *transactionsv1.Coin {
+	return &transactionsv1.Coin{
+		Id:        c.ID,
+		Name:      c.Name,
+		Precision: int32(c.Precision),
+		Active:    c.Active,
+		MinSpend:  c.MinSpend,
+		MaxSpend:  c.MaxSpend,
+		CreatedAt: timestamppb.New(c.CreatedAt.UTC()),
+		UpdatedAt: timestamppb.New(c.UpdatedAt.UTC()),
+	}
+}
+
+func (s *Server) SetCoin(ctx context.Context, req *transactionsv1.Coin) (*transactionsv1.Coin, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.GetId()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	c, err := s.Coins.Set(ctx, models.Coin{
+		ID:        req.GetId(),
+		Name:      req.GetName(),
+		Precision: int(req.GetPrecision()),
+		Active:    req.GetActive(),
+		MinSpend:  req.GetMinSpend(),
+		MaxSpend:  req.GetMaxSpend(),
+	})
+	if err != nil {
+		return nil, toStatus(err, "set coin")
+	}
+	return coinToProto(*c), nil
+}
+
+func (s *Server) ListCoins(ctx context.Context, req *transactionsv1.ListCoinsRequest) (*transactionsv1.ListCoinsResponse, error) {
+	coins, err := s.Coins.List(ctx, req.GetIncludeInactive())
+	if err != nil {
+		return nil, toStatus(err, "list coins")
+	}
+	resp := &transactionsv1.ListCoinsResponse{}
+	for _, c := range coins {
+		resp.Coins = append(resp.Coins, coinToProto(c))
+	}
+	return resp, nil
+}
@@ -0,0 +1,50 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	transactionsv1 "github.com/devifyX/go-back-transaction-service/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) EraseUser(ctx context.Context, req *transactionsv1.EraseUserRequest) (*transactionsv1.ErasureReceipt, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.GetUserid()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "userid is required")
+	}
+	rc, err := s.Repo.EraseUser(ctx, req.GetUserid(), middleware.UserIDFromContext(ctx))
+	if err != nil {
+		return nil, toStatus(err, "erase user")
+	}
+	return &transactionsv1.ErasureReceipt{
+		Id:           rc.ID,
+		TenantId:     rc.TenantID,
+		Pseudonym:    rc.Pseudonym,
+		SubjectHash:  rc.SubjectHash,
+		Transactions: rc.Transactions,
+		SpendingCaps: rc.SpendingCaps,
+		RequestedBy:  rc.RequestedBy,
+		ErasedAt:     timestamppb.New(rc.ErasedAt.UTC()),
+	}, nil
+}
+
+func (s *Server) DeleteTransaction(ctx context.Context, req *transactionsv1.DeleteTransactionRequest) (*transactionsv1.Transaction, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.GetId()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	t, err := s.Repo.SoftDelete(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err, "delete transaction")
+	}
+	return transactionToProto(*t), nil
+}
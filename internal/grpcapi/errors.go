@@ -0,0 +1,83 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/devifyX/go-back-transaction-service/internal/reconcile"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatus maps repository errors onto gRPC codes; anything unrecognised
+// becomes Internal with op as context.
+func toStatus(err error, op string) error {
+	var capErr *db.CapExceededError
+	var coinErr *db.InvalidCoinError
+	var platformErr *db.InvalidPlatformError
+	var transitionErr *db.IllegalTransitionError
+	var ruleErr *db.RuleError
+	var fraudErr *db.FraudRejectedError
+	var dupErr *db.DuplicateTransactionError
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.As(err, &capErr):
+		return status.Error(codes.FailedPrecondition, capErr.Error())
+	case errors.As(err, &coinErr):
+		return status.Error(codes.InvalidArgument, coinErr.Error())
+	case errors.As(err, &platformErr):
+		return status.Error(codes.InvalidArgument, platformErr.Error())
+	case errors.As(err, &transitionErr):
+		return status.Error(codes.FailedPrecondition, transitionErr.Error())
+	case errors.As(err, &ruleErr):
+		return status.Error(codes.FailedPrecondition, ruleErr.Error())
+	case errors.As(err, &fraudErr):
+		return status.Error(codes.PermissionDenied, fraudErr.Error())
+	case errors.As(err, &dupErr):
+		return status.Error(codes.AlreadyExists, dupErr.Error())
+	case errors.Is(err, db.ErrVersionConflict):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, db.ErrReviewClosed), errors.Is(err, db.ErrArchiveNotErasable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, db.ErrInvalidRate), errors.Is(err, reconcile.ErrInvalidRequest), errors.Is(err, db.ErrInvalidSubscription),
+		errors.Is(err, db.ErrInvalidSchedule), errors.Is(err, db.ErrFutureTimestamp), errors.Is(err, db.ErrInvalidUpdate),
+		errors.Is(err, db.ErrInvalidMetadata), errors.Is(err, db.ErrInvalidFilter),
+		errors.Is(err, db.ErrInvalidAmount):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.As(err, &pgErr) && pgErr.Code == "23505": // unique_violation
+		return status.Errorf(codes.AlreadyExists, "%s: already exists", op)
+	case errors.Is(err, db.ErrNoEntitlement):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, pgx.ErrNoRows):
+		return status.Errorf(codes.NotFound, "%s: not found", op)
+	default:
+		return status.Errorf(codes.Internal, "%s failed: %v", op, err)
+	}
+}
+
+// requireAdmin rejects callers that did not present the admin token.
+func requireAdmin(ctx context.Context) error {
+	if !middleware.IsAdmin(ctx) {
+		return status.Error(codes.PermissionDenied, "admin token required")
+	}
+	return nil
+}
+
+// ownerScope limits non-admin callers to the user-owned records (scheduled
+// spends, subscriptions) of the user in X-User-ID; admins see every record
+// of the tenant.
+func ownerScope(ctx context.Context) (string, error) {
+	if middleware.IsAdmin(ctx) {
+		return "", nil
+	}
+	uid := middleware.UserIDFromContext(ctx)
+	if uid == "" {
+		return "", status.Error(codes.PermissionDenied, "user id or admin token required")
+	}
+	return uid, nil
+}
@@ -0,0 +1,68 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	transactionsv1 "github.com/devifyX/go-back-transaction-service/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func fraudReviewToProto(r *models.FraudReview) *transactionsv1.FraudReview {
+	out := &transactionsv1.FraudReview{
+		Id:            r.ID,
+		TransactionId: r.TransactionID,
+		Userid:        r.UserID,
+		PlatformName:  r.PlatformName,
+		Coinused:      r.CoinUsed,
+		Action:        r.Action,
+		Status:        r.Status,
+		ReviewedBy:    r.ReviewedBy,
+		Note:          r.Note,
+		CreatedAt:     timestamppb.New(r.CreatedAt.UTC()),
+		ReviewedAt:    optTimestamp(r.ReviewedAt),
+	}
+	for _, f := range r.Findings {
+		out.Findings = append(out.Findings, &transactionsv1.FraudFinding{Rule: f.Rule, Action: f.Action, Reason: f.Reason})
+	}
+	return out
+}
+
+func (s *Server) ListFraudReviews(ctx context.Context, req *transactionsv1.ListFraudReviewsRequest) (*transactionsv1.ListFraudReviewsResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	reviews, err := s.FraudReviews.List(ctx, req.GetStatus(), int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, toStatus(err, "list fraud reviews")
+	}
+	out := &transactionsv1.ListFraudReviewsResponse{Reviews: make([]*transactionsv1.FraudReview, 0, len(reviews))}
+	for i := range reviews {
+		out.Reviews = append(out.Reviews, fraudReviewToProto(&reviews[i]))
+	}
+	return out, nil
+}
+
+func (s *Server) ApproveFraudReview(ctx context.Context, req *transactionsv1.DecideFraudReviewRequest) (*transactionsv1.FraudReview, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	r, err := s.FraudReviews.Approve(ctx, req.GetId(), req.GetNote(), middleware.UserIDFromContext(ctx))
+	if err != nil {
+		return nil, toStatus(err, "approve fraud review")
+	}
+	return fraudReviewToProto(r), nil
+}
+
+func (s *Server) DenyFraudReview(ctx context.Context, req *transactionsv1.DecideFraudReviewRequest) (*transactionsv1.FraudReview, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	r, err := s.FraudReviews.Deny(ctx, req.GetId(), req.GetNote(), middleware.UserIDFromContext(ctx))
+	if err != nil {
+		return nil, toStatus(err, "deny fraud review")
+	}
+	return fraudReviewToProto(r), nil
+}
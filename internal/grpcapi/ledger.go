@@ -0,0 +1,71 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	transactionsv1 "github.com/devifyX/go-back-transaction-service/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) GetAccountBalance(ctx context.Context, req *transactionsv1.GetAccountBalanceRequest) (*transactionsv1.GetAccountBalanceResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.GetAccount()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "account is required")
+	}
+	balances, err := s.Ledger.Balances(ctx, req.GetAccount())
+	if err != nil {
+		return nil, toStatus(err, "account balance")
+	}
+	resp := &transactionsv1.GetAccountBalanceResponse{}
+	for _, b := range balances {
+		resp.Balances = append(resp.Balances, &transactionsv1.AccountBalance{Account: b.Account, Coinid: b.CoinID, Balance: b.Balance})
+	}
+	return resp, nil
+}
+
+func (s *Server) GetAccountStatement(ctx context.Context, req *transactionsv1.GetAccountStatementRequest) (*transactionsv1.AccountStatement, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.GetAccount()) == "" || strings.TrimSpace(req.GetCoinid()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "account and coinid are required")
+	}
+	var from, to *time.Time
+	if req.GetFrom() != nil {
+		t := req.GetFrom().AsTime()
+		from = &t
+	}
+	if req.GetTo() != nil {
+		t := req.GetTo().AsTime()
+		to = &t
+	}
+	st, err := s.Ledger.Statement(ctx, req.GetAccount(), req.GetCoinid(), from, to, int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, toStatus(err, "account statement")
+	}
+	resp := &transactionsv1.AccountStatement{
+		Account: st.Account,
+		Coinid:  st.CoinID,
+		Opening: st.Opening,
+		Closing: st.Closing,
+	}
+	for _, p := range st.Postings {
+		resp.Postings = append(resp.Postings, &transactionsv1.Posting{
+			Id:            p.ID,
+			EntryId:       p.EntryID,
+			TransactionId: p.TransactionID,
+			Account:       p.Account,
+			Coinid:        p.CoinID,
+			Amount:        p.Amount,
+			PostedAt:      timestamppb.New(p.PostedAt.UTC()),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,68 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	transactionsv1 "github.com/devifyX/go-back-transaction-service/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) AuthorizeTransaction(ctx context.Context, req *transactionsv1.CreateTransactionRequest) (*transactionsv1.Transaction, error) {
+	model, err := transactionFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.Repo.Authorize(ctx, model)
+	if err != nil {
+		return nil, toStatus(err, "authorize")
+	}
+	return transactionToProto(*out), nil
+}
+
+func (s *Server) CaptureTransaction(ctx context.Context, req *transactionsv1.CaptureTransactionRequest) (*transactionsv1.Transaction, error) {
+	if strings.TrimSpace(req.GetId()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	out, err := s.Repo.Capture(ctx, req.GetId(), middleware.UserIDFromContext(ctx))
+	if err != nil {
+		return nil, toStatus(err, "capture")
+	}
+	return transactionToProto(*out), nil
+}
+
+func (s *Server) VoidTransaction(ctx context.Context, req *transactionsv1.VoidTransactionRequest) (*transactionsv1.Transaction, error) {
+	if strings.TrimSpace(req.GetId()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	out, err := s.Repo.Void(ctx, req.GetId(), req.GetReason(), middleware.UserIDFromContext(ctx))
+	if err != nil {
+		return nil, toStatus(err, "void")
+	}
+	return transactionToProto(*out), nil
+}
+
+func (s *Server) ListStatusChanges(ctx context.Context, req *transactionsv1.ListStatusChangesRequest) (*transactionsv1.ListStatusChangesResponse, error) {
+	if strings.TrimSpace(req.GetTransactionId()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction_id is required")
+	}
+	changes, err := s.Repo.History(ctx, req.GetTransactionId())
+	if err != nil {
+		return nil, toStatus(err, "list status changes")
+	}
+	resp := &transactionsv1.ListStatusChangesResponse{}
+	for _, c := range changes {
+		resp.Changes = append(resp.Changes, &transactionsv1.StatusChange{
+			From:      c.From,
+			To:        c.To,
+			Reason:    c.Reason,
+			Actor:     c.Actor,
+			ChangedAt: timestamppb.New(c.ChangedAt.UTC()),
+		})
+	}
+	return resp, nil
+}
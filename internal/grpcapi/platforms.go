@@ -0,0 +1,129 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	transactionsv1 "github.com/devifyX/go-back-transaction-service/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func platformToProto(p models.Platform) *transactionsv1.Platform {
+	return &transactionsv1.Platform{
+		Name:                 p.Name,
+		DisplayName:          p.DisplayName,
+		OwnerContact:         p.OwnerContact,
+		AllowedCoins:         p.AllowedCoins,
+		DefaultAccessSeconds: int64(p.DefaultAccess / time.Second),
+		WebhookUrl:           p.WebhookURL,
+		WebhookSecret:        p.WebhookSecret,
+		Enabled:              p.Enabled,
+		DedupWindowSeconds:   int64(p.DedupWindow / time.Second),
+		DedupAction:          p.DedupAction,
+		CreatedAt:            timestamppb.New(p.CreatedAt.UTC()),
+		UpdatedAt:            timestamppb.New(p.UpdatedAt.UTC()),
+	}
+}
+
+func platformFromProto(p *transactionsv1.Platform) (models.Platform, error) {
+	if strings.TrimSpace(p.GetName()) == "" {
+		return models.Platform{}, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	return models.Platform{
+		Name:          p.GetName(),
+		DisplayName:   p.GetDisplayName(),
+		OwnerContact:  p.GetOwnerContact(),
+		AllowedCoins:  p.GetAllowedCoins(),
+		DefaultAccess: time.Duration(p.GetDefaultAccessSeconds()) * time.Second,
+		WebhookURL:    p.GetWebhookUrl(),
+		Enabled:       p.GetEnabled(),
+		DedupWindow:   time.Duration(p.GetDedupWindowSeconds()) * time.Second,
+		DedupAction:   p.GetDedupAction(),
+	}, nil
+}
+
+func (s *Server) CreatePlatform(ctx context.Context, req *transactionsv1.Platform) (*transactionsv1.Platform, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	in, err := platformFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.Platforms.Create(ctx, in)
+	if err != nil {
+		return nil, toStatus(err, "create platform")
+	}
+	return platformToProto(*p), nil
+}
+
+func (s *Server) UpdatePlatform(ctx context.Context, req *transactionsv1.Platform) (*transactionsv1.Platform, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	in, err := platformFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.Platforms.Update(ctx, in)
+	if err != nil {
+		return nil, toStatus(err, "update platform")
+	}
+	return platformToProto(*p), nil
+}
+
+func (s *Server) GetPlatform(ctx context.Context, req *transactionsv1.GetPlatformRequest) (*transactionsv1.Platform, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	p, err := s.Platforms.Get(ctx, req.GetName())
+	if err != nil {
+		return nil, toStatus(err, "get platform")
+	}
+	if p == nil {
+		return nil, status.Errorf(codes.NotFound, "platform %q not found", req.GetName())
+	}
+	return platformToProto(*p), nil
+}
+
+func (s *Server) ListPlatforms(ctx context.Context, req *transactionsv1.ListPlatformsRequest) (*transactionsv1.ListPlatformsResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	platforms, err := s.Platforms.List(ctx, req.GetIncludeDisabled())
+	if err != nil {
+		return nil, toStatus(err, "list platforms")
+	}
+	resp := &transactionsv1.ListPlatformsResponse{}
+	for _, p := range platforms {
+		resp.Platforms = append(resp.Platforms, platformToProto(p))
+	}
+	return resp, nil
+}
+
+func (s *Server) DeletePlatform(ctx context.Context, req *transactionsv1.DeletePlatformRequest) (*transactionsv1.DeletePlatformResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	deleted, err := s.Platforms.Delete(ctx, req.GetName())
+	if err != nil {
+		return nil, toStatus(err, "delete platform")
+	}
+	return &transactionsv1.DeletePlatformResponse{Deleted: deleted}, nil
+}
+
+func (s *Server) RotateWebhookSecret(ctx context.Context, req *transactionsv1.RotateWebhookSecretRequest) (*transactionsv1.Platform, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	p, err := s.Platforms.RotateWebhookSecret(ctx, req.GetName())
+	if err != nil {
+		return nil, toStatus(err, "rotate webhook secret")
+	}
+	return platformToProto(*p), nil
+}
@@ -0,0 +1,38 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"path"
+
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/devifyX/go-back-transaction-service/internal/quota"
+
+	"google.golang.org/grpc"
+)
+
+// QuotaInterceptor enforces per-method/per-platform quota rules. The
+// operation is the bare method name (e.g. "CreateTransaction").
+func QuotaInterceptor(e *quota.Enforcer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		q := quota.Request{
+			Tenant:    middleware.TenantIDFromContext(ctx),
+			Operation: path.Base(info.FullMethod),
+			Caller:    middleware.UserIDFromContext(ctx),
+			IP:        middleware.ClientIPFromContext(ctx),
+			Admin:     middleware.IsAdmin(ctx),
+			APIKey:    middleware.HasAPIKey(ctx),
+		}
+		if p, ok := req.(interface{ GetPlatformName() string }); ok {
+			q.Platform = p.GetPlatformName()
+		}
+		if err := e.Check(ctx, q); err != nil {
+			var ex *quota.ExceededError
+			if errors.As(err, &ex) {
+				return nil, exhausted(ctx, ex.Decision, err.Error())
+			}
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,132 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/devifyX/go-back-transaction-service/internal/ratelimit"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// RateLimiter applies the same per-IP and per-user (x-user-id metadata)
+// limits as the HTTP middleware, against a shared ratelimit.Backend.
+type RateLimiter struct {
+	backend ratelimit.Backend
+	ip      ratelimit.Limit
+	user    ratelimit.Limit
+}
+
+func NewRateLimiter(backend ratelimit.Backend, ipPerMin, userPerMin int) *RateLimiter {
+	return &RateLimiter{
+		backend: backend,
+		ip:      ratelimit.Limit{PerMinute: ipPerMin, Burst: 20},
+		user:    ratelimit.Limit{PerMinute: userPerMin, Burst: 20},
+	}
+}
+
+func (l *RateLimiter) allow(ctx context.Context, key string, lim ratelimit.Limit) ratelimit.Decision {
+	d, err := l.backend.Allow(ctx, key, lim)
+	if err != nil {
+		log.Printf("rate limit backend error (key=%s): %v", key, err)
+		return ratelimit.Decision{Allowed: true, Limit: lim.Burst, Remaining: lim.Burst}
+	}
+	return d
+}
+
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
+
+// setRateLimitTrailer mirrors the HTTP RateLimit-* headers as trailers.
+func setRateLimitTrailer(ctx context.Context, d ratelimit.Decision) {
+	md := metadata.Pairs(
+		"ratelimit-limit", strconv.Itoa(d.Limit),
+		"ratelimit-remaining", strconv.Itoa(d.Remaining),
+		"ratelimit-reset", ceilSeconds(d.Reset),
+	)
+	if !d.Allowed {
+		md.Set("retry-after", ceilSeconds(d.RetryAfter))
+	}
+	_ = grpc.SetTrailer(ctx, md)
+}
+
+// exhausted returns a ResourceExhausted status carrying a RetryInfo detail.
+func exhausted(ctx context.Context, d ratelimit.Decision, msg string) error {
+	setRateLimitTrailer(ctx, d)
+	st := status.New(codes.ResourceExhausted, msg)
+	if withInfo, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(d.RetryAfter)}); err == nil {
+		st = withInfo
+	}
+	return st.Err()
+}
+
+// Identify is a grpc.UnaryServerInterceptor that records the client
+// address (resolved through trusted proxies), x-user-id, admin status
+// (x-admin-token), tenant (x-api-key) and request id (x-request-id,
+// generated if absent) in the context; it must run before the limiters.
+func Identify(id *middleware.Identifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		first := func(key string) string {
+			if vals := md.Get(key); len(vals) > 0 {
+				return vals[0]
+			}
+			return ""
+		}
+		out, err := id.Identify(ctx, middleware.Credentials{
+			IP:         id.IPs.FromGRPC(ctx),
+			UserID:     first("x-user-id"),
+			AdminToken: first("x-admin-token"),
+			APIKey:     first("x-api-key"),
+			RequestID:  first("x-request-id"),
+		})
+		var limited *middleware.RateLimitedError
+		switch {
+		case errors.As(err, &limited):
+			return nil, exhausted(ctx, limited.Decision, err.Error())
+		case errors.Is(err, middleware.ErrUnauthenticated):
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case err != nil:
+			return nil, status.Errorf(codes.Unavailable, "identity lookup failed: %v", err)
+		}
+		_ = grpc.SetHeader(ctx, metadata.Pairs("x-request-id", middleware.RequestIDFromContext(out)))
+		return handler(out, req)
+	}
+}
+
+// Unary is a grpc.UnaryServerInterceptor enforcing the limits. Rate limit
+// state is reported in trailers on every call.
+func (l *RateLimiter) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ipLim, userLim := l.ip, l.user
+	if t := db.TenantFromContext(ctx); t != nil {
+		ipLim, userLim = ipLim.Override(t.IPRatePerMinute), userLim.Override(t.UserRatePerMinute)
+	}
+	tenant := middleware.TenantIDFromContext(ctx)
+	d := l.allow(ctx, ratelimit.IPKey(tenant, middleware.ClientIPFromContext(ctx)), ipLim)
+	if !d.Allowed {
+		return nil, exhausted(ctx, d, "rate limit exceeded (ip)")
+	}
+	if uid := middleware.UserIDFromContext(ctx); uid != "" {
+		ud := l.allow(ctx, ratelimit.UserKey(tenant, uid), userLim)
+		if !ud.Allowed {
+			return nil, exhausted(ctx, ud, "rate limit exceeded (user)")
+		}
+		if ud.Remaining < d.Remaining {
+			d = ud
+		}
+	}
+	setRateLimitTrailer(ctx, d)
+	return handler(ctx, req)
+}
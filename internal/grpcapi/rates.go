@@ -0,0 +1,93 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	transactionsv1 "github.com/devifyX/go-back-transaction-service/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) ImportExchangeRates(ctx context.Context, req *transactionsv1.ImportExchangeRatesRequest) (*transactionsv1.ImportExchangeRatesResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	rates := make([]models.ExchangeRate, 0, len(req.GetRates()))
+	for _, r := range req.GetRates() {
+		rt := models.ExchangeRate{BaseCoin: r.GetBaseCoin(), QuoteCoin: r.GetQuoteCoin(), Rate: r.GetRate()}
+		if r.GetEffectiveAt() != nil {
+			rt.EffectiveAt = r.GetEffectiveAt().AsTime().UTC()
+		}
+		rates = append(rates, rt)
+	}
+	n, err := s.Rates.Import(ctx, rates)
+	if err != nil {
+		return nil, toStatus(err, "import exchange rates")
+	}
+	return &transactionsv1.ImportExchangeRatesResponse{Imported: int32(n)}, nil
+}
+
+func (s *Server) ListExchangeRates(ctx context.Context, req *transactionsv1.ListExchangeRatesRequest) (*transactionsv1.ListExchangeRatesResponse, error) {
+	rates, err := s.Rates.List(ctx, req.GetBaseCoin(), req.GetQuoteCoin())
+	if err != nil {
+		return nil, toStatus(err, "list exchange rates")
+	}
+	resp := &transactionsv1.ListExchangeRatesResponse{}
+	for _, r := range rates {
+		resp.Rates = append(resp.Rates, &transactionsv1.ExchangeRate{
+			BaseCoin:    r.BaseCoin,
+			QuoteCoin:   r.QuoteCoin,
+			Rate:        r.Rate,
+			EffectiveAt: timestamppb.New(r.EffectiveAt.UTC()),
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) GetTransactionTotals(ctx context.Context, req *transactionsv1.GetTransactionTotalsRequest) (*transactionsv1.TransactionTotals, error) {
+	if strings.TrimSpace(req.GetReferenceCoin()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reference_coin is required")
+	}
+	f := db.TransactionFilter{}
+	if v := req.GetUserid(); v != "" {
+		f.UserID = &v
+	}
+	if v := req.GetCoinid(); v != "" {
+		f.CoinID = &v
+	}
+	if v := req.GetPlatformName(); v != "" {
+		f.PlatformName = &v
+	}
+	if req.GetFrom() != nil {
+		t := req.GetFrom().AsTime()
+		f.FromTimestamp = &t
+	}
+	if req.GetTo() != nil {
+		t := req.GetTo().AsTime()
+		f.ToTimestamp = &t
+	}
+	totals, err := s.Repo.Totals(ctx, f, req.GetReferenceCoin())
+	if err != nil {
+		return nil, toStatus(err, "transaction totals")
+	}
+	resp := &transactionsv1.TransactionTotals{
+		ReferenceCoin: totals.ReferenceCoin,
+		Value:         totals.Value,
+		Complete:      totals.Complete,
+	}
+	for _, c := range totals.ByCoin {
+		resp.ByCoin = append(resp.ByCoin, &transactionsv1.CoinTotal{
+			Coinid:      c.CoinID,
+			Count:       c.Count,
+			Total:       c.Total,
+			Value:       c.Value,
+			Unconverted: c.Unconverted,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,157 @@
+package grpcapi
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/devifyX/go-back-transaction-service/internal/reconcile"
+	transactionsv1 "github.com/devifyX/go-back-transaction-service/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func optTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(t.UTC())
+}
+
+func reconciliationRunToProto(run *models.ReconciliationRun) *transactionsv1.ReconciliationRun {
+	return &transactionsv1.ReconciliationRun{
+		Id:                   run.ID,
+		PlatformName:         run.PlatformName,
+		FileName:             run.FileName,
+		From:                 timestamppb.New(run.From.UTC()),
+		To:                   timestamppb.New(run.To.UTC()),
+		AmountTolerance:      run.AmountTolerance,
+		TimeToleranceSeconds: int64(run.TimeTolerance / time.Second),
+		Matched:              int32(run.Matched),
+		MissingOurs:          int32(run.MissingOurs),
+		MissingTheirs:        int32(run.MissingTheirs),
+		AmountMismatches:     int32(run.AmountMismatches),
+		CreatedBy:            run.CreatedBy,
+		CreatedAt:            timestamppb.New(run.CreatedAt.UTC()),
+	}
+}
+
+func reconciliationItemToProto(it *models.ReconciliationItem) *transactionsv1.ReconciliationItem {
+	out := &transactionsv1.ReconciliationItem{
+		Id:             it.ID,
+		RunId:          it.RunID,
+		Kind:           it.Kind,
+		Status:         it.Status,
+		Dataid:         it.DataID,
+		Userid:         it.UserID,
+		TheirAmount:    it.TheirAmount,
+		OurAmount:      it.OurAmount,
+		TheirTimestamp: optTimestamp(it.TheirTimestamp),
+		OurTimestamp:   optTimestamp(it.OurTimestamp),
+		Resolution:     it.Resolution,
+		ResolvedBy:     it.ResolvedBy,
+		ResolvedAt:     optTimestamp(it.ResolvedAt),
+	}
+	if it.TransactionID != nil {
+		out.TransactionId = *it.TransactionID
+	}
+	return out
+}
+
+func (s *Server) ReconcileSettlement(ctx context.Context, req *transactionsv1.ReconcileSettlementRequest) (*transactionsv1.ReconciliationRun, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.GetPlatformName()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "platform_name is required")
+	}
+	lines, err := reconcile.ParseCSV(bytes.NewReader(req.GetCsv()))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid settlement file: %v", err)
+	}
+	r := reconcile.Request{
+		Platform:  req.GetPlatformName(),
+		FileName:  req.GetFileName(),
+		Lines:     lines,
+		Tolerance: s.Reconcile.Defaults,
+		CreatedBy: middleware.UserIDFromContext(ctx),
+	}
+	if req.AmountTolerance != nil {
+		r.Tolerance.Amount = req.GetAmountTolerance()
+	}
+	if req.TimeToleranceSeconds != nil {
+		r.Tolerance.Time = time.Duration(req.GetTimeToleranceSeconds()) * time.Second
+	}
+	if req.GetFrom() != nil {
+		t := req.GetFrom().AsTime()
+		r.From = &t
+	}
+	if req.GetTo() != nil {
+		t := req.GetTo().AsTime()
+		r.To = &t
+	}
+	run, err := s.Reconcile.Run(ctx, r)
+	if err != nil {
+		return nil, toStatus(err, "reconcile settlement")
+	}
+	return reconciliationRunToProto(run), nil
+}
+
+func (s *Server) ListReconciliationRuns(ctx context.Context, req *transactionsv1.ListReconciliationRunsRequest) (*transactionsv1.ListReconciliationRunsResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	runs, err := s.Reconcile.Repo.Runs(ctx, req.GetPlatformName(), int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, toStatus(err, "list reconciliation runs")
+	}
+	resp := &transactionsv1.ListReconciliationRunsResponse{}
+	for i := range runs {
+		resp.Runs = append(resp.Runs, reconciliationRunToProto(&runs[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) ListReconciliationItems(ctx context.Context, req *transactionsv1.ListReconciliationItemsRequest) (*transactionsv1.ListReconciliationItemsResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	items, err := s.Reconcile.Repo.Items(ctx, db.ItemFilter{
+		RunID:  req.GetRunId(),
+		Kind:   req.GetKind(),
+		Status: req.GetStatus(),
+		Limit:  int(req.GetLimit()),
+		Offset: int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, toStatus(err, "list reconciliation items")
+	}
+	resp := &transactionsv1.ListReconciliationItemsResponse{}
+	for i := range items {
+		resp.Items = append(resp.Items, reconciliationItemToProto(&items[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) ResolveReconciliationItem(ctx context.Context, req *transactionsv1.ResolveReconciliationItemRequest) (*transactionsv1.ReconciliationItem, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.GetNote()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "note is required")
+	}
+	it, err := s.Reconcile.Repo.Resolve(ctx, req.GetId(), req.GetNote(), middleware.UserIDFromContext(ctx))
+	if err != nil {
+		return nil, toStatus(err, "resolve reconciliation item")
+	}
+	if it == nil {
+		return nil, status.Errorf(codes.NotFound, "reconciliation item %d not found", req.GetId())
+	}
+	return reconciliationItemToProto(it), nil
+}
@@ -0,0 +1,47 @@
+package grpcapi
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	transactionsv1 "github.com/devifyX/go-back-transaction-service/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) ExtendAccess(ctx context.Context, req *transactionsv1.ExtendAccessRequest) (*transactionsv1.ExtendAccessResponse, error) {
+	if strings.TrimSpace(req.GetCoinid()) == "" ||
+		strings.TrimSpace(req.GetUserid()) == "" ||
+		strings.TrimSpace(req.GetDataid()) == "" ||
+		strings.TrimSpace(req.GetPlatformName()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "coinid, userid, dataid, and platform_name are required")
+	}
+	if v := req.GetCoinused(); v < 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil, status.Errorf(codes.InvalidArgument, "coinused must be a finite, non-negative number")
+	}
+	if req.GetDurationSeconds() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "duration_seconds must be non-negative")
+	}
+	ren, err := s.Repo.ExtendAccess(ctx, models.RenewalRequest{
+		UserID:       req.GetUserid(),
+		DataID:       req.GetDataid(),
+		PlatformName: req.GetPlatformName(),
+		CoinID:       req.GetCoinid(),
+		CoinUsed:     req.GetCoinused(),
+		Duration:     time.Duration(req.GetDurationSeconds()) * time.Second,
+	})
+	if err != nil {
+		return nil, toStatus(err, "extend access")
+	}
+	return &transactionsv1.ExtendAccessResponse{
+		Transaction:    transactionToProto(ren.Transaction),
+		OriginalId:     ren.OriginalID,
+		PreviousExpiry: timestamppb.New(ren.PreviousExpiry.UTC()),
+		ExpiryDate:     timestamppb.New(ren.ExpiryDate.UTC()),
+	}, nil
+}
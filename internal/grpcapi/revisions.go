@@ -0,0 +1,74 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	transactionsv1 "github.com/devifyX/go-back-transaction-service/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maskFields maps update_mask paths onto the model's field names.
+var maskFields = map[string]string{
+	"expiry_date":   models.FieldExpiryDate,
+	"platform_name": models.FieldPlatformName,
+}
+
+func (s *Server) UpdateTransaction(ctx context.Context, req *transactionsv1.UpdateTransactionRequest) (*transactionsv1.Transaction, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.GetId()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	u := models.TransactionUpdate{
+		ID:           req.GetId(),
+		Version:      req.GetVersion(),
+		PlatformName: req.GetPlatformName(),
+		Reason:       req.GetReason(),
+	}
+	if ts := req.GetExpiryDate(); ts != nil {
+		u.ExpiryDate = ts.AsTime().UTC()
+	}
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		f, ok := maskFields[path]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "field %q cannot be updated", path)
+		}
+		u.Fields = append(u.Fields, f)
+	}
+	out, err := s.Repo.Update(ctx, u, middleware.UserIDFromContext(ctx))
+	if err != nil {
+		return nil, toStatus(err, "update transaction")
+	}
+	return transactionToProto(*out), nil
+}
+
+func (s *Server) ListTransactionRevisions(ctx context.Context, req *transactionsv1.ListTransactionRevisionsRequest) (*transactionsv1.ListTransactionRevisionsResponse, error) {
+	if strings.TrimSpace(req.GetTransactionId()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction_id is required")
+	}
+	revisions, err := s.Repo.Revisions(ctx, req.GetTransactionId())
+	if err != nil {
+		return nil, toStatus(err, "list transaction revisions")
+	}
+	resp := &transactionsv1.ListTransactionRevisionsResponse{}
+	for _, rev := range revisions {
+		out := &transactionsv1.TransactionRevision{
+			Version:   rev.Version,
+			Reason:    rev.Reason,
+			Actor:     rev.Actor,
+			ChangedAt: timestamppb.New(rev.ChangedAt.UTC()),
+		}
+		for _, c := range rev.Changes {
+			out.Changes = append(out.Changes, &transactionsv1.FieldChange{Field: c.Field, From: c.From, To: c.To})
+		}
+		resp.Revisions = append(resp.Revisions, out)
+	}
+	return resp, nil
+}
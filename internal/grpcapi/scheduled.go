@@ -0,0 +1,86 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	transactionsv1 "github.com/devifyX/go-back-transaction-service/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func scheduledToProto(s *models.ScheduledTransaction) *transactionsv1.ScheduledTransaction {
+	out := &transactionsv1.ScheduledTransaction{
+		Id:           s.ID,
+		Coinid:       s.CoinID,
+		Userid:       s.UserID,
+		Dataid:       s.DataID,
+		Coinused:     s.CoinUsed,
+		ExpiryDate:   optTimestamp(s.ExpiryDate),
+		PlatformName: s.PlatformName,
+		ExecuteAt:    timestamppb.New(s.ExecuteAt.UTC()),
+		Status:       s.Status,
+		Error:        s.Error,
+		CreatedBy:    s.CreatedBy,
+		CreatedAt:    timestamppb.New(s.CreatedAt.UTC()),
+		UpdatedAt:    timestamppb.New(s.UpdatedAt.UTC()),
+	}
+	if s.TransactionID != nil {
+		out.TransactionId = *s.TransactionID
+	}
+	return out
+}
+
+func (s *Server) ScheduleTransaction(ctx context.Context, req *transactionsv1.ScheduleTransactionRequest) (*transactionsv1.ScheduledTransaction, error) {
+	if req.GetExecuteAt() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "execute_at is required")
+	}
+	in := models.ScheduledTransaction{
+		CoinID:       req.GetCoinid(),
+		UserID:       req.GetUserid(),
+		DataID:       req.GetDataid(),
+		CoinUsed:     req.GetCoinused(),
+		PlatformName: req.GetPlatformName(),
+		ExecuteAt:    req.GetExecuteAt().AsTime().UTC(),
+	}
+	if exp := req.GetExpiryDate(); exp != nil {
+		t := exp.AsTime().UTC()
+		in.ExpiryDate = &t
+	}
+	out, err := s.Scheduled.Schedule(ctx, in, middleware.UserIDFromContext(ctx))
+	if err != nil {
+		return nil, toStatus(err, "schedule transaction")
+	}
+	return scheduledToProto(out), nil
+}
+
+func (s *Server) ListScheduledTransactions(ctx context.Context, req *transactionsv1.ListScheduledTransactionsRequest) (*transactionsv1.ListScheduledTransactionsResponse, error) {
+	owner, err := ownerScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.Scheduled.List(ctx, owner, req.GetUserid(), req.GetStatus(), int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, toStatus(err, "list scheduled transactions")
+	}
+	out := &transactionsv1.ListScheduledTransactionsResponse{Scheduled: make([]*transactionsv1.ScheduledTransaction, 0, len(list))}
+	for i := range list {
+		out.Scheduled = append(out.Scheduled, scheduledToProto(&list[i]))
+	}
+	return out, nil
+}
+
+func (s *Server) CancelScheduledTransaction(ctx context.Context, req *transactionsv1.CancelScheduledTransactionRequest) (*transactionsv1.ScheduledTransaction, error) {
+	owner, err := ownerScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.Scheduled.Cancel(ctx, req.GetId(), owner)
+	if err != nil {
+		return nil, toStatus(err, "cancel scheduled transaction")
+	}
+	return scheduledToProto(out), nil
+}
@@ -2,116 +2,159 @@ package grpcapi
 
 import (
 	"context"
-	"net"
+	"math"
 	"strings"
-	"sync"
+	"time"
 
+	"github.com/devifyX/go-back-transaction-service/internal/audit"
 	"github.com/devifyX/go-back-transaction-service/internal/db"
 	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/devifyX/go-back-transaction-service/internal/reconcile"
 	transactionsv1 "github.com/devifyX/go-back-transaction-service/proto"
 
-	"golang.org/x/time/rate"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
-	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// Server implements transactions.v1.Transactions.
+// Server implements transactions.v1.Transactions. Identity, rate limiting,
+// quotas and auditing are applied by interceptors.
 type Server struct {
 	transactionsv1.UnimplementedTransactionsServer
-	Repo *db.TransactionRepo
-
-	// simple in-memory rate limiting (per-IP and per-user)
-	mu          sync.Mutex
-	ipLimiter   map[string]*rate.Limiter
-	userLimiter map[string]*rate.Limiter
-	ipRate      rate.Limit
-	userRate    rate.Limit
-	burst       int
+	Repo          *db.TransactionRepo
+	Caps          *db.CapRepo
+	Coins         *db.CoinRepo
+	Platforms     *db.PlatformRepo
+	Rates         *db.RateRepo
+	Ledger        *db.LedgerRepo
+	Reconcile     *reconcile.Reconciler
+	Subscriptions *db.SubscriptionRepo
+	Scheduled     *db.ScheduledRepo
+	FraudReviews  *db.FraudReviewRepo
+	Tenants       *db.TenantRepo
+	Audit         *audit.Recorder // optional; serves ListAuditEvents
 }
 
-func NewServer(repo *db.TransactionRepo, ipPerMin, userPerMin int) *Server {
-	return &Server{
-		Repo:        repo,
-		ipLimiter:   map[string]*rate.Limiter{},
-		userLimiter: map[string]*rate.Limiter{},
-		ipRate:      rate.Limit(float64(ipPerMin) / 60.0),
-		userRate:    rate.Limit(float64(userPerMin) / 60.0),
-		burst:       20,
+func (s *Server) CreateTransaction(ctx context.Context, req *transactionsv1.CreateTransactionRequest) (*transactionsv1.Transaction, error) {
+	model, err := transactionFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := s.Repo.Insert(ctx, model)
+	if err != nil {
+		return nil, toStatus(err, "insert")
 	}
+
+	return transactionToProto(*out), nil
 }
 
-func (s *Server) getLimiter(m map[string]*rate.Limiter, key string, r rate.Limit) *rate.Limiter {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if lim, ok := m[key]; ok {
-		return lim
-	}
-	lim := rate.NewLimiter(r, s.burst)
-	m[key] = lim
-	return lim
+var sortFields = map[transactionsv1.TransactionSortField]string{
+	transactionsv1.TransactionSortField_TRANSACTION_SORT_FIELD_UNSPECIFIED:           db.SortByTimestamp,
+	transactionsv1.TransactionSortField_TRANSACTION_SORT_FIELD_TRANSACTION_TIMESTAMP: db.SortByTimestamp,
+	transactionsv1.TransactionSortField_TRANSACTION_SORT_FIELD_EXPIRY_DATE:           db.SortByExpiry,
+	transactionsv1.TransactionSortField_TRANSACTION_SORT_FIELD_COINUSED:              db.SortByCoinUsed,
 }
 
-func (s *Server) allow(ctx context.Context) bool {
-	// Derive client IP from peer info
-	ip := "unknown"
-	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
-		ip = p.Addr.String()
-		if host, _, err := net.SplitHostPort(ip); err == nil {
-			ip = host
+func (s *Server) ListTransactions(ctx context.Context, req *transactionsv1.ListTransactionsRequest) (*transactionsv1.ListTransactionsResponse, error) {
+	if req.GetIncludeDeleted() {
+		if err := requireAdmin(ctx); err != nil {
+			return nil, err
 		}
 	}
-	ipLimiter := s.getLimiter(s.ipLimiter, ip, s.ipRate)
-	if !ipLimiter.Allow() {
-		return false
+	f, err := filterFromProto(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	sortBy, ok := sortFields[req.GetSortBy()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown sort field %v", req.GetSortBy())
 	}
+	f.SortBy, f.Ascending = sortBy, req.GetAscending()
+	f.Limit, f.Offset = int(req.GetLimit()), int(req.GetOffset())
+	f.IncludeDeleted = req.GetIncludeDeleted()
 
-	// Optional user limiter via metadata "x-user-id"
-	if md, ok := metadata.FromIncomingContext(ctx); ok {
-		vals := md.Get("x-user-id")
-		if len(vals) > 0 {
-			uid := strings.TrimSpace(vals[0])
-			if uid != "" {
-				userLimiter := s.getLimiter(s.userLimiter, uid, s.userRate)
-				if !userLimiter.Allow() {
-					return false
-				}
-			}
-		}
+	txs, err := s.Repo.List(ctx, f)
+	if err != nil {
+		return nil, toStatus(err, "list transactions")
 	}
-	return true
+	resp := &transactionsv1.ListTransactionsResponse{}
+	for _, t := range txs {
+		resp.Transactions = append(resp.Transactions, transactionToProto(t))
+	}
+	return resp, nil
 }
 
-func (s *Server) CreateTransaction(ctx context.Context, req *transactionsv1.CreateTransactionRequest) (*transactionsv1.Transaction, error) {
-	if !s.allow(ctx) {
-		return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded")
+// filterFromProto converts a TransactionFilter; invalid timestamps are
+// rejected.
+func filterFromProto(in *transactionsv1.TransactionFilter) (db.TransactionFilter, error) {
+	f := db.TransactionFilter{
+		UserIDs:       in.GetUserids(),
+		CoinIDs:       in.GetCoinids(),
+		DataIDs:       in.GetDataids(),
+		PlatformNames: in.GetPlatformNames(),
+		DataIDPrefix:  in.GetDataidPrefix(),
+		Metadata:      in.GetMetadata(),
+		Tags:          in.GetTags(),
+	}
+	if in != nil {
+		f.MinCoinUsed, f.MaxCoinUsed = in.MinCoinused, in.MaxCoinused
+	}
+	if v := in.GetStatus(); v != "" {
+		f.Status = &v
 	}
+	for _, ts := range []struct {
+		name string
+		in   *timestamppb.Timestamp
+		out  **time.Time
+	}{
+		{"from", in.GetFrom(), &f.FromTimestamp},
+		{"to", in.GetTo(), &f.ToTimestamp},
+		{"expires_from", in.GetExpiresFrom(), &f.ExpiresFrom},
+		{"expires_to", in.GetExpiresTo(), &f.ExpiresTo},
+		{"active_at", in.GetActiveAt(), &f.ActiveAt},
+	} {
+		if ts.in == nil {
+			continue
+		}
+		if err := ts.in.CheckValid(); err != nil {
+			return f, status.Errorf(codes.InvalidArgument, "filter.%s: %v", ts.name, err)
+		}
+		t := ts.in.AsTime()
+		*ts.out = &t
+	}
+	return f, nil
+}
 
+// transactionFromRequest validates req and converts it to a model.
+func transactionFromRequest(req *transactionsv1.CreateTransactionRequest) (models.Transaction, error) {
 	// Basic validation
 	if strings.TrimSpace(req.GetCoinid()) == "" ||
 		strings.TrimSpace(req.GetUserid()) == "" ||
 		strings.TrimSpace(req.GetDataid()) == "" ||
 		strings.TrimSpace(req.GetPlatformName()) == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "coinid, userid, dataid, and platform_name are required")
+		return models.Transaction{}, status.Errorf(codes.InvalidArgument, "coinid, userid, dataid, and platform_name are required")
 	}
-	if req.GetCoinused() < 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "coinused must be non-negative")
+	if v := req.GetCoinused(); v < 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+		return models.Transaction{}, status.Errorf(codes.InvalidArgument, "coinused must be a finite, non-negative number")
 	}
 	txTS := req.GetTransactionTimestamp()
-	expTS := req.GetExpiryDate()
-	if txTS == nil || expTS == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "transaction_timestamp and expiry_date are required")
+	if txTS == nil {
+		return models.Transaction{}, status.Errorf(codes.InvalidArgument, "transaction_timestamp is required")
 	}
 	txTime := txTS.AsTime().UTC()
-	expTime := expTS.AsTime().UTC()
-	if expTime.Before(txTime) {
-		return nil, status.Errorf(codes.InvalidArgument, "expiry_date must be >= transaction_timestamp")
+	// A missing expiry_date is derived from the platform's default access
+	// duration by the repository.
+	var expTime time.Time
+	if expTS := req.GetExpiryDate(); expTS != nil {
+		expTime = expTS.AsTime().UTC()
+		if expTime.Before(txTime) {
+			return models.Transaction{}, status.Errorf(codes.InvalidArgument, "expiry_date must be >= transaction_timestamp")
+		}
 	}
 
 	// Convert request to model
-	model := models.Transaction{
+	return models.Transaction{
 		CoinID:               req.GetCoinid(),
 		UserID:               req.GetUserid(),
 		DataID:               req.GetDataid(),
@@ -119,22 +162,35 @@ func (s *Server) CreateTransaction(ctx context.Context, req *transactionsv1.Crea
 		TransactionTimestamp: txTime,
 		ExpiryDate:           expTime,
 		PlatformName:         req.GetPlatformName(),
-	}
+		Metadata:             req.GetMetadata(),
+		Tags:                 req.GetTags(),
+	}, nil
+}
 
-	out, err := s.Repo.Insert(ctx, model)
-	if err != nil {
-		// Map DB errors as needed (e.g., unique violation -> AlreadyExists)
-		return nil, status.Errorf(codes.Internal, "insert failed: %v", err)
+func transactionToProto(t models.Transaction) *transactionsv1.Transaction {
+	out := &transactionsv1.Transaction{
+		Id:                   t.ID,
+		Coinid:               t.CoinID,
+		Userid:               t.UserID,
+		Dataid:               t.DataID,
+		Coinused:             t.CoinUsed,
+		TransactionTimestamp: timestamppb.New(t.TransactionTimestamp.UTC()),
+		ExpiryDate:           timestamppb.New(t.ExpiryDate.UTC()),
+		PlatformName:         t.PlatformName,
+		TenantId:             t.TenantID,
+		Status:               t.Status,
+		Version:              t.Version,
+		Metadata:             t.Metadata,
+		Tags:                 t.Tags,
 	}
-
-	return &transactionsv1.Transaction{
-		Id:                   out.ID,
-		Coinid:               out.CoinID,
-		Userid:               out.UserID,
-		Dataid:               out.DataID,
-		Coinused:             out.CoinUsed,
-		TransactionTimestamp: timestamppb.New(out.TransactionTimestamp.UTC()),
-		ExpiryDate:           timestamppb.New(out.ExpiryDate.UTC()),
-		PlatformName:         out.PlatformName,
-	}, nil
+	if t.DeletedAt != nil {
+		out.DeletedAt = timestamppb.New(t.DeletedAt.UTC())
+	}
+	if t.HoldExpiresAt != nil {
+		out.HoldExpiresAt = timestamppb.New(t.HoldExpiresAt.UTC())
+	}
+	if t.RenewsID != nil {
+		out.RenewsId = *t.RenewsID
+	}
+	return out
 }
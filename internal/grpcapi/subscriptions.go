@@ -0,0 +1,193 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	transactionsv1 "github.com/devifyX/go-back-transaction-service/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func planToProto(p *models.SubscriptionPlan) *transactionsv1.SubscriptionPlan {
+	return &transactionsv1.SubscriptionPlan{
+		Id:           p.ID,
+		Name:         p.Name,
+		PlatformName: p.PlatformName,
+		Dataid:       p.DataID,
+		Coinid:       p.CoinID,
+		Amount:       p.Amount,
+		Period:       p.Period,
+		Active:       p.Active,
+		CreatedAt:    timestamppb.New(p.CreatedAt.UTC()),
+	}
+}
+
+func subscriptionToProto(s *models.Subscription) *transactionsv1.Subscription {
+	return &transactionsv1.Subscription{
+		Id:             s.ID,
+		PlanId:         s.PlanID,
+		Userid:         s.UserID,
+		Status:         s.Status,
+		StartedAt:      timestamppb.New(s.StartedAt.UTC()),
+		Cycle:          int32(s.Cycle),
+		NextChargeAt:   timestamppb.New(s.NextChargeAt.UTC()),
+		FailedAttempts: int32(s.FailedAttempts),
+		GraceUntil:     optTimestamp(s.GraceUntil),
+		LastError:      s.LastError,
+		CreatedAt:      timestamppb.New(s.CreatedAt.UTC()),
+		UpdatedAt:      timestamppb.New(s.UpdatedAt.UTC()),
+	}
+}
+
+func (s *Server) CreateSubscriptionPlan(ctx context.Context, req *transactionsv1.SubscriptionPlan) (*transactionsv1.SubscriptionPlan, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	p, err := s.Subscriptions.CreatePlan(ctx, models.SubscriptionPlan{
+		Name:         req.GetName(),
+		PlatformName: req.GetPlatformName(),
+		DataID:       req.GetDataid(),
+		CoinID:       req.GetCoinid(),
+		Amount:       req.GetAmount(),
+		Period:       req.GetPeriod(),
+		Active:       req.GetActive(),
+	})
+	if err != nil {
+		return nil, toStatus(err, "create subscription plan")
+	}
+	return planToProto(p), nil
+}
+
+func (s *Server) SetSubscriptionPlanActive(ctx context.Context, req *transactionsv1.SetSubscriptionPlanActiveRequest) (*transactionsv1.SubscriptionPlan, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	p, err := s.Subscriptions.SetPlanActive(ctx, req.GetId(), req.GetActive())
+	if err != nil {
+		return nil, toStatus(err, "set subscription plan active")
+	}
+	return planToProto(p), nil
+}
+
+func (s *Server) ListSubscriptionPlans(ctx context.Context, req *transactionsv1.ListSubscriptionPlansRequest) (*transactionsv1.ListSubscriptionPlansResponse, error) {
+	plans, err := s.Subscriptions.ListPlans(ctx, req.GetIncludeInactive())
+	if err != nil {
+		return nil, toStatus(err, "list subscription plans")
+	}
+	out := &transactionsv1.ListSubscriptionPlansResponse{Plans: make([]*transactionsv1.SubscriptionPlan, 0, len(plans))}
+	for i := range plans {
+		out.Plans = append(out.Plans, planToProto(&plans[i]))
+	}
+	return out, nil
+}
+
+func (s *Server) Subscribe(ctx context.Context, req *transactionsv1.SubscribeRequest) (*transactionsv1.SubscribeResponse, error) {
+	if strings.TrimSpace(req.GetPlanId()) == "" || strings.TrimSpace(req.GetUserid()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "plan_id and userid are required")
+	}
+	sub, charge, err := s.Subscriptions.Subscribe(ctx, req.GetPlanId(), req.GetUserid())
+	if err != nil {
+		return nil, toStatus(err, "subscribe")
+	}
+	return &transactionsv1.SubscribeResponse{
+		Subscription: subscriptionToProto(sub),
+		Transaction:  transactionToProto(*charge),
+	}, nil
+}
+
+func (s *Server) GetSubscription(ctx context.Context, req *transactionsv1.GetSubscriptionRequest) (*transactionsv1.Subscription, error) {
+	owner, err := ownerScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sub, err := s.Subscriptions.Get(ctx, req.GetId(), owner)
+	if err != nil {
+		return nil, toStatus(err, "get subscription")
+	}
+	return subscriptionToProto(sub), nil
+}
+
+func (s *Server) ListSubscriptions(ctx context.Context, req *transactionsv1.ListSubscriptionsRequest) (*transactionsv1.ListSubscriptionsResponse, error) {
+	owner, err := ownerScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	subs, err := s.Subscriptions.List(ctx, owner, req.GetUserid(), req.GetStatus())
+	if err != nil {
+		return nil, toStatus(err, "list subscriptions")
+	}
+	out := &transactionsv1.ListSubscriptionsResponse{Subscriptions: make([]*transactionsv1.Subscription, 0, len(subs))}
+	for i := range subs {
+		out.Subscriptions = append(out.Subscriptions, subscriptionToProto(&subs[i]))
+	}
+	return out, nil
+}
+
+func (s *Server) CancelSubscription(ctx context.Context, req *transactionsv1.CancelSubscriptionRequest) (*transactionsv1.Subscription, error) {
+	owner, err := ownerScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sub, err := s.Subscriptions.Cancel(ctx, req.GetId(), owner)
+	if err != nil {
+		return nil, toStatus(err, "cancel subscription")
+	}
+	return subscriptionToProto(sub), nil
+}
+
+func (s *Server) PauseSubscription(ctx context.Context, req *transactionsv1.PauseSubscriptionRequest) (*transactionsv1.Subscription, error) {
+	owner, err := ownerScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sub, err := s.Subscriptions.Pause(ctx, req.GetId(), owner)
+	if err != nil {
+		return nil, toStatus(err, "pause subscription")
+	}
+	return subscriptionToProto(sub), nil
+}
+
+func (s *Server) ResumeSubscription(ctx context.Context, req *transactionsv1.ResumeSubscriptionRequest) (*transactionsv1.Subscription, error) {
+	owner, err := ownerScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sub, err := s.Subscriptions.Resume(ctx, req.GetId(), owner)
+	if err != nil {
+		return nil, toStatus(err, "resume subscription")
+	}
+	return subscriptionToProto(sub), nil
+}
+
+func (s *Server) ListSubscriptionEvents(ctx context.Context, req *transactionsv1.ListSubscriptionEventsRequest) (*transactionsv1.ListSubscriptionEventsResponse, error) {
+	owner, err := ownerScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.Subscriptions.Events(ctx, req.GetSubscriptionId(), owner)
+	if err != nil {
+		return nil, toStatus(err, "list subscription events")
+	}
+	out := &transactionsv1.ListSubscriptionEventsResponse{Events: make([]*transactionsv1.SubscriptionEvent, 0, len(events))}
+	for _, e := range events {
+		pe := &transactionsv1.SubscriptionEvent{
+			Id:             e.ID,
+			SubscriptionId: e.SubscriptionID,
+			PlatformName:   e.PlatformName,
+			Userid:         e.UserID,
+			Type:           e.Type,
+			Detail:         e.Detail,
+			CreatedAt:      timestamppb.New(e.CreatedAt.UTC()),
+			DeliveredAt:    optTimestamp(e.DeliveredAt),
+		}
+		if e.TransactionID != nil {
+			pe.TransactionId = *e.TransactionID
+		}
+		out.Events = append(out.Events, pe)
+	}
+	return out, nil
+}
@@ -0,0 +1,97 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	transactionsv1 "github.com/devifyX/go-back-transaction-service/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func tenantToProto(t models.Tenant) *transactionsv1.Tenant {
+	return &transactionsv1.Tenant{
+		Id:                t.ID,
+		Name:              t.Name,
+		IpRatePerMinute:   int32(t.IPRatePerMinute),
+		UserRatePerMinute: int32(t.UserRatePerMinute),
+		RetentionDays:     int32(t.RetentionDays),
+		CreatedAt:         timestamppb.New(t.CreatedAt.UTC()),
+	}
+}
+
+func tenantFromProto(t *transactionsv1.Tenant) (models.Tenant, error) {
+	if strings.TrimSpace(t.GetId()) == "" || strings.TrimSpace(t.GetName()) == "" {
+		return models.Tenant{}, status.Errorf(codes.InvalidArgument, "id and name are required")
+	}
+	return models.Tenant{
+		ID:                t.GetId(),
+		Name:              t.GetName(),
+		IPRatePerMinute:   int(t.GetIpRatePerMinute()),
+		UserRatePerMinute: int(t.GetUserRatePerMinute()),
+		RetentionDays:     int(t.GetRetentionDays()),
+	}, nil
+}
+
+func (s *Server) CreateTenant(ctx context.Context, req *transactionsv1.Tenant) (*transactionsv1.TenantCredentials, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	in, err := tenantFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+	t, key, err := s.Tenants.Create(ctx, in)
+	if err != nil {
+		return nil, toStatus(err, "create tenant")
+	}
+	return &transactionsv1.TenantCredentials{Tenant: tenantToProto(*t), ApiKey: key}, nil
+}
+
+func (s *Server) UpdateTenant(ctx context.Context, req *transactionsv1.Tenant) (*transactionsv1.Tenant, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	in, err := tenantFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.Tenants.Update(ctx, in)
+	if err != nil {
+		return nil, toStatus(err, "update tenant")
+	}
+	return tenantToProto(*t), nil
+}
+
+func (s *Server) ListTenants(ctx context.Context, _ *transactionsv1.ListTenantsRequest) (*transactionsv1.ListTenantsResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	tenants, err := s.Tenants.List(ctx)
+	if err != nil {
+		return nil, toStatus(err, "list tenants")
+	}
+	resp := &transactionsv1.ListTenantsResponse{}
+	for _, t := range tenants {
+		resp.Tenants = append(resp.Tenants, tenantToProto(t))
+	}
+	return resp, nil
+}
+
+func (s *Server) RotateTenantKey(ctx context.Context, req *transactionsv1.RotateTenantKeyRequest) (*transactionsv1.TenantCredentials, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	key, err := s.Tenants.RotateKey(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err, "rotate tenant key")
+	}
+	t, err := s.Tenants.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err, "rotate tenant key")
+	}
+	return &transactionsv1.TenantCredentials{Tenant: tenantToProto(*t), ApiKey: key}, nil
+}
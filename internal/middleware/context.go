@@ -0,0 +1,211 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/devifyX/go-back-transaction-service/internal/clientip"
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/devifyX/go-back-transaction-service/internal/ratelimit"
+)
+
+type ctxKey int
+
+const (
+	clientIPKey ctxKey = iota
+	userIDKey
+	adminKey
+	requestIDKey
+	apiKeyKey
+)
+
+// ErrUnauthenticated is returned when an API key is missing or unknown.
+var ErrUnauthenticated = errors.New("invalid or missing API key")
+
+// RateLimitedError is returned by Identify when the client IP exhausted
+// Identifier.AuthLimit.
+type RateLimitedError struct {
+	Decision ratelimit.Decision
+}
+
+func (e *RateLimitedError) Error() string { return "rate limit exceeded (ip)" }
+
+// TenantResolver maps API keys and ids to tenants; unknown values resolve
+// to nil.
+type TenantResolver interface {
+	ByAPIKey(ctx context.Context, key string) (*models.Tenant, error)
+	Get(ctx context.Context, id string) (*models.Tenant, error)
+}
+
+// Credentials are the raw identity inputs a transport extracts from a
+// request (headers for HTTP, metadata for gRPC).
+type Credentials struct {
+	IP         string // already resolved through trusted proxies
+	UserID     string
+	AdminToken string
+	APIKey     string
+	RequestID  string
+}
+
+// Identifier turns Credentials into the request context shared by both
+// transports: client IP, user id, admin status, request id and tenant.
+type Identifier struct {
+	IPs        *clientip.Resolver
+	AdminToken string
+	Tenants    TenantResolver
+	// DefaultTenant serves callers without an API key; empty makes the
+	// key mandatory.
+	DefaultTenant string
+	// Limiter, when set, charges AuthLimit per client IP before the tenant
+	// is looked up, so random API keys cannot drive unthrottled lookups.
+	Limiter   ratelimit.Backend
+	AuthLimit ratelimit.Limit
+}
+
+// Identify validates c and returns ctx populated with the caller identity.
+func (id *Identifier) Identify(ctx context.Context, c Credentials) (context.Context, error) {
+	if id.Limiter != nil {
+		d, err := id.Limiter.Allow(ctx, ratelimit.AuthKey(c.IP), id.AuthLimit)
+		switch {
+		case err != nil:
+			log.Printf("rate limit backend error (key=%s): %v", ratelimit.AuthKey(c.IP), err)
+		case !d.Allowed:
+			return nil, &RateLimitedError{Decision: d}
+		}
+	}
+	var (
+		tenant *models.Tenant
+		err    error
+	)
+	key := strings.TrimSpace(c.APIKey)
+	switch {
+	case key != "":
+		tenant, err = id.Tenants.ByAPIKey(ctx, key)
+	case id.DefaultTenant != "":
+		tenant, err = id.Tenants.Get(ctx, id.DefaultTenant)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if tenant == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	ctx = db.WithTenant(ctx, tenant)
+	ctx = db.WithClientIP(ctx, c.IP) // for fraud screening
+	ctx = WithIdentity(ctx, c.IP, c.UserID)
+	if key != "" {
+		ctx = context.WithValue(ctx, apiKeyKey, true)
+	}
+	if AdminTokenMatches(id.AdminToken, c.AdminToken) {
+		ctx = WithAdmin(ctx)
+	}
+	return WithRequestID(ctx, c.RequestID), nil
+}
+
+// HTTP records the client IP (resolved through trusted proxies),
+// X-User-ID, admin status (X-Admin-Token), tenant (X-API-Key) and request
+// id of the request in its context so downstream handlers and resolvers
+// need not re-derive them. The request id is echoed in X-Request-ID.
+func (id *Identifier) HTTP(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := id.Identify(r.Context(), Credentials{
+			IP:         id.IPs.FromHTTP(r),
+			UserID:     r.Header.Get("X-User-ID"),
+			AdminToken: r.Header.Get("X-Admin-Token"),
+			APIKey:     r.Header.Get("X-API-Key"),
+			RequestID:  r.Header.Get("X-Request-ID"),
+		})
+		var limited *RateLimitedError
+		switch {
+		case errors.As(err, &limited):
+			writeRateLimitHeaders(w, limited.Decision)
+			http.Error(w, err.Error(), http.StatusTooManyRequests)
+			return
+		case errors.Is(err, ErrUnauthenticated):
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		case err != nil:
+			http.Error(w, "identity lookup failed", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("X-Request-ID", RequestIDFromContext(ctx))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// WithRequestID stores id, or a freshly generated one when id is empty.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	if id = strings.TrimSpace(id); id == "" || len(id) > 128 {
+		b := make([]byte, 16)
+		_, _ = rand.Read(b)
+		id = hex.EncodeToString(b)
+	}
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// RequestIDFromContext returns the id recorded by WithRequestID, or "".
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
+
+// AdminTokenMatches compares tokens in constant time. An empty configured
+// token disables admin access entirely.
+func AdminTokenMatches(want, got string) bool {
+	return want != "" && subtle.ConstantTimeCompare([]byte(want), []byte(got)) == 1
+}
+
+// WithAdmin marks the caller as an operator.
+func WithAdmin(ctx context.Context) context.Context {
+	return context.WithValue(ctx, adminKey, true)
+}
+
+// IsAdmin reports whether the caller presented the admin token.
+func IsAdmin(ctx context.Context) bool {
+	ok, _ := ctx.Value(adminKey).(bool)
+	return ok
+}
+
+// HasAPIKey reports whether the tenant was resolved from an API key rather
+// than DefaultTenant.
+func HasAPIKey(ctx context.Context) bool {
+	ok, _ := ctx.Value(apiKeyKey).(bool)
+	return ok
+}
+
+// WithIdentity stores the caller's address and user id in ctx.
+func WithIdentity(ctx context.Context, ip, uid string) context.Context {
+	ctx = context.WithValue(ctx, clientIPKey, ip)
+	if uid = strings.TrimSpace(uid); uid != "" {
+		ctx = context.WithValue(ctx, userIDKey, uid)
+	}
+	return ctx
+}
+
+// ClientIPFromContext returns the IP recorded by Identify, or "".
+func ClientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey).(string)
+	return ip
+}
+
+// UserIDFromContext returns the X-User-ID recorded by Identify, or "".
+func UserIDFromContext(ctx context.Context) string {
+	uid, _ := ctx.Value(userIDKey).(string)
+	return uid
+}
+
+// TenantIDFromContext returns the caller's tenant id, or "".
+func TenantIDFromContext(ctx context.Context) string {
+	if t := db.TenantFromContext(ctx); t != nil {
+		return t.ID
+	}
+	return ""
+}
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/devifyX/go-back-transaction-service/internal/ratelimit"
+)
+
+type countingTenants struct{ lookups int }
+
+func (c *countingTenants) ByAPIKey(context.Context, string) (*models.Tenant, error) {
+	c.lookups++
+	return nil, nil
+}
+
+func (c *countingTenants) Get(context.Context, string) (*models.Tenant, error) {
+	c.lookups++
+	return nil, nil
+}
+
+func TestIdentifyLimitsByIPBeforeTenantLookup(t *testing.T) {
+	tenants := &countingTenants{}
+	id := &Identifier{
+		Tenants:   tenants,
+		Limiter:   ratelimit.NewMemory(100),
+		AuthLimit: ratelimit.Limit{PerMinute: 1, Burst: 3},
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := id.Identify(context.Background(), Credentials{IP: "1.2.3.4", APIKey: "random"}); !errors.Is(err, ErrUnauthenticated) {
+			t.Fatalf("call %d: err = %v, want ErrUnauthenticated", i, err)
+		}
+	}
+	_, err := id.Identify(context.Background(), Credentials{IP: "1.2.3.4", APIKey: "random"})
+	var limited *RateLimitedError
+	if !errors.As(err, &limited) {
+		t.Fatalf("err = %v, want RateLimitedError", err)
+	}
+	if tenants.lookups != 3 {
+		t.Fatalf("%d tenant lookups, want 3", tenants.lookups)
+	}
+	if _, err := id.Identify(context.Background(), Credentials{IP: "5.6.7.8", APIKey: "random"}); !errors.Is(err, ErrUnauthenticated) {
+		t.Fatalf("other IP: err = %v, want ErrUnauthenticated", err)
+	}
+}
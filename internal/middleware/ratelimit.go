@@ -1,82 +1,94 @@
 package middleware
 
 import (
-	"net"
+	"log"
+	"math"
 	"net/http"
-	"strings"
-	"sync"
+	"strconv"
 	"time"
 
-	"golang.org/x/time/rate"
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/ratelimit"
 )
 
 type LimiterStore struct {
-	mu    sync.Mutex
-	ip    map[string]*entry
-	user  map[string]*entry
-	ipR   rate.Limit
-	userR rate.Limit
-	burst int
+	backend ratelimit.Backend
+	ip      ratelimit.Limit
+	user    ratelimit.Limit
 }
 
-type entry struct {
-	lim  *rate.Limiter
-	last time.Time
-}
-
-func NewLimiterStore(ipPerMin, userPerMin int) *LimiterStore {
+func NewLimiterStore(backend ratelimit.Backend, ipPerMin, userPerMin int) *LimiterStore {
 	return &LimiterStore{
-		ip:    make(map[string]*entry),
-		user:  make(map[string]*entry),
-		ipR:   rate.Limit(float64(ipPerMin) / 60.0),
-		userR: rate.Limit(float64(userPerMin) / 60.0),
-		burst: 20,
+		backend: backend,
+		ip:      ratelimit.Limit{PerMinute: ipPerMin, Burst: 20},
+		user:    ratelimit.Limit{PerMinute: userPerMin, Burst: 20},
 	}
 }
 
-func (s *LimiterStore) get(lmap map[string]*entry, key string, r rate.Limit) *rate.Limiter {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if e, ok := lmap[key]; ok {
-		e.last = time.Now()
-		return e.lim
+// allow consults the backend and fails open if it is unavailable, so a
+// database hiccup degrades limiting rather than the whole API.
+func (s *LimiterStore) allow(r *http.Request, key string, l ratelimit.Limit) ratelimit.Decision {
+	d, err := s.backend.Allow(r.Context(), key, l)
+	if err != nil {
+		log.Printf("rate limit backend error (key=%s): %v", key, err)
+		return ratelimit.Decision{Allowed: true, Limit: l.Burst, Remaining: l.Burst}
 	}
-	lim := rate.NewLimiter(r, s.burst)
-	lmap[key] = &entry{lim: lim, last: time.Now()}
-	return lim
+	return d
 }
 
-func clientIP(r *http.Request) string {
-	// Best effort: honor X-Forwarded-For if behind proxy
-	if xf := r.Header.Get("X-Forwarded-For"); xf != "" {
-		parts := strings.Split(xf, ",")
-		return strings.TrimSpace(parts[0])
+// tighter returns whichever decision leaves the caller less headroom.
+func tighter(a, b ratelimit.Decision) ratelimit.Decision {
+	if b.Remaining < a.Remaining {
+		return b
 	}
-	h, _, err := net.SplitHostPort(r.RemoteAddr)
-	if err != nil {
-		return r.RemoteAddr
+	return a
+}
+
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
+
+// writeRateLimitHeaders sets the IETF RateLimit-* headers and, on rejection,
+// Retry-After.
+func writeRateLimitHeaders(w http.ResponseWriter, d ratelimit.Decision) {
+	h := w.Header()
+	h.Set("RateLimit-Limit", strconv.Itoa(d.Limit))
+	h.Set("RateLimit-Remaining", strconv.Itoa(d.Remaining))
+	h.Set("RateLimit-Reset", ceilSeconds(d.Reset))
+	if !d.Allowed {
+		h.Set("Retry-After", ceilSeconds(d.RetryAfter))
 	}
-	return h
 }
 
-// RateLimit enforces per-IP and per-user (via X-User-ID) limits.
+// RateLimit enforces per-IP and per-user (via X-User-ID) limits, using the
+// tenant's own limits where configured. The RateLimit-* headers describe
+// the tighter of the two buckets. It must run inside Identifier.HTTP.
 func (s *LimiterStore) RateLimit(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ip := clientIP(r)
-		ipLimiter := s.get(s.ip, ip, s.ipR)
-		if !ipLimiter.Allow() {
+		ipLim, userLim := s.ip, s.user
+		if t := db.TenantFromContext(r.Context()); t != nil {
+			ipLim, userLim = ipLim.Override(t.IPRatePerMinute), userLim.Override(t.UserRatePerMinute)
+		}
+		tenant := TenantIDFromContext(r.Context())
+		ip := ClientIPFromContext(r.Context())
+		d := s.allow(r, ratelimit.IPKey(tenant, ip), ipLim)
+		if !d.Allowed {
+			writeRateLimitHeaders(w, d)
 			w.WriteHeader(http.StatusTooManyRequests)
 			_, _ = w.Write([]byte("rate limit exceeded (ip)"))
 			return
 		}
-		if uid := strings.TrimSpace(r.Header.Get("X-User-ID")); uid != "" {
-			userLimiter := s.get(s.user, uid, s.userR)
-			if !userLimiter.Allow() {
+		if uid := UserIDFromContext(r.Context()); uid != "" {
+			ud := s.allow(r, ratelimit.UserKey(tenant, uid), userLim)
+			if !ud.Allowed {
+				writeRateLimitHeaders(w, ud)
 				w.WriteHeader(http.StatusTooManyRequests)
 				_, _ = w.Write([]byte("rate limit exceeded (user)"))
 				return
 			}
+			d = tighter(d, ud)
 		}
+		writeRateLimitHeaders(w, d)
 		next.ServeHTTP(w, r)
 	})
 }
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// Coin is a catalog entry; Transaction.CoinID must name an active coin.
+type Coin struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Precision int       `json:"precision"` // decimal places allowed in coinused
+	Active    bool      `json:"active"`
+	MinSpend  float64   `json:"minSpend"`
+	MaxSpend  float64   `json:"maxSpend"` // 0 means no maximum
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// ErasureReceipt records that a user's identity was removed from their
+// transactions and spending caps. Amounts are kept for accounting; the
+// original userid survives only as SubjectHash.
+type ErasureReceipt struct {
+	ID           string    `json:"id"`
+	TenantID     string    `json:"tenantId"`
+	Pseudonym    string    `json:"pseudonym"`
+	SubjectHash  string    `json:"subjectHash"`
+	Transactions int64     `json:"transactions"`
+	SpendingCaps int64     `json:"spendingCaps"`
+	RequestedBy  string    `json:"requestedBy"`
+	ErasedAt     time.Time `json:"erasedAt"`
+}
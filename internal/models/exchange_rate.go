@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// ExchangeRate says 1 BaseCoin is worth Rate QuoteCoin from EffectiveAt
+// until the pair's next rate. The inverse pair is derived, not stored.
+type ExchangeRate struct {
+	BaseCoin    string    `json:"baseCoin"`
+	QuoteCoin   string    `json:"quoteCoin"`
+	Rate        float64   `json:"rate"`
+	EffectiveAt time.Time `json:"effectiveAt"`
+}
+
+// CoinTotal aggregates the transactions of one coin. Value is the sum
+// converted into the reference coin; Unconverted counts rows that had no
+// rate and are missing from Value.
+type CoinTotal struct {
+	CoinID      string  `json:"coinid"`
+	Count       int64   `json:"count"`
+	Total       float64 `json:"total"`
+	Value       float64 `json:"value"`
+	Unconverted int64   `json:"unconverted"`
+}
+
+// Totals is the result of an aggregation normalized into ReferenceCoin.
+// Value is only complete when no CoinTotal has unconverted rows.
+type Totals struct {
+	ReferenceCoin string      `json:"referenceCoin"`
+	ByCoin        []CoinTotal `json:"byCoin"`
+	Value         float64     `json:"value"`
+	Complete      bool        `json:"complete"`
+}
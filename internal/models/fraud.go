@@ -0,0 +1,56 @@
+package models
+
+import "time"
+
+// Fraud actions, from weakest to strongest. Flagged transactions go
+// through and are queued for review; held ones are stored as authorized
+// holds without expiry until an operator approves (captures) or denies
+// (voids) them; rejected ones are not stored.
+const (
+	FraudFlag   = "flag"
+	FraudHold   = "hold"
+	FraudReject = "reject"
+)
+
+// FraudActionRank orders actions by severity; unknown actions rank 0.
+func FraudActionRank(action string) int {
+	switch action {
+	case FraudFlag:
+		return 1
+	case FraudHold:
+		return 2
+	case FraudReject:
+		return 3
+	}
+	return 0
+}
+
+// FraudFinding is a rule that matched a new transaction.
+type FraudFinding struct {
+	Rule   string `json:"rule"`
+	Action string `json:"action"`
+	Reason string `json:"reason"`
+}
+
+// Fraud review statuses.
+const (
+	ReviewOpen     = "open"
+	ReviewApproved = "approved"
+	ReviewDenied   = "denied"
+)
+
+// FraudReview is a flagged or held transaction awaiting an operator.
+type FraudReview struct {
+	ID            int64          `json:"id"`
+	TransactionID string         `json:"transactionId"`
+	UserID        string         `json:"userid"`
+	PlatformName  string         `json:"platformName"`
+	CoinUsed      float64        `json:"coinused"`
+	Action        string         `json:"action"` // flag or hold
+	Findings      []FraudFinding `json:"findings"`
+	Status        string         `json:"status"`
+	ReviewedBy    string         `json:"reviewedBy"`
+	Note          string         `json:"note"`
+	CreatedAt     time.Time      `json:"createdAt"`
+	ReviewedAt    *time.Time     `json:"reviewedAt,omitempty"`
+}
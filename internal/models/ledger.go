@@ -0,0 +1,71 @@
+package models
+
+import "time"
+
+// Ledger account names. Each transaction debits the user's wallet and
+// credits the platform's revenue account in its coin.
+func UserAccount(userID string) string           { return "user:" + userID }
+func PlatformAccount(platformName string) string { return "platform:" + platformName }
+
+// Posting is one line of a journal entry; debits are positive, credits
+// negative, and every entry sums to zero per coin.
+type Posting struct {
+	ID            int64     `json:"id"`
+	EntryID       string    `json:"entryId"`
+	TransactionID string    `json:"transactionId"`
+	Account       string    `json:"account"`
+	CoinID        string    `json:"coinid"`
+	Amount        float64   `json:"amount"`
+	PostedAt      time.Time `json:"postedAt"`
+}
+
+type AccountBalance struct {
+	Account string  `json:"account"`
+	CoinID  string  `json:"coinid"`
+	Balance float64 `json:"balance"`
+}
+
+// Statement lists an account's postings in one coin between From and To,
+// with the balance before the first and after the last posting in range.
+type Statement struct {
+	Account  string     `json:"account"`
+	CoinID   string     `json:"coinid"`
+	From     *time.Time `json:"from"`
+	To       *time.Time `json:"to"`
+	Opening  float64    `json:"opening"`
+	Closing  float64    `json:"closing"`
+	Postings []Posting  `json:"postings"`
+}
+
+// LedgerCoinTotal compares the spend recorded in transactions with the
+// wallet debits in the ledger for one coin.
+type LedgerCoinTotal struct {
+	CoinID       string  `json:"coinid"`
+	Transactions float64 `json:"transactions"`
+	Ledger       float64 `json:"ledger"`
+}
+
+// LedgerReport is the result of a ledger consistency check. The id lists
+// hold samples of each kind of problem.
+type LedgerReport struct {
+	Transactions   int64             `json:"transactions"`
+	Entries        int64             `json:"entries"`
+	Unbalanced     []string          `json:"unbalanced"`     // journal entry ids
+	MissingEntries []string          `json:"missingEntries"` // transaction ids without an entry
+	Orphaned       []string          `json:"orphaned"`       // entries whose transaction is neither live nor archived
+	Mismatched     []string          `json:"mismatched"`     // transaction ids whose postings differ from the row
+	Totals         []LedgerCoinTotal `json:"totals"`
+}
+
+// OK reports whether the check found no problems.
+func (r *LedgerReport) OK() bool {
+	if len(r.Unbalanced)+len(r.MissingEntries)+len(r.Orphaned)+len(r.Mismatched) > 0 {
+		return false
+	}
+	for _, t := range r.Totals {
+		if t.Transactions != t.Ledger {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+// Transaction statuses. Every transaction starts pending inside the
+// creating database transaction and leaves it before commit: holds become
+// authorized, plain purchases captured. Authorized holds are captured or
+// voided later, explicitly or when the hold expires.
+const (
+	StatusPending    = "pending"
+	StatusAuthorized = "authorized"
+	StatusCaptured   = "captured"
+	StatusVoided     = "voided"
+)
+
+// transitions lists the legal status changes.
+var transitions = map[string][]string{
+	StatusPending:    {StatusAuthorized, StatusCaptured},
+	StatusAuthorized: {StatusCaptured, StatusVoided},
+}
+
+// CanTransition reports whether a transaction may move from one status to
+// another.
+func CanTransition(from, to string) bool {
+	for _, s := range transitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusChange is one row of a transaction's status history. Creation is
+// recorded as the change out of pending.
+type StatusChange struct {
+	ID            int64     `json:"id"`
+	TransactionID string    `json:"transactionId"`
+	From          string    `json:"from"`
+	To            string    `json:"to"`
+	Reason        string    `json:"reason"`
+	Actor         string    `json:"actor"`
+	ChangedAt     time.Time `json:"changedAt"`
+}
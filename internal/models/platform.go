@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// Dedup actions applied to a create that duplicates a recent transaction.
+const (
+	DedupReturn = "return" // respond with the existing transaction
+	DedupReject = "reject" // fail the create
+)
+
+// Platform is a registry entry; Transaction.PlatformName must name an
+// enabled platform.
+type Platform struct {
+	Name          string        `json:"name"`
+	DisplayName   string        `json:"displayName"`
+	OwnerContact  string        `json:"ownerContact"`
+	AllowedCoins  []string      `json:"allowedCoins"`  // empty allows every coin
+	DefaultAccess time.Duration `json:"defaultAccess"` // fills a missing expiryDate; 0 requires one
+	WebhookURL    string        `json:"webhookUrl"`
+	WebhookSecret string        `json:"webhookSecret"` // signs webhook deliveries; generated by the service
+	Enabled       bool          `json:"enabled"`
+	DedupWindow   time.Duration `json:"dedupWindow"` // 0 disables duplicate detection
+	DedupAction   string        `json:"dedupAction"` // DedupReturn or DedupReject
+	CreatedAt     time.Time     `json:"createdAt"`
+	UpdatedAt     time.Time     `json:"updatedAt"`
+}
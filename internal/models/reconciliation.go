@@ -0,0 +1,54 @@
+package models
+
+import "time"
+
+// Reconciliation item kinds.
+const (
+	ReconMatched        = "matched"
+	ReconMissingOurs    = "missing_ours"   // in the settlement file only
+	ReconMissingTheirs  = "missing_theirs" // in transactions only
+	ReconAmountMismatch = "amount_mismatch"
+)
+
+// Reconciliation item statuses. Matched items are created resolved.
+const (
+	ReconOpen     = "open"
+	ReconResolved = "resolved"
+)
+
+// ReconciliationRun summarizes one settlement file checked against the
+// transactions of a platform between From and To.
+type ReconciliationRun struct {
+	ID               string        `json:"id"`
+	PlatformName     string        `json:"platformName"`
+	FileName         string        `json:"fileName"`
+	From             time.Time     `json:"from"`
+	To               time.Time     `json:"to"`
+	AmountTolerance  float64       `json:"amountTolerance"`
+	TimeTolerance    time.Duration `json:"timeTolerance"`
+	Matched          int           `json:"matched"`
+	MissingOurs      int           `json:"missingOurs"`
+	MissingTheirs    int           `json:"missingTheirs"`
+	AmountMismatches int           `json:"amountMismatches"`
+	CreatedBy        string        `json:"createdBy"`
+	CreatedAt        time.Time     `json:"createdAt"`
+}
+
+// ReconciliationItem pairs a settlement line with a transaction; either
+// side is empty for the missing kinds.
+type ReconciliationItem struct {
+	ID             int64      `json:"id"`
+	RunID          string     `json:"runId"`
+	Kind           string     `json:"kind"`
+	Status         string     `json:"status"`
+	DataID         string     `json:"dataid"`
+	UserID         string     `json:"userid"`
+	TheirAmount    *float64   `json:"theirAmount"`
+	OurAmount      *float64   `json:"ourAmount"`
+	TheirTimestamp *time.Time `json:"theirTimestamp"`
+	OurTimestamp   *time.Time `json:"ourTimestamp"`
+	TransactionID  *string    `json:"transactionId"`
+	Resolution     string     `json:"resolution"`
+	ResolvedBy     string     `json:"resolvedBy"`
+	ResolvedAt     *time.Time `json:"resolvedAt"`
+}
@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// RenewalRequest asks to extend a user's access to a dataid on a platform
+// by Duration (the platform's default access when zero), charging
+// CoinUsed of CoinID.
+type RenewalRequest struct {
+	UserID       string        `json:"userid"`
+	DataID       string        `json:"dataid"`
+	PlatformName string        `json:"platformName"`
+	CoinID       string        `json:"coinid"`
+	CoinUsed     float64       `json:"coinused"`
+	Duration     time.Duration `json:"duration"`
+}
+
+// Renewal is the outcome of extending access. The extension stacks on
+// PreviousExpiry while it is in the future, and starts now otherwise. A
+// renewal held by fraud screening applies ExpiryDate once captured.
+type Renewal struct {
+	Transaction    Transaction `json:"transaction"` // the renewal charge
+	OriginalID     string      `json:"originalId"`
+	PreviousExpiry time.Time   `json:"previousExpiry"`
+	ExpiryDate     time.Time   `json:"expiryDate"`
+}
@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// Transaction fields operators may edit with TransactionUpdate.
+const (
+	FieldExpiryDate   = "expiryDate"
+	FieldPlatformName = "platformName"
+)
+
+// TransactionUpdate edits the fields named in Fields of a transaction
+// still at Version; the other values are ignored.
+type TransactionUpdate struct {
+	ID           string
+	Version      int64    // version the edit was based on
+	Fields       []string // FieldExpiryDate, FieldPlatformName
+	ExpiryDate   time.Time
+	PlatformName string
+	Reason       string
+}
+
+// FieldChange is one field edited by a revision, with values rendered as
+// text (timestamps in RFC 3339).
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// TransactionRevision records an edit that produced Version.
+type TransactionRevision struct {
+	ID            int64         `json:"id"`
+	TransactionID string        `json:"transactionId"`
+	Version       int64         `json:"version"`
+	Changes       []FieldChange `json:"changes"`
+	Reason        string        `json:"reason"`
+	Actor         string        `json:"actor"`
+	ChangedAt     time.Time     `json:"changedAt"`
+}
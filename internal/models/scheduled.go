@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// Scheduled transaction statuses. A pending spend is executed by the
+// worker at ExecuteAt; it fails when the checks of a normal insert reject
+// it at that time.
+const (
+	ScheduledPending   = "pending"
+	ScheduledExecuted  = "executed"
+	ScheduledFailed    = "failed"
+	ScheduledCancelled = "cancelled"
+)
+
+// ScheduledTransaction is a spend queued for execution at ExecuteAt. The
+// resulting transaction is timestamped with the execution time; a nil
+// ExpiryDate defaults from the platform then.
+type ScheduledTransaction struct {
+	ID            string     `json:"id"`
+	CoinID        string     `json:"coinid"`
+	UserID        string     `json:"userid"`
+	DataID        string     `json:"dataid"`
+	CoinUsed      float64    `json:"coinused"`
+	ExpiryDate    *time.Time `json:"expiryDate,omitempty"`
+	PlatformName  string     `json:"platformName"`
+	ExecuteAt     time.Time  `json:"executeAt"`
+	Status        string     `json:"status"`
+	TransactionID *string    `json:"transactionId,omitempty"`
+	Error         string     `json:"error"`
+	CreatedBy     string     `json:"createdBy"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+}
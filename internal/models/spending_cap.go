@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// Cap periods are rolling windows ending now, measured on when the service
+// recorded each spend. Monthly is a rolling 30 days, not a calendar month.
+const (
+	CapPeriodDaily   = "daily"
+	CapPeriodMonthly = "monthly"
+)
+
+// CapWindow returns the length of a cap period's rolling window.
+func CapWindow(period string) (time.Duration, bool) {
+	switch period {
+	case CapPeriodDaily:
+		return 24 * time.Hour, true
+	case CapPeriodMonthly:
+		return 30 * 24 * time.Hour, true
+	default:
+		return 0, false
+	}
+}
+
+// SpendingCap limits how much a user may spend within a rolling period.
+// Empty CoinID or PlatformName apply the cap across all coins/platforms.
+type SpendingCap struct {
+	ID           string    `json:"id"`
+	UserID       string    `json:"userid"`
+	CoinID       string    `json:"coinid"`
+	PlatformName string    `json:"platformName"`
+	Period       string    `json:"period"`
+	Amount       float64   `json:"amount"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// Budget reports usage of a cap over its current window.
+type Budget struct {
+	Cap         SpendingCap `json:"cap"`
+	Spent       float64     `json:"spent"`
+	Remaining   float64     `json:"remaining"`
+	WindowStart time.Time   `json:"windowStart"`
+}
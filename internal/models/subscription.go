@@ -0,0 +1,102 @@
+package models
+
+import "time"
+
+// Plan periods are calendar periods counted from a subscription's start.
+const (
+	PlanPeriodDaily   = "daily"
+	PlanPeriodWeekly  = "weekly"
+	PlanPeriodMonthly = "monthly"
+	PlanPeriodYearly  = "yearly"
+)
+
+// ChargeDate returns when the n-th charge of a subscription started at
+// start is due (n = 0 is the start itself). Monthly and yearly charges
+// fall on the start's day of month, or the month's last day when it is
+// shorter.
+func ChargeDate(start time.Time, period string, n int) (time.Time, bool) {
+	switch period {
+	case PlanPeriodDaily:
+		return start.AddDate(0, 0, n), true
+	case PlanPeriodWeekly:
+		return start.AddDate(0, 0, 7*n), true
+	case PlanPeriodMonthly:
+		return addMonths(start, n), true
+	case PlanPeriodYearly:
+		return addMonths(start, 12*n), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+func addMonths(t time.Time, n int) time.Time {
+	y, m, d := t.Date()
+	first := time.Date(y, m+time.Month(n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	if last := first.AddDate(0, 1, -1).Day(); d > last {
+		d = last
+	}
+	return first.AddDate(0, 0, d-1)
+}
+
+// SubscriptionPlan is a recurring charge of Amount CoinID for access to
+// DataID on PlatformName.
+type SubscriptionPlan struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	PlatformName string    `json:"platformName"`
+	DataID       string    `json:"dataid"`
+	CoinID       string    `json:"coinid"`
+	Amount       float64   `json:"amount"`
+	Period       string    `json:"period"`
+	Active       bool      `json:"active"` // inactive plans take no new subscribers
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// Subscription statuses. Active and past-due subscriptions are charged;
+// a past-due subscription lapses when its grace period ends unpaid.
+const (
+	SubscriptionActive    = "active"
+	SubscriptionPastDue   = "past_due"
+	SubscriptionPaused    = "paused"
+	SubscriptionCancelled = "cancelled"
+	SubscriptionLapsed    = "lapsed"
+)
+
+type Subscription struct {
+	ID             string     `json:"id"`
+	PlanID         string     `json:"planId"`
+	UserID         string     `json:"userid"`
+	Status         string     `json:"status"`
+	StartedAt      time.Time  `json:"startedAt"`
+	Cycle          int        `json:"cycle"` // charges made so far
+	NextChargeAt   time.Time  `json:"nextChargeAt"`
+	FailedAttempts int        `json:"failedAttempts"`
+	GraceUntil     *time.Time `json:"graceUntil"` // set while past due
+	LastError      string     `json:"lastError"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+}
+
+// Subscription event types.
+const (
+	EventCharged      = "charged"
+	EventChargeFailed = "charge_failed"
+	EventLapsed       = "lapsed"
+	EventPaused       = "paused"
+	EventResumed      = "resumed"
+	EventCancelled    = "cancelled"
+)
+
+// SubscriptionEvent is delivered to the platform's webhook when it has
+// one.
+type SubscriptionEvent struct {
+	ID             int64      `json:"id"`
+	SubscriptionID string     `json:"subscriptionId"`
+	PlatformName   string     `json:"platformName"`
+	UserID         string     `json:"userid"`
+	Type           string     `json:"type"`
+	TransactionID  *string    `json:"transactionId,omitempty"`
+	Detail         string     `json:"detail"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	DeliveredAt    *time.Time `json:"deliveredAt,omitempty"`
+}
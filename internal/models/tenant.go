@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// DefaultTenantID owns all rows that predate multi-tenancy.
+const DefaultTenantID = "default"
+
+// Tenant is an independent brand sharing the deployment. Zero limits
+// inherit the global configuration.
+type Tenant struct {
+	ID                string    `json:"id"`
+	Name              string    `json:"name"`
+	IPRatePerMinute   int       `json:"ipRatePerMinute"`
+	UserRatePerMinute int       `json:"userRatePerMinute"`
+	RetentionDays     int       `json:"retentionDays"`
+	CreatedAt         time.Time `json:"createdAt"`
+}
@@ -13,4 +13,13 @@ type Transaction struct {
 	TransactionTimestamp time.Time `json:"transactionTimestamp"`
 	ExpiryDate time.Time `json:"expiryDate"`
 	PlatformName string `json:"platformName"`
+	TenantID string `json:"tenantId"`
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	Status string `json:"status"`
+	HoldExpiresAt *time.Time `json:"holdExpiresAt,omitempty"` // authorized holds only
+	RenewsID *string `json:"renewsId,omitempty"` // original purchase of a renewal
+	Version int64 `json:"version"` // bumped on every update of the row
+	CreatedAt time.Time `json:"createdAt"` // when the row was inserted; transactionTimestamp is caller supplied
+	Metadata map[string]string `json:"metadata,omitempty"` // caller-defined context, e.g. an order reference
+	Tags []string `json:"tags,omitempty"` // sorted, without duplicates
 }
\ No newline at end of file
@@ -0,0 +1,58 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/devifyX/go-back-transaction-service/internal/ratelimit"
+)
+
+func TestEnforcerIgnoresSpoofedCaller(t *testing.T) {
+	p := &Policy{
+		TrustAdmin:     true,
+		TrustedTenants: []string{"ops"},
+		Rules: []Rule{
+			{Name: "default", PerMinute: 1, Burst: 1},
+			{Name: "partner", Caller: "ops", PerMinute: 600, Burst: 100},
+		},
+	}
+	e := NewEnforcer(p, ratelimit.NewMemory(100))
+	ctx := context.Background()
+
+	// Unauthenticated calls claiming a trusted or partner X-User-ID, or a
+	// fresh one each time, all share the IP's default bucket.
+	if err := e.Check(ctx, Request{Tenant: "ops", Caller: "ops", IP: "1.2.3.4"}); err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		err := e.Check(ctx, Request{Tenant: "ops", Caller: fmt.Sprintf("ops-%d", i), IP: "1.2.3.4"})
+		var ex *ExceededError
+		if !errors.As(err, &ex) || ex.Rule != "default" {
+			t.Fatalf("spoofed call %d: err = %v, want default quota exceeded", i, err)
+		}
+	}
+	if err := e.Check(ctx, Request{Tenant: "ops", Caller: "ops", IP: "1.2.3.4"}); err == nil {
+		t.Fatal("spoofed trusted caller bypassed the quota")
+	}
+
+	// The same tenant authenticated by API key is trusted.
+	for i := 0; i < 3; i++ {
+		if err := e.Check(ctx, Request{Tenant: "ops", APIKey: true, IP: "1.2.3.4"}); err != nil {
+			t.Fatalf("trusted tenant call %d: %v", i, err)
+		}
+	}
+}
+
+func TestLoadPolicyRejectsTrustedCallers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.json")
+	if err := os.WriteFile(path, []byte(`{"trustedCallers":["ops"]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadPolicy(path); err == nil {
+		t.Fatal("expected trustedCallers to be rejected")
+	}
+}
@@ -0,0 +1,111 @@
+package quota
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Rule grants a token bucket to requests matching all of its non-empty
+// selectors. "*" is equivalent to an empty selector.
+type Rule struct {
+	Name      string `json:"name"`
+	Tenant    string `json:"tenant,omitempty"`    // tenant id
+	Platform  string `json:"platform,omitempty"`  // platformName
+	Operation string `json:"operation,omitempty"` // GraphQL root field or gRPC method, e.g. "addTransaction", "CreateTransaction"
+	Caller    string `json:"caller,omitempty"`    // X-User-ID / x-user-id; honored only for authenticated calls
+	PerMinute int    `json:"perMinute"`
+	Burst     int    `json:"burst"`
+}
+
+// Policy is the declarative quota configuration loaded from
+// QUOTA_POLICY_FILE.
+type Policy struct {
+	// DryRun logs requests that would be rejected but lets them through.
+	DryRun bool `json:"dryRun"`
+	// TrustAdmin lets callers presenting the admin token bypass every rule.
+	TrustAdmin bool `json:"trustAdmin"`
+	// TrustedTenants bypass every rule, but only on calls authenticated
+	// with that tenant's API key.
+	TrustedTenants []string `json:"trustedTenants"`
+	Rules          []Rule   `json:"rules"`
+}
+
+// LoadPolicy reads a JSON policy file.
+func LoadPolicy(path string) (*Policy, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var p Policy
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return nil, fmt.Errorf("parse quota policy %s: %w", path, err)
+	}
+	// trustedCallers matched the client-supplied X-User-ID; refuse it
+	// rather than silently dropping the bypass or keeping it spoofable.
+	var legacy struct {
+		TrustedCallers []string `json:"trustedCallers"`
+	}
+	if json.Unmarshal(raw, &legacy) == nil && len(legacy.TrustedCallers) > 0 {
+		return nil, fmt.Errorf("quota policy %s: trustedCallers is no longer supported; use trustAdmin or trustedTenants", path)
+	}
+	for i, r := range p.Rules {
+		if r.Name == "" {
+			return nil, fmt.Errorf("quota rule %d: name is required", i)
+		}
+		if r.PerMinute <= 0 || r.Burst <= 0 {
+			return nil, fmt.Errorf("quota rule %q: perMinute and burst must be positive", r.Name)
+		}
+	}
+	return &p, nil
+}
+
+func matches(selector, value string) bool {
+	return selector == "" || selector == "*" || selector == value
+}
+
+// specificity ranks rules so that a caller selector outweighs all others
+// together, making caller rules act as overrides, followed by tenant,
+// platform and operation.
+func specificity(r Rule) int {
+	n := 0
+	for weight, s := range map[int]string{8: r.Caller, 4: r.Tenant, 2: r.Platform, 1: r.Operation} {
+		if s != "" && s != "*" {
+			n += weight
+		}
+	}
+	return n
+}
+
+// Match returns the most specific rule applying to req. Ties go to the rule
+// listed first.
+func (p *Policy) Match(req Request) (Rule, bool) {
+	best, bestScore := Rule{}, -1
+	for _, r := range p.Rules {
+		if !matches(r.Tenant, req.Tenant) || !matches(r.Platform, req.Platform) ||
+			!matches(r.Operation, req.Operation) || !matches(r.Caller, req.Caller) {
+			continue
+		}
+		if s := specificity(r); s > bestScore {
+			best, bestScore = r, s
+		}
+	}
+	return best, bestScore >= 0
+}
+
+// trusted reports whether req bypasses every rule. Trust comes only from
+// credentials Identify verified, never from the X-User-ID header.
+func (p *Policy) trusted(req Request) bool {
+	if req.Admin && p.TrustAdmin {
+		return true
+	}
+	if !req.APIKey || req.Tenant == "" {
+		return false
+	}
+	for _, t := range p.TrustedTenants {
+		if t == req.Tenant {
+			return true
+		}
+	}
+	return false
+}
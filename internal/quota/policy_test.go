@@ -0,0 +1,53 @@
+package quota
+
+import "testing"
+
+func TestPolicyMatchPrefersMostSpecificRule(t *testing.T) {
+	p := &Policy{Rules: []Rule{
+		{Name: "default", PerMinute: 60, Burst: 10},
+		{Name: "writes", Operation: "addTransaction", PerMinute: 10, Burst: 2},
+		{Name: "shop-writes", Platform: "shop", Operation: "addTransaction", PerMinute: 5, Burst: 1},
+		{Name: "partner", Platform: "shop", Operation: "*", Caller: "partner-1", PerMinute: 600, Burst: 100},
+		{Name: "brand-b", Tenant: "b", PerMinute: 30, Burst: 5},
+	}}
+
+	cases := []struct {
+		req  Request
+		want string
+	}{
+		{Request{Operation: "getTransactions", Platform: "shop"}, "default"},
+		{Request{Operation: "addTransaction", Platform: "other"}, "writes"},
+		{Request{Operation: "addTransaction", Platform: "shop"}, "shop-writes"},
+		{Request{Operation: "CreateTransaction", Platform: "shop", Caller: "partner-1"}, "partner"},
+		{Request{Operation: "addTransaction", Platform: "shop", Caller: "partner-1"}, "partner"},
+		{Request{Tenant: "b", Operation: "addTransaction", Platform: "shop"}, "brand-b"},
+	}
+	for _, c := range cases {
+		got, ok := p.Match(c.req)
+		if !ok || got.Name != c.want {
+			t.Errorf("Match(%+v) = %q, want %q", c.req, got.Name, c.want)
+		}
+	}
+}
+
+func TestPolicyTrusted(t *testing.T) {
+	p := &Policy{TrustAdmin: true, TrustedTenants: []string{"ops"}}
+	cases := []struct {
+		req  Request
+		want bool
+	}{
+		{Request{Admin: true}, true},
+		{Request{Tenant: "ops", APIKey: true}, true},
+		{Request{Tenant: "ops"}, false}, // default tenant, no key presented
+		{Request{Tenant: "other", APIKey: true}, false},
+		{Request{Caller: "ops"}, false},
+	}
+	for _, c := range cases {
+		if got := p.trusted(c.req); got != c.want {
+			t.Errorf("trusted(%+v) = %v, want %v", c.req, got, c.want)
+		}
+	}
+	if (&Policy{}).trusted(Request{Admin: true}) {
+		t.Error("admin trusted without trustAdmin")
+	}
+}
@@ -0,0 +1,94 @@
+package ratelimit
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Memory is a per-process token bucket backend. Quotas are not shared
+// between replicas; use Postgres for that. Entries are kept in LRU order so
+// the max-entries cap can be enforced in constant time.
+type Memory struct {
+	mu         sync.Mutex
+	entries    map[string]*list.Element
+	lru        *list.List // front = most recently used
+	maxEntries int
+}
+
+type entry struct {
+	key  string
+	lim  *rate.Limiter
+	last time.Time
+}
+
+// NewMemory returns an in-memory backend holding at most maxEntries
+// buckets (unbounded when maxEntries <= 0).
+func NewMemory(maxEntries int) *Memory {
+	return &Memory{
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+		maxEntries: maxEntries,
+	}
+}
+
+func (m *Memory) get(key string, l Limit, now time.Time) *rate.Limiter {
+	r := rate.Limit(l.perSecond())
+	if el, ok := m.entries[key]; ok {
+		e := el.Value.(*entry)
+		e.last = now
+		m.lru.MoveToFront(el)
+		if e.lim.Limit() != r {
+			e.lim.SetLimitAt(now, r)
+		}
+		if e.lim.Burst() != l.Burst {
+			e.lim.SetBurstAt(now, l.Burst)
+		}
+		return e.lim
+	}
+	for m.maxEntries > 0 && m.lru.Len() >= m.maxEntries {
+		m.remove(m.lru.Back())
+	}
+	lim := rate.NewLimiter(r, l.Burst)
+	m.entries[key] = m.lru.PushFront(&entry{key: key, lim: lim, last: now})
+	return lim
+}
+
+func (m *Memory) remove(el *list.Element) {
+	m.lru.Remove(el)
+	delete(m.entries, el.Value.(*entry).key)
+}
+
+func (m *Memory) Allow(_ context.Context, key string, l Limit) (Decision, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	lim := m.get(key, l, now)
+	allowed := lim.AllowN(now, 1)
+	return decide(l, lim.TokensAt(now), allowed), nil
+}
+
+func (m *Memory) Evict(_ context.Context, idle time.Duration) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cutoff := time.Now().Add(-idle)
+	n := 0
+	for el := m.lru.Back(); el != nil; el = m.lru.Back() {
+		if el.Value.(*entry).last.After(cutoff) {
+			break
+		}
+		m.remove(el)
+		n++
+	}
+	return n, nil
+}
+
+// Len reports the number of live buckets.
+func (m *Memory) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lru.Len()
+}
@@ -0,0 +1,63 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestMemoryAllowBurstThenReject(t *testing.T) {
+	m := NewMemory(0)
+	l := Limit{PerMinute: 1, Burst: 3}
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if d, _ := m.Allow(ctx, "ip:1.2.3.4", l); !d.Allowed || d.Remaining != 2-i {
+			t.Fatalf("request %d: got %+v", i+1, d)
+		}
+	}
+	d, _ := m.Allow(ctx, "ip:1.2.3.4", l)
+	if d.Allowed {
+		t.Fatalf("expected rejection after burst")
+	}
+	if d.RetryAfter <= 0 || d.RetryAfter > time.Minute {
+		t.Fatalf("unexpected RetryAfter %v", d.RetryAfter)
+	}
+	// Other keys have their own bucket.
+	if d, _ := m.Allow(ctx, "user:abc", l); !d.Allowed {
+		t.Fatalf("independent key rejected")
+	}
+}
+
+func TestMemoryMaxEntriesEvictsLeastRecentlyUsed(t *testing.T) {
+	m := NewMemory(2)
+	l := Limit{PerMinute: 60, Burst: 1}
+	ctx := context.Background()
+
+	m.Allow(ctx, "a", l)
+	m.Allow(ctx, "b", l)
+	m.Allow(ctx, "a", l) // a is now most recent
+	m.Allow(ctx, "c", l) // evicts b
+
+	if m.Len() != 2 {
+		t.Fatalf("len = %d, want 2", m.Len())
+	}
+	if _, ok := m.entries["b"]; ok {
+		t.Fatalf("expected b to be evicted")
+	}
+}
+
+func TestMemoryEvictIdle(t *testing.T) {
+	m := NewMemory(0)
+	ctx := context.Background()
+	for i := 0; i < 5; i++ {
+		m.Allow(ctx, fmt.Sprintf("ip:%d", i), Limit{PerMinute: 60, Burst: 1})
+	}
+	if n, _ := m.Evict(ctx, time.Hour); n != 0 {
+		t.Fatalf("evicted %d fresh entries", n)
+	}
+	if n, _ := m.Evict(ctx, 0); n != 5 || m.Len() != 0 {
+		t.Fatalf("evicted %d, len %d", n, m.Len())
+	}
+}
@@ -0,0 +1,65 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// Postgres keeps token buckets in the rate_limit_buckets table so every
+// replica (and both the HTTP and gRPC servers) draw from the same quota.
+// Time is taken from the database clock to avoid skew between hosts.
+type Postgres struct {
+	pool *db.Pool
+}
+
+func NewPostgres(pool *db.Pool) *Postgres {
+	return &Postgres{pool: pool}
+}
+
+func (p *Postgres) Allow(ctx context.Context, key string, l Limit) (Decision, error) {
+	var d Decision
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		// New buckets start full.
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO rate_limit_buckets (key, tokens, updated_at)
+			VALUES ($1, $2, now())
+			ON CONFLICT (key) DO NOTHING`, key, float64(l.Burst)); err != nil {
+			return err
+		}
+
+		var tokens, elapsed float64
+		if err := tx.QueryRow(ctx, `
+			SELECT tokens, GREATEST(EXTRACT(EPOCH FROM (now() - updated_at)), 0)::float8
+			FROM rate_limit_buckets
+			WHERE key = $1
+			FOR UPDATE`, key).Scan(&tokens, &elapsed); err != nil {
+			return err
+		}
+
+		tokens = math.Min(float64(l.Burst), tokens+elapsed*l.perSecond())
+		allowed := tokens >= 1
+		if allowed {
+			tokens--
+		}
+		d = decide(l, tokens, allowed)
+
+		_, err := tx.Exec(ctx, `
+			UPDATE rate_limit_buckets SET tokens = $2, updated_at = now()
+			WHERE key = $1`, key, tokens)
+		return err
+	})
+	return d, err
+}
+
+func (p *Postgres) Evict(ctx context.Context, idle time.Duration) (int, error) {
+	tag, err := p.pool.Exec(ctx,
+		"DELETE FROM rate_limit_buckets WHERE updated_at < now() - $1::interval", idle)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
@@ -0,0 +1,127 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+)
+
+// testPool connects to the disposable database in TEST_DATABASE_URL.
+func testPool(t *testing.T) *db.Pool {
+	t.Helper()
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+	ctx := context.Background()
+	pool, err := db.NewPool(ctx, url)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(pool.Close)
+	if err := pool.Migrate(ctx); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return pool
+}
+
+// backdate moves a bucket's last update into the past, as if it had been
+// idle for d.
+func backdate(t *testing.T, pool *db.Pool, key string, d time.Duration) {
+	t.Helper()
+	if _, err := pool.Exec(context.Background(),
+		"UPDATE rate_limit_buckets SET updated_at = updated_at - $2::interval WHERE key = $1", key, d); err != nil {
+		t.Fatalf("backdate %s: %v", key, err)
+	}
+}
+
+func TestPostgresDecisions(t *testing.T) {
+	pool := testPool(t)
+	rl := NewPostgres(pool)
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		limit     Limit
+		allowed   []bool
+		remaining []int
+		retry     bool // the last request must carry a RetryAfter
+	}{
+		{"burst then reject", Limit{PerMinute: 1, Burst: 3}, []bool{true, true, true, false}, []int{2, 1, 0, 0}, true},
+		{"no refill", Limit{PerMinute: 0, Burst: 1}, []bool{true, false}, []int{0, 0}, false},
+		{"empty bucket", Limit{PerMinute: 60, Burst: 0}, []bool{false}, []int{0}, true},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := fmt.Sprintf("test:%d:%d", time.Now().UnixNano(), i)
+			var d Decision
+			for n, want := range tt.allowed {
+				var err error
+				if d, err = rl.Allow(ctx, key, tt.limit); err != nil {
+					t.Fatalf("request %d: %v", n+1, err)
+				}
+				if d.Allowed != want || d.Remaining != tt.remaining[n] || d.Limit != tt.limit.Burst {
+					t.Fatalf("request %d: got %+v, want allowed=%v remaining=%d", n+1, d, want, tt.remaining[n])
+				}
+			}
+			if tt.retry && (d.RetryAfter <= 0 || d.RetryAfter > time.Minute) {
+				t.Fatalf("RetryAfter = %v", d.RetryAfter)
+			}
+			if !tt.retry && d.RetryAfter != 0 {
+				t.Fatalf("RetryAfter = %v, want 0", d.RetryAfter)
+			}
+		})
+	}
+}
+
+func TestPostgresRefillsAndEvicts(t *testing.T) {
+	pool := testPool(t)
+	rl := NewPostgres(pool)
+	ctx := context.Background()
+	l := Limit{PerMinute: 60, Burst: 2}
+	key := fmt.Sprintf("test:%d", time.Now().UnixNano())
+
+	for i := 0; i < 2; i++ {
+		if d, err := rl.Allow(ctx, key, l); err != nil || !d.Allowed {
+			t.Fatalf("request %d: %+v, %v", i+1, d, err)
+		}
+	}
+	if d, err := rl.Allow(ctx, key, l); err != nil || d.Allowed {
+		t.Fatalf("drained bucket: %+v, %v", d, err)
+	}
+
+	// A minute idle refills far more than the burst; the bucket is capped.
+	backdate(t, pool, key, time.Minute)
+	d, err := rl.Allow(ctx, key, l)
+	if err != nil || !d.Allowed || d.Remaining != 1 {
+		t.Fatalf("after refill: %+v, %v", d, err)
+	}
+
+	if _, err := rl.Evict(ctx, time.Hour); err != nil {
+		t.Fatalf("evict: %v", err)
+	}
+	if !exists(t, pool, key) {
+		t.Fatalf("fresh bucket %s evicted", key)
+	}
+	backdate(t, pool, key, 2*time.Hour)
+	if n, err := rl.Evict(ctx, time.Hour); err != nil || n < 1 {
+		t.Fatalf("evict idle: %d, %v", n, err)
+	}
+	if exists(t, pool, key) {
+		t.Fatalf("idle bucket %s not evicted", key)
+	}
+}
+
+func exists(t *testing.T, pool *db.Pool, key string) bool {
+	t.Helper()
+	var ok bool
+	if err := pool.QueryRow(context.Background(),
+		"SELECT EXISTS (SELECT 1 FROM rate_limit_buckets WHERE key = $1)", key).Scan(&ok); err != nil {
+		t.Fatalf("lookup %s: %v", key, err)
+	}
+	return ok
+}
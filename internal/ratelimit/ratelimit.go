@@ -0,0 +1,113 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+)
+
+// Limit describes a token bucket: PerMinute tokens are refilled every minute
+// up to a maximum of Burst.
+type Limit struct {
+	PerMinute int
+	Burst     int
+}
+
+// perSecond returns the refill rate in tokens per second.
+func (l Limit) perSecond() float64 {
+	return float64(l.PerMinute) / 60.0
+}
+
+// Decision is the outcome of a single Allow call, carrying enough state to
+// populate RateLimit-* response headers.
+type Decision struct {
+	Allowed    bool
+	Limit      int           // bucket size
+	Remaining  int           // whole tokens left after this request
+	Reset      time.Duration // until the bucket is full again
+	RetryAfter time.Duration // until the next token, zero when allowed
+}
+
+// decide builds a Decision from the token count left in a bucket.
+func decide(l Limit, tokens float64, allowed bool) Decision {
+	d := Decision{Allowed: allowed, Limit: l.Burst, Remaining: int(math.Max(0, math.Floor(tokens)))}
+	rate := l.perSecond()
+	if rate <= 0 {
+		return d
+	}
+	d.Reset = seconds((float64(l.Burst) - tokens) / rate)
+	if !allowed {
+		d.RetryAfter = seconds((1 - tokens) / rate)
+	}
+	return d
+}
+
+func seconds(s float64) time.Duration {
+	if s <= 0 {
+		return 0
+	}
+	return time.Duration(s * float64(time.Second))
+}
+
+// Backend decides whether a request identified by key may proceed under l.
+// Keys are namespaced by the caller (e.g. "ip:10.0.0.1", "user:abc") so the
+// HTTP and gRPC front ends share the same buckets.
+type Backend interface {
+	Allow(ctx context.Context, key string, l Limit) (Decision, error)
+	// Evict drops buckets that have not been used for idle and reports how
+	// many were removed.
+	Evict(ctx context.Context, idle time.Duration) (int, error)
+}
+
+// New returns the backend named by kind ("memory" or "postgres").
+// maxEntries caps the in-memory backend; Postgres relies on the janitor.
+func New(kind string, pool *db.Pool, maxEntries int) (Backend, error) {
+	switch kind {
+	case "", "memory":
+		return NewMemory(maxEntries), nil
+	case "postgres":
+		return NewPostgres(pool), nil
+	default:
+		return nil, fmt.Errorf("unknown rate limit backend %q", kind)
+	}
+}
+
+// RunJanitor evicts idle buckets every ttl/2 until ctx is done.
+func RunJanitor(ctx context.Context, b Backend, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	t := time.NewTicker(ttl / 2)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if _, err := b.Evict(ctx, ttl); err != nil {
+				log.Printf("rate limit janitor: %v", err)
+			}
+		}
+	}
+}
+
+// IPKey and UserKey build the bucket keys used by both transports. Buckets
+// are per tenant, since tenants may have their own limits.
+func IPKey(tenant, ip string) string    { return "ip:" + tenant + ":" + ip }
+func UserKey(tenant, uid string) string { return "user:" + tenant + ":" + uid }
+
+// AuthKey is the bucket charged per IP before the caller's tenant is known.
+func AuthKey(ip string) string { return "auth:" + ip }
+
+// Override returns l with PerMinute replaced by perMinute when positive,
+// for per-tenant limits.
+func (l Limit) Override(perMinute int) Limit {
+	if perMinute > 0 {
+		l.PerMinute = perMinute
+	}
+	return l
+}
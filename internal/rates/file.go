@@ -0,0 +1,69 @@
+// Package rates reads exchange rate files for import.
+package rates
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+)
+
+// header is the expected first line of a rates file.
+var header = []string{"base", "quote", "rate", "effective_at"}
+
+// ParseCSV reads rates in the form
+//
+//	base,quote,rate,effective_at
+//	BTC,USD,64000.5,2024-05-01T00:00:00Z
+//
+// meaning 1 base is worth rate quote from effective_at (RFC3339) on.
+func ParseCSV(r io.Reader) ([]models.ExchangeRate, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = len(header)
+	cr.TrimLeadingSpace = true
+	cr.Comment = '#'
+
+	first, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("rates file is empty")
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i, h := range header {
+		if !strings.EqualFold(strings.TrimSpace(first[i]), h) {
+			return nil, fmt.Errorf("rates file header must be %q", strings.Join(header, ","))
+		}
+	}
+
+	var out []models.ExchangeRate
+	for {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		rate, err := strconv.ParseFloat(strings.TrimSpace(rec[2]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid rate %q", line, rec[2])
+		}
+		at, err := time.Parse(time.RFC3339, strings.TrimSpace(rec[3]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid effective_at %q", line, rec[3])
+		}
+		out = append(out, models.ExchangeRate{
+			BaseCoin:    strings.TrimSpace(rec[0]),
+			QuoteCoin:   strings.TrimSpace(rec[1]),
+			Rate:        rate,
+			EffectiveAt: at,
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package rates
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseCSV(t *testing.T) {
+	in := `base,quote,rate,effective_at
+# comments are skipped
+BTC, USD, 64000.5, 2024-05-01T00:00:00Z
+GEM,GOLD,0.01,2024-05-02T12:00:00+02:00
+`
+	got, err := ParseCSV(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("parsed %d rates, want 2", len(got))
+	}
+	if got[0].BaseCoin != "BTC" || got[0].QuoteCoin != "USD" || got[0].Rate != 64000.5 {
+		t.Errorf("unexpected first rate %+v", got[0])
+	}
+	if want := time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC); !got[1].EffectiveAt.Equal(want) {
+		t.Errorf("effective_at = %v, want %v", got[1].EffectiveAt, want)
+	}
+
+	for name, bad := range map[string]string{
+		"empty":       "",
+		"header":      "from,to,rate,at\n",
+		"rate":        "base,quote,rate,effective_at\nBTC,USD,abc,2024-05-01T00:00:00Z\n",
+		"time":        "base,quote,rate,effective_at\nBTC,USD,1,yesterday\n",
+		"field count": "base,quote,rate,effective_at\nBTC,USD,1\n",
+	} {
+		if _, err := ParseCSV(strings.NewReader(bad)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
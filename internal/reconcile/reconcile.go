@@ -0,0 +1,281 @@
+// Package reconcile checks platform settlement files against stored
+// transactions and records the differences for follow-up.
+package reconcile
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+)
+
+// Line is one entry of a settlement file.
+type Line struct {
+	Row       int // line number in the file
+	DataID    string
+	UserID    string
+	CoinUsed  float64
+	Timestamp time.Time
+}
+
+// columns are the required header fields of a settlement file, in any order.
+var columns = []string{"dataid", "userid", "coinused", "timestamp"}
+
+// ParseCSV reads a settlement file in the form
+//
+//	dataid,userid,coinused,timestamp
+//	movie-42,u1,3.5,2024-05-01T12:00:00Z
+//
+// Columns are matched by header name; extra columns are ignored.
+func ParseCSV(r io.Reader) ([]Line, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	cr.Comment = '#'
+
+	first, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("settlement file is empty")
+	}
+	if err != nil {
+		return nil, err
+	}
+	idx := make(map[string]int, len(columns))
+	for i, h := range first {
+		idx[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	for _, c := range columns {
+		if _, ok := idx[c]; !ok {
+			return nil, fmt.Errorf("settlement file header must contain %q", strings.Join(columns, ","))
+		}
+	}
+	cr.FieldsPerRecord = len(first)
+
+	var out []Line
+	for {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		row, _ := cr.FieldPos(0)
+		field := func(name string) string { return strings.TrimSpace(rec[idx[name]]) }
+		l := Line{Row: row, DataID: field("dataid"), UserID: field("userid")}
+		if l.DataID == "" || l.UserID == "" {
+			return nil, fmt.Errorf("line %d: dataid and userid are required", row)
+		}
+		if l.CoinUsed, err = strconv.ParseFloat(field("coinused"), 64); err != nil {
+			return nil, fmt.Errorf("line %d: invalid coinused %q", row, field("coinused"))
+		}
+		if l.Timestamp, err = time.Parse(time.RFC3339, field("timestamp")); err != nil {
+			return nil, fmt.Errorf("line %d: invalid timestamp %q", row, field("timestamp"))
+		}
+		out = append(out, l)
+	}
+}
+
+// Tolerance decides when a settlement line and a transaction match. Lines
+// pair with transactions of the same dataid and userid whose timestamps
+// differ by at most Time; a pair whose amounts differ by more than Amount
+// is reported as an amount mismatch.
+type Tolerance struct {
+	Amount float64
+	Time   time.Duration
+}
+
+// epsilon absorbs float noise so a zero amount tolerance still matches
+// amounts that went through decimal text.
+const epsilon = 1e-9
+
+func (t Tolerance) amountOK(a, b float64) bool {
+	return math.Abs(a-b) <= t.Amount+epsilon
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// Match pairs lines with transactions and classifies every line and every
+// unpaired transaction. Each transaction pairs with at most one line;
+// candidates within the amount tolerance are preferred, then the closest
+// in time. Matched items are created resolved, everything else open.
+func Match(lines []Line, ours []models.Transaction, tol Tolerance) []models.ReconciliationItem {
+	key := func(dataID, userID string) string { return dataID + "\x00" + userID }
+	byKey := make(map[string][]int)
+	for i, t := range ours {
+		k := key(t.DataID, t.UserID)
+		byKey[k] = append(byKey[k], i)
+	}
+	used := make([]bool, len(ours))
+
+	items := make([]models.ReconciliationItem, 0, len(lines))
+	for _, l := range lines {
+		best, bestOK := -1, false
+		var bestDelta time.Duration
+		for _, i := range byKey[key(l.DataID, l.UserID)] {
+			if used[i] {
+				continue
+			}
+			delta := absDuration(ours[i].TransactionTimestamp.Sub(l.Timestamp))
+			if delta > tol.Time {
+				continue
+			}
+			ok := tol.amountOK(ours[i].CoinUsed, l.CoinUsed)
+			if best < 0 || ok && !bestOK || ok == bestOK && delta < bestDelta {
+				best, bestOK, bestDelta = i, ok, delta
+			}
+		}
+
+		item := models.ReconciliationItem{
+			Kind:           models.ReconMissingOurs,
+			Status:         models.ReconOpen,
+			DataID:         l.DataID,
+			UserID:         l.UserID,
+			TheirAmount:    &l.CoinUsed,
+			TheirTimestamp: &l.Timestamp,
+		}
+		if best >= 0 {
+			used[best] = true
+			t := &ours[best]
+			item.OurAmount, item.OurTimestamp, item.TransactionID = &t.CoinUsed, &t.TransactionTimestamp, &t.ID
+			item.Kind = models.ReconAmountMismatch
+			if bestOK {
+				item.Kind, item.Status = models.ReconMatched, models.ReconResolved
+			}
+		}
+		items = append(items, item)
+	}
+
+	var rest []int
+	for i := range ours {
+		if !used[i] {
+			rest = append(rest, i)
+		}
+	}
+	sort.SliceStable(rest, func(a, b int) bool {
+		return ours[rest[a]].TransactionTimestamp.Before(ours[rest[b]].TransactionTimestamp)
+	})
+	for _, i := range rest {
+		t := &ours[i]
+		items = append(items, models.ReconciliationItem{
+			Kind:          models.ReconMissingTheirs,
+			Status:        models.ReconOpen,
+			DataID:        t.DataID,
+			UserID:        t.UserID,
+			OurAmount:     &t.CoinUsed,
+			OurTimestamp:  &t.TransactionTimestamp,
+			TransactionID: &t.ID,
+		})
+	}
+	return items
+}
+
+// ErrInvalidRequest wraps validation failures of Reconciler.Run.
+var ErrInvalidRequest = errors.New("invalid reconciliation request")
+
+// Request describes one reconciliation run.
+type Request struct {
+	Platform  string
+	FileName  string
+	Lines     []Line
+	Tolerance Tolerance
+	// From and To bound the transactions checked for missing_theirs. They
+	// default to the first and last line timestamp widened by the time
+	// tolerance.
+	From, To  *time.Time
+	CreatedBy string
+}
+
+// Reconciler runs and records reconciliations for the tenant in ctx.
+type Reconciler struct {
+	Repo     *db.ReconciliationRepo
+	Defaults Tolerance // used by callers that do not choose tolerances
+}
+
+func NewReconciler(repo *db.ReconciliationRepo, defaults Tolerance) *Reconciler {
+	return &Reconciler{Repo: repo, Defaults: defaults}
+}
+
+// Run matches req.Lines against the platform's transactions in the
+// period and stores the run with all of its items.
+func (r *Reconciler) Run(ctx context.Context, req Request) (*models.ReconciliationRun, error) {
+	if req.Platform == "" {
+		return nil, fmt.Errorf("%w: platform is required", ErrInvalidRequest)
+	}
+	if req.Tolerance.Amount < 0 || req.Tolerance.Time < 0 {
+		return nil, fmt.Errorf("%w: tolerances must not be negative", ErrInvalidRequest)
+	}
+	from, to, err := period(req)
+	if err != nil {
+		return nil, err
+	}
+	ours, err := r.Repo.Transactions(ctx, req.Platform, from, to)
+	if err != nil {
+		return nil, err
+	}
+	items := Match(req.Lines, ours, req.Tolerance)
+
+	run := models.ReconciliationRun{
+		PlatformName:    req.Platform,
+		FileName:        req.FileName,
+		From:            from,
+		To:              to,
+		AmountTolerance: req.Tolerance.Amount,
+		TimeTolerance:   req.Tolerance.Time,
+		CreatedBy:       req.CreatedBy,
+	}
+	for _, it := range items {
+		switch it.Kind {
+		case models.ReconMatched:
+			run.Matched++
+		case models.ReconMissingOurs:
+			run.MissingOurs++
+		case models.ReconMissingTheirs:
+			run.MissingTheirs++
+		case models.ReconAmountMismatch:
+			run.AmountMismatches++
+		}
+	}
+	return r.Repo.Save(ctx, run, items)
+}
+
+func period(req Request) (from, to time.Time, err error) {
+	if req.From == nil || req.To == nil {
+		if len(req.Lines) == 0 {
+			return from, to, fmt.Errorf("%w: a period is required when the settlement file has no lines", ErrInvalidRequest)
+		}
+		from, to = req.Lines[0].Timestamp, req.Lines[0].Timestamp
+		for _, l := range req.Lines[1:] {
+			if l.Timestamp.Before(from) {
+				from = l.Timestamp
+			}
+			if l.Timestamp.After(to) {
+				to = l.Timestamp
+			}
+		}
+		from, to = from.Add(-req.Tolerance.Time), to.Add(req.Tolerance.Time)
+	}
+	if req.From != nil {
+		from = *req.From
+	}
+	if req.To != nil {
+		to = *req.To
+	}
+	if to.Before(from) {
+		return from, to, fmt.Errorf("%w: period end is before its start", ErrInvalidRequest)
+	}
+	return from, to, nil
+}
@@ -0,0 +1,125 @@
+package reconcile
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+)
+
+func TestParseCSV(t *testing.T) {
+	in := `userid,dataid,timestamp,coinused,note
+# comments are skipped
+u1, movie-1, 2024-05-01T12:00:00Z, 3.5, first
+u2,movie-2,2024-05-01T14:00:00+02:00,1,
+`
+	got, err := ParseCSV(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("parsed %d lines, want 2", len(got))
+	}
+	if got[0].DataID != "movie-1" || got[0].UserID != "u1" || got[0].CoinUsed != 3.5 || got[0].Row != 3 {
+		t.Errorf("unexpected first line %+v", got[0])
+	}
+	if want := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC); !got[1].Timestamp.Equal(want) {
+		t.Errorf("timestamp = %v, want %v", got[1].Timestamp, want)
+	}
+
+	for name, bad := range map[string]string{
+		"empty":       "",
+		"header":      "dataid,userid,amount,timestamp\n",
+		"amount":      "dataid,userid,coinused,timestamp\nd,u,abc,2024-05-01T00:00:00Z\n",
+		"time":        "dataid,userid,coinused,timestamp\nd,u,1,yesterday\n",
+		"ids":         "dataid,userid,coinused,timestamp\n,u,1,2024-05-01T00:00:00Z\n",
+		"field count": "dataid,userid,coinused,timestamp\nd,u,1\n",
+	} {
+		if _, err := ParseCSV(strings.NewReader(bad)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	tx := func(id, data string, amount float64, offset time.Duration) models.Transaction {
+		return models.Transaction{ID: id, DataID: data, UserID: "u", CoinUsed: amount, TransactionTimestamp: at.Add(offset)}
+	}
+	line := func(data string, amount float64, offset time.Duration) Line {
+		return Line{DataID: data, UserID: "u", CoinUsed: amount, Timestamp: at.Add(offset)}
+	}
+	ours := []models.Transaction{
+		tx("t1", "a", 1, 0),
+		tx("t2", "b", 2, 30*time.Second),
+		tx("t3", "c", 3, 0),
+		tx("t4", "d", 4, 0),
+		// two purchases of the same item: the amount decides the pairing
+		tx("t5", "e", 5, 0),
+		tx("t6", "e", 6, time.Minute),
+	}
+	lines := []Line{
+		line("a", 1.0000001, 0), // matched within amount tolerance
+		line("b", 2, 0),         // matched within time tolerance
+		line("c", 3.5, 0),       // amount mismatch
+		line("d", 4, time.Hour), // too far apart: missing on both sides
+		line("e", 6, 0),         // pairs with t6 despite t5 being closer
+		line("x", 1, 0),         // unknown to us
+	}
+	items := Match(lines, ours, Tolerance{Amount: 0.001, Time: 2 * time.Minute})
+
+	type result struct{ kind, tx string }
+	var got []result
+	for _, it := range items {
+		r := result{kind: it.Kind}
+		if it.TransactionID != nil {
+			r.tx = *it.TransactionID
+		}
+		got = append(got, r)
+		if (it.Kind == models.ReconMatched) != (it.Status == models.ReconResolved) {
+			t.Errorf("item %+v: status %s does not fit kind", r, it.Status)
+		}
+	}
+	want := []result{
+		{models.ReconMatched, "t1"},
+		{models.ReconMatched, "t2"},
+		{models.ReconAmountMismatch, "t3"},
+		{models.ReconMissingOurs, ""},
+		{models.ReconMatched, "t6"},
+		{models.ReconMissingOurs, ""},
+		{models.ReconMissingTheirs, "t4"},
+		{models.ReconMissingTheirs, "t5"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d items %v, want %v", len(got), got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("item %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestPeriod(t *testing.T) {
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	req := Request{
+		Lines:     []Line{{Timestamp: at.Add(time.Hour)}, {Timestamp: at}},
+		Tolerance: Tolerance{Time: time.Minute},
+	}
+	from, to, err := period(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !from.Equal(at.Add(-time.Minute)) || !to.Equal(at.Add(time.Hour+time.Minute)) {
+		t.Errorf("period = %v..%v", from, to)
+	}
+
+	if _, _, err := period(Request{}); err == nil {
+		t.Error("expected an error without lines or period")
+	}
+	end := at.Add(-time.Hour)
+	if _, _, err := period(Request{From: &at, To: &end}); err == nil {
+		t.Error("expected an error for a reversed period")
+	}
+}
@@ -0,0 +1,161 @@
+// Package rules implements built-in business rules as a db.Hook, configured
+// from a JSON file (RULES_FILE):
+//
+//	{"rules": [
+//	  {"name": "bans", "type": "banned_users", "users": ["mallory"]},
+//	  {"name": "big", "type": "max_amount", "platform": "web", "max": 500},
+//	  {"name": "per-item", "type": "max_coins_per_dataid", "max": 1000}
+//	]}
+//
+// Every rule whose tenant and platform selectors match a transaction is
+// applied; an empty or "*" selector matches everything.
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// Rule types.
+const (
+	// BannedUsers rejects transactions of the listed users.
+	BannedUsers = "banned_users"
+	// MaxAmount rejects transactions spending more than Max.
+	MaxAmount = "max_amount"
+	// MaxCoinsPerDataID rejects transactions that take a user's total
+	// spend on one dataid above Max. Voided and expired holds do not count.
+	MaxCoinsPerDataID = "max_coins_per_dataid"
+)
+
+type Rule struct {
+	Name     string   `json:"name"`
+	Type     string   `json:"type"`
+	Tenant   string   `json:"tenant,omitempty"`
+	Platform string   `json:"platform,omitempty"`
+	Users    []string `json:"users,omitempty"` // banned_users
+	Max      float64  `json:"max,omitempty"`   // max_amount, max_coins_per_dataid
+}
+
+// Config is the rule file.
+type Config struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Parse reads and validates a rule file.
+func Parse(raw []byte) (*Config, error) {
+	var c Config
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, err
+	}
+	for i, r := range c.Rules {
+		if r.Name == "" {
+			return nil, fmt.Errorf("rule %d: name is required", i)
+		}
+		switch r.Type {
+		case BannedUsers:
+			if len(r.Users) == 0 {
+				return nil, fmt.Errorf("rule %q: users is required", r.Name)
+			}
+		case MaxAmount, MaxCoinsPerDataID:
+			if r.Max < 0 {
+				return nil, fmt.Errorf("rule %q: max must be non-negative", r.Name)
+			}
+		default:
+			return nil, fmt.Errorf("rule %q: unknown type %q", r.Name, r.Type)
+		}
+	}
+	return &c, nil
+}
+
+// Engine applies a Config. It implements db.Hook.
+type Engine struct {
+	rules []Rule
+}
+
+func NewEngine(c *Config) *Engine {
+	return &Engine{rules: c.Rules}
+}
+
+// Load builds an Engine from the rule file at path, or returns nil when
+// path is empty.
+func Load(path string) (*Engine, error) {
+	if path == "" {
+		return nil, nil
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c, err := Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("parse rules %s: %w", path, err)
+	}
+	return NewEngine(c), nil
+}
+
+func matches(selector, value string) bool {
+	return selector == "" || selector == "*" || selector == value
+}
+
+func (e *Engine) matching(t models.Transaction, types ...string) []Rule {
+	var out []Rule
+	for _, r := range e.rules {
+		if slices.Contains(types, r.Type) && matches(r.Tenant, t.TenantID) && matches(r.Platform, t.PlatformName) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// PreValidate applies the rules that need no database access.
+func (e *Engine) PreValidate(ctx context.Context, t models.Transaction) error {
+	for _, r := range e.matching(t, BannedUsers, MaxAmount) {
+		switch r.Type {
+		case BannedUsers:
+			if slices.Contains(r.Users, t.UserID) {
+				return &db.RuleError{Rule: r.Name, Reason: fmt.Sprintf("user %q is banned", t.UserID)}
+			}
+		case MaxAmount:
+			if t.CoinUsed > r.Max {
+				return &db.RuleError{Rule: r.Name, Reason: fmt.Sprintf("amount %g is above the maximum of %g", t.CoinUsed, r.Max)}
+			}
+		}
+	}
+	return nil
+}
+
+// PreCommit applies the rules that depend on stored transactions. t is
+// already stored and the user is locked by the cap check, so concurrent
+// spends of the same user cannot both slip under a limit.
+func (e *Engine) PreCommit(ctx context.Context, tx pgx.Tx, t models.Transaction) error {
+	for _, r := range e.matching(t, MaxCoinsPerDataID) {
+		var spent float64
+		if err := tx.QueryRow(ctx, `
+			SELECT COALESCE(SUM(coinused), 0)
+			FROM transactions
+			WHERE tenant_id = $1
+			  AND userid = $2
+			  AND dataid = $3
+			  AND platformName = $4
+			  AND deleted_at IS NULL
+			  AND status <> 'voided'
+			  AND NOT (status = 'authorized' AND hold_expires_at IS NOT NULL AND hold_expires_at <= now())`,
+			t.TenantID, t.UserID, t.DataID, t.PlatformName).Scan(&spent); err != nil {
+			return err
+		}
+		if spent > r.Max {
+			return &db.RuleError{Rule: r.Name, Reason: fmt.Sprintf("spend of %g on dataid %q would exceed the maximum of %g", spent, t.DataID, r.Max)}
+		}
+	}
+	return nil
+}
+
+// PostCommit does nothing; the built-in rules only reject.
+func (e *Engine) PostCommit(ctx context.Context, t models.Transaction) {}
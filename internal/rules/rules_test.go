@@ -0,0 +1,58 @@
+package rules
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+)
+
+var _ db.Hook = (*Engine)(nil)
+
+func TestParse(t *testing.T) {
+	bad := []string{
+		`{"rules": [{"type": "max_amount", "max": 1}]}`,
+		`{"rules": [{"name": "x", "type": "max_spend"}]}`,
+		`{"rules": [{"name": "x", "type": "banned_users"}]}`,
+		`{"rules": [{"name": "x", "type": "max_amount", "max": -1}]}`,
+	}
+	for _, raw := range bad {
+		if _, err := Parse([]byte(raw)); err == nil {
+			t.Errorf("Parse(%s) succeeded", raw)
+		}
+	}
+}
+
+func TestPreValidate(t *testing.T) {
+	c, err := Parse([]byte(`{"rules": [
+		{"name": "bans", "type": "banned_users", "users": ["mallory"]},
+		{"name": "web-max", "type": "max_amount", "platform": "web", "max": 10},
+		{"name": "acme-max", "type": "max_amount", "tenant": "acme", "max": 5}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := NewEngine(c)
+	cases := []struct {
+		t    models.Transaction
+		rule string
+	}{
+		{models.Transaction{UserID: "alice", PlatformName: "web", CoinUsed: 10, TenantID: "default"}, ""},
+		{models.Transaction{UserID: "mallory", PlatformName: "app", CoinUsed: 1, TenantID: "default"}, "bans"},
+		{models.Transaction{UserID: "alice", PlatformName: "web", CoinUsed: 11, TenantID: "default"}, "web-max"},
+		{models.Transaction{UserID: "alice", PlatformName: "app", CoinUsed: 11, TenantID: "default"}, ""},
+		{models.Transaction{UserID: "alice", PlatformName: "app", CoinUsed: 6, TenantID: "acme"}, "acme-max"},
+	}
+	for _, c := range cases {
+		err := e.PreValidate(context.Background(), c.t)
+		var ruleErr *db.RuleError
+		switch {
+		case c.rule == "" && err != nil:
+			t.Errorf("%+v rejected: %v", c.t, err)
+		case c.rule != "" && (!errors.As(err, &ruleErr) || ruleErr.Rule != c.rule):
+			t.Errorf("%+v: err=%v, want rule %q", c.t, err, c.rule)
+		}
+	}
+}
@@ -3,13 +3,22 @@ package server
 import (
 	"context"
 	"log"
+	"net"
 	"net/http"
 	"time"
 
+	"github.com/devifyX/go-back-transaction-service/internal/archive"
+	"github.com/devifyX/go-back-transaction-service/internal/audit"
+	"github.com/devifyX/go-back-transaction-service/internal/clientip"
 	"github.com/devifyX/go-back-transaction-service/internal/config"
 	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/fraud"
 	"github.com/devifyX/go-back-transaction-service/internal/graph"
 	"github.com/devifyX/go-back-transaction-service/internal/middleware"
+	"github.com/devifyX/go-back-transaction-service/internal/quota"
+	"github.com/devifyX/go-back-transaction-service/internal/ratelimit"
+	"github.com/devifyX/go-back-transaction-service/internal/reconcile"
+	"github.com/devifyX/go-back-transaction-service/internal/rules"
 	"github.com/graphql-go/handler"
 )
 
@@ -28,8 +37,63 @@ func Run() error {
 	}
 	defer pool.Close()
 
+	if err := pool.Migrate(ctx); err != nil {
+		return err
+	}
+	engine, err := rules.Load(cfg.RulesFile)
+	if err != nil {
+		return err
+	}
+	if engine != nil {
+		pool.AddHook(engine)
+	}
+	screener, err := fraud.Load(cfg.FraudRulesFile)
+	if err != nil {
+		return err
+	}
+	if screener != nil {
+		pool.SetScreener(screener)
+	}
+
+	backend, err := ratelimit.New(cfg.RateLimitBackend, pool, cfg.RateLimitMaxKeys)
+	if err != nil {
+		return err
+	}
+	janitorCtx, stopJanitor := context.WithCancel(context.Background())
+	defer stopJanitor()
+	go ratelimit.RunJanitor(janitorCtx, backend, cfg.RateLimitIdleTTL)
+
+	quotas, err := quota.Load(cfg.QuotaPolicyFile, backend)
+	if err != nil {
+		return err
+	}
+
 	repo := db.NewTransactionRepo(pool)
-	resolver := &graph.Resolver{Repo: repo}
+	repo.HoldTimeout = cfg.HoldTimeout
+	tenants := db.NewTenantRepo(pool)
+	reconciler := reconcile.NewReconciler(db.NewReconciliationRepo(pool), reconcile.Tolerance{
+		Amount: cfg.ReconcileAmountTolerance,
+		Time:   cfg.ReconcileTimeTolerance,
+	})
+	resolver := &graph.Resolver{
+		Repo:          repo,
+		Caps:          db.NewCapRepo(pool),
+		Coins:         db.NewCoinRepo(pool),
+		Platforms:     db.NewPlatformRepo(pool),
+		Rates:         db.NewRateRepo(pool),
+		Ledger:        db.NewLedgerRepo(pool),
+		Reconcile:     reconciler,
+		Subscriptions: db.NewSubscriptionRepo(pool),
+		Scheduled:     db.NewScheduledRepo(pool),
+		FraudReviews:  db.NewFraudReviewRepo(pool),
+		Tenants:       tenants,
+		Quota:         quotas,
+		Audit:         &audit.Recorder{Log: audit.NewLog(pool), Reads: cfg.AuditReads, UserKey: []byte(cfg.AuditUserKey)},
+	}
+	if cfg.ArchiveDir != "" {
+		resolver.Archive = archive.NewArchiver(pool, archive.DirStore{Root: cfg.ArchiveDir})
+		pool.AddEraser(resolver.Archive)
+	}
 	schema, err := graph.NewSchema(resolver)
 	if err != nil {
 		return err
@@ -42,10 +106,22 @@ func Run() error {
 		GraphiQL: true,
 	})
 
-	limStore := middleware.NewLimiterStore(cfg.IPRatePerMinute, cfg.UserRatePerMinute)
+	ips, err := clientip.NewResolver(cfg.TrustedProxies)
+	if err != nil {
+		return err
+	}
+	limStore := middleware.NewLimiterStore(backend, cfg.IPRatePerMinute, cfg.UserRatePerMinute)
 	mux := http.NewServeMux()
 
-	mux.Handle("/graphql", limStore.RateLimit(h))
+	identifier := &middleware.Identifier{
+		IPs:           ips,
+		AdminToken:    cfg.AdminToken,
+		Tenants:       tenants,
+		DefaultTenant: cfg.DefaultTenant,
+		Limiter:       backend,
+		AuthLimit:     ratelimit.Limit{PerMinute: cfg.AuthRatePerMinute, Burst: 20},
+	}
+	mux.Handle("/graphql", identifier.HTTP(limStore.RateLimit(h)))
 	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		_, _ = w.Write([]byte("ok"))
@@ -56,6 +132,14 @@ func Run() error {
 		Handler: mux,
 	}
 
+	lis, err := net.Listen("tcp", cfg.Addr)
+	if err != nil {
+		return err
+	}
+	if cfg.ProxyProtocol {
+		lis = clientip.NewProxyListener(lis, ips)
+	}
+
 	log.Printf("listening on %s", cfg.Addr)
-	return srv.ListenAndServe()
+	return srv.Serve(lis)
 }
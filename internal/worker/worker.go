@@ -0,0 +1,217 @@
+// Package worker runs background jobs that act on behalf of users:
+// scheduled spends, recurring subscription charges and delivery of
+// subscription events to platform webhooks.
+package worker
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+)
+
+// Store is the part of *db.Pool the worker needs.
+type Store interface {
+	DueScheduled(ctx context.Context, limit int) ([]string, error)
+	ExecuteScheduled(ctx context.Context, id string) (string, error)
+	DueSubscriptions(ctx context.Context, limit int) ([]string, error)
+	ChargeSubscription(ctx context.Context, id string, policy db.RetryPolicy) (string, error)
+	PendingWebhooks(ctx context.Context, limit int) ([]db.PendingWebhook, error)
+	MarkWebhook(ctx context.Context, eventID int64, delivered bool) error
+}
+
+// Stats summarises one RunOnce.
+type Stats struct {
+	Executed  int // scheduled spends inserted
+	Rejected  int // scheduled spends failing the insert checks
+	Charged   int
+	Failed    int
+	Lapsed    int
+	Delivered int
+	Errors    int // spends and charges left due by an error, retried next run
+}
+
+// Worker executes due scheduled spends, charges due subscriptions and
+// delivers subscription events.
+type Worker struct {
+	Store     Store
+	Policy    db.RetryPolicy
+	Client    *http.Client
+	BatchSize int
+}
+
+func New(store Store, policy db.RetryPolicy) *Worker {
+	return &Worker{
+		Store:     store,
+		Policy:    policy,
+		Client:    &http.Client{Timeout: 10 * time.Second},
+		BatchSize: 100,
+	}
+}
+
+// RunOnce executes every scheduled spend and charges every subscription
+// due now, then delivers pending events. A rejected spend or charge is
+// recorded on its row, and one failing with any other error is logged and
+// left for the next run; neither stops the run.
+func (w *Worker) RunOnce(ctx context.Context) (Stats, error) {
+	var st Stats
+	for {
+		ids, err := w.Store.DueScheduled(ctx, w.BatchSize)
+		if err != nil {
+			return st, err
+		}
+		progress := false
+		for _, id := range ids {
+			result, err := w.Store.ExecuteScheduled(ctx, id)
+			if err != nil {
+				if ctx.Err() != nil {
+					return st, ctx.Err()
+				}
+				log.Printf("execute scheduled transaction %s: %v", id, err)
+				st.Errors++
+				continue
+			}
+			switch result {
+			case models.ScheduledExecuted:
+				st.Executed++
+			case models.ScheduledFailed:
+				st.Rejected++
+			}
+			progress = true
+		}
+		// Spends left pending by errors would be returned again.
+		if len(ids) < w.BatchSize || !progress {
+			break
+		}
+	}
+
+	for {
+		ids, err := w.Store.DueSubscriptions(ctx, w.BatchSize)
+		if err != nil {
+			return st, err
+		}
+		progress := false
+		for _, id := range ids {
+			event, err := w.Store.ChargeSubscription(ctx, id, w.Policy)
+			if err != nil {
+				if ctx.Err() != nil {
+					return st, ctx.Err()
+				}
+				log.Printf("charge subscription %s: %v", id, err)
+				st.Errors++
+				continue
+			}
+			switch event {
+			case models.EventCharged:
+				st.Charged++
+			case models.EventChargeFailed:
+				st.Failed++
+			case models.EventLapsed:
+				st.Lapsed++
+			}
+			// Successful charges may leave the subscription due again
+			// (catching up after a pause); failures move it into the future.
+			progress = progress || event == models.EventCharged
+		}
+		if len(ids) < w.BatchSize || !progress {
+			break
+		}
+	}
+
+	hooks, err := w.Store.PendingWebhooks(ctx, w.BatchSize)
+	if err != nil {
+		return st, err
+	}
+	for _, h := range hooks {
+		delivered := true
+		if err := w.deliver(ctx, h); err != nil {
+			log.Printf("webhook %s event %d: %v", h.URL, h.Event.ID, err)
+			delivered = false
+		}
+		if err := w.Store.MarkWebhook(ctx, h.Event.ID, delivered); err != nil {
+			return st, err
+		}
+		if delivered {
+			st.Delivered++
+		}
+	}
+	return st, nil
+}
+
+// Run calls RunOnce every interval until ctx is done.
+func (w *Worker) Run(ctx context.Context, interval time.Duration) error {
+	for {
+		st, err := w.RunOnce(ctx)
+		if err != nil {
+			log.Printf("worker run failed: %v", err)
+		} else {
+			log.Printf("worker run done: %d scheduled executed, %d rejected, %d charged, %d failed, %d lapsed, %d events delivered, %d errors",
+				st.Executed, st.Rejected, st.Charged, st.Failed, st.Lapsed, st.Delivered, st.Errors)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// Headers authenticating a webhook delivery. Receivers recompute
+// Sign(secret, timestamp, body), compare it in constant time and reject
+// stale timestamps to stop replays.
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	TimestampHeader = "X-Webhook-Timestamp"
+)
+
+// Sign returns the signature of a delivery: "sha256=" and the hex
+// HMAC-SHA256 of the Unix timestamp, a dot and the body.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// deliver POSTs the event as signed JSON; any 2xx response counts as
+// delivered.
+func (w *Worker) deliver(ctx context.Context, h db.PendingWebhook) error {
+	if h.Secret == "" {
+		return errors.New("platform has no webhook secret")
+	}
+	body, err := json.Marshal(h.Event)
+	if err != nil {
+		return err
+	}
+	ts := time.Now().Unix()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Tenant-ID", h.TenantID)
+	req.Header.Set("X-Event-Type", h.Event.Type)
+	req.Header.Set(TimestampHeader, strconv.FormatInt(ts, 10))
+	req.Header.Set(SignatureHeader, Sign(h.Secret, ts, body))
+	resp, err := w.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("status %s", resp.Status)
+	}
+	return nil
+}
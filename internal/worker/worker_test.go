@@ -0,0 +1,151 @@
+package worker
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/devifyX/go-back-transaction-service/internal/db"
+	"github.com/devifyX/go-back-transaction-service/internal/models"
+)
+
+type fakeStore struct {
+	scheduled []string
+	executed  map[string]string
+	broken    map[string]error // ExecuteScheduled and ChargeSubscription errors by id
+	due       []string
+	results   map[string]string
+	hooks     []db.PendingWebhook
+	marked    map[int64]bool
+}
+
+func (s *fakeStore) DueScheduled(ctx context.Context, limit int) ([]string, error) {
+	ids := s.scheduled
+	s.scheduled = nil
+	return ids, nil
+}
+
+func (s *fakeStore) ExecuteScheduled(ctx context.Context, id string) (string, error) {
+	if err := s.broken[id]; err != nil {
+		return "", err
+	}
+	return s.executed[id], nil
+}
+
+func (s *fakeStore) DueSubscriptions(ctx context.Context, limit int) ([]string, error) {
+	ids := s.due
+	s.due = nil
+	return ids, nil
+}
+
+func (s *fakeStore) ChargeSubscription(ctx context.Context, id string, policy db.RetryPolicy) (string, error) {
+	if err := s.broken[id]; err != nil {
+		return "", err
+	}
+	return s.results[id], nil
+}
+
+func (s *fakeStore) PendingWebhooks(ctx context.Context, limit int) ([]db.PendingWebhook, error) {
+	return s.hooks, nil
+}
+
+func (s *fakeStore) MarkWebhook(ctx context.Context, eventID int64, delivered bool) error {
+	s.marked[eventID] = delivered
+	return nil
+}
+
+func TestRunOnce(t *testing.T) {
+	var got []models.SubscriptionEvent
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		ts, err := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+		if err != nil || time.Since(time.Unix(ts, 0)) > time.Minute {
+			t.Errorf("%s = %q", TimestampHeader, r.Header.Get(TimestampHeader))
+		}
+		if sig := r.Header.Get(SignatureHeader); !hmac.Equal([]byte(sig), []byte(Sign("whsec_test", ts, body))) {
+			t.Errorf("%s = %q does not match the body", SignatureHeader, sig)
+		}
+		var e models.SubscriptionEvent
+		if err := json.Unmarshal(body, &e); err != nil {
+			t.Errorf("decode: %v", err)
+		}
+		if r.Header.Get("X-Event-Type") != e.Type {
+			t.Errorf("X-Event-Type = %q, want %q", r.Header.Get("X-Event-Type"), e.Type)
+		}
+		got = append(got, e)
+	}))
+	defer ok.Close()
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer broken.Close()
+
+	store := &fakeStore{
+		scheduled: []string{"s1", "s2", "s3"},
+		executed:  map[string]string{"s1": models.ScheduledExecuted, "s2": models.ScheduledFailed, "s3": models.ScheduledExecuted},
+		due:       []string{"a", "b", "c"},
+		results:   map[string]string{"a": models.EventCharged, "b": models.EventChargeFailed, "c": models.EventLapsed},
+		hooks: []db.PendingWebhook{
+			{TenantID: "t", URL: ok.URL, Secret: "whsec_test", Event: models.SubscriptionEvent{ID: 1, Type: models.EventChargeFailed}},
+			{TenantID: "t", URL: broken.URL, Secret: "whsec_test", Event: models.SubscriptionEvent{ID: 2, Type: models.EventLapsed}},
+		},
+		marked: map[int64]bool{},
+	}
+	st, err := New(store, db.RetryPolicy{}).RunOnce(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st != (Stats{Executed: 2, Rejected: 1, Charged: 1, Failed: 1, Lapsed: 1, Delivered: 1}) {
+		t.Errorf("stats = %+v", st)
+	}
+	if len(got) != 1 || got[0].ID != 1 {
+		t.Errorf("delivered %+v, want event 1", got)
+	}
+	if delivered, seen := store.marked[1]; !seen || !delivered {
+		t.Errorf("event 1 marked %v, %v", delivered, seen)
+	}
+	if delivered, seen := store.marked[2]; !seen || delivered {
+		t.Errorf("event 2 marked %v, %v; want an undelivered attempt", delivered, seen)
+	}
+}
+
+func TestDeliverRequiresSecret(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unsigned delivery sent")
+	}))
+	defer srv.Close()
+	w := New(&fakeStore{}, db.RetryPolicy{})
+	if err := w.deliver(context.Background(), db.PendingWebhook{URL: srv.URL}); err == nil {
+		t.Fatal("delivered without a webhook secret")
+	}
+}
+
+func TestRunOnceContinuesPastErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+	store := &fakeStore{
+		scheduled: []string{"poisoned", "s1"},
+		executed:  map[string]string{"s1": models.ScheduledExecuted},
+		due:       []string{"poisoned", "a"},
+		results:   map[string]string{"a": models.EventCharged},
+		broken:    map[string]error{"poisoned": errors.New("boom")},
+		hooks:     []db.PendingWebhook{{TenantID: "t", URL: srv.URL, Secret: "whsec_test", Event: models.SubscriptionEvent{ID: 1}}},
+		marked:    map[int64]bool{},
+	}
+	w := New(store, db.RetryPolicy{})
+	w.BatchSize = 2
+	st, err := w.RunOnce(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st != (Stats{Executed: 1, Charged: 1, Delivered: 1, Errors: 2}) {
+		t.Errorf("stats = %+v", st)
+	}
+}
@@ -9,6 +9,7 @@ package transactionsv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,6 +23,107 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TransactionSortField int32
+
+const (
+	TransactionSortField_TRANSACTION_SORT_FIELD_UNSPECIFIED           TransactionSortField = 0 // transaction_timestamp
+	TransactionSortField_TRANSACTION_SORT_FIELD_TRANSACTION_TIMESTAMP TransactionSortField = 1
+	TransactionSortField_TRANSACTION_SORT_FIELD_EXPIRY_DATE           TransactionSortField = 2
+	TransactionSortField_TRANSACTION_SORT_FIELD_COINUSED              TransactionSortField = 3
+)
+
+// Enum value maps for TransactionSortField.
+var (
+	TransactionSortField_name = map[int32]string{
+		0: "TRANSACTION_SORT_FIELD_UNSPECIFIED",
+		1: "TRANSACTION_SORT_FIELD_TRANSACTION_TIMESTAMP",
+		2: "TRANSACTION_SORT_FIELD_EXPIRY_DATE",
+		3: "TRANSACTION_SORT_FIELD_COINUSED",
+	}
+	TransactionSortField_value = map[string]int32{
+		"TRANSACTION_SORT_FIELD_UNSPECIFIED":           0,
+		"TRANSACTION_SORT_FIELD_TRANSACTION_TIMESTAMP": 1,
+		"TRANSACTION_SORT_FIELD_EXPIRY_DATE":           2,
+		"TRANSACTION_SORT_FIELD_COINUSED":              3,
+	}
+)
+
+func (x TransactionSortField) Enum() *TransactionSortField {
+	p := new(TransactionSortField)
+	*p = x
+	return p
+}
+
+func (x TransactionSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_transactions_proto_enumTypes[0].Descriptor()
+}
+
+func (TransactionSortField) Type() protoreflect.EnumType {
+	return &file_proto_transactions_proto_enumTypes[0]
+}
+
+func (x TransactionSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionSortField.Descriptor instead.
+func (TransactionSortField) EnumDescriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{0}
+}
+
+type CapPeriod int32
+
+const (
+	CapPeriod_CAP_PERIOD_UNSPECIFIED CapPeriod = 0
+	CapPeriod_CAP_PERIOD_DAILY       CapPeriod = 1
+	CapPeriod_CAP_PERIOD_MONTHLY     CapPeriod = 2
+)
+
+// Enum value maps for CapPeriod.
+var (
+	CapPeriod_name = map[int32]string{
+		0: "CAP_PERIOD_UNSPECIFIED",
+		1: "CAP_PERIOD_DAILY",
+		2: "CAP_PERIOD_MONTHLY",
+	}
+	CapPeriod_value = map[string]int32{
+		"CAP_PERIOD_UNSPECIFIED": 0,
+		"CAP_PERIOD_DAILY":       1,
+		"CAP_PERIOD_MONTHLY":     2,
+	}
+)
+
+func (x CapPeriod) Enum() *CapPeriod {
+	p := new(CapPeriod)
+	*p = x
+	return p
+}
+
+func (x CapPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CapPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_transactions_proto_enumTypes[1].Descriptor()
+}
+
+func (CapPeriod) Type() protoreflect.EnumType {
+	return &file_proto_transactions_proto_enumTypes[1]
+}
+
+func (x CapPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CapPeriod.Descriptor instead.
+func (CapPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{1}
+}
+
 type CreateTransactionRequest struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	Coinid               string                 `protobuf:"bytes,1,opt,name=coinid,proto3" json:"coinid,omitempty"`
@@ -29,8 +131,10 @@ type CreateTransactionRequest struct {
 	Dataid               string                 `protobuf:"bytes,3,opt,name=dataid,proto3" json:"dataid,omitempty"`
 	Coinused             float64                `protobuf:"fixed64,4,opt,name=coinused,proto3" json:"coinused,omitempty"`
 	TransactionTimestamp *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=transaction_timestamp,json=transactionTimestamp,proto3" json:"transaction_timestamp,omitempty"`
-	ExpiryDate           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`
+	ExpiryDate           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"` // optional; defaults from the platform
 	PlatformName         string                 `protobuf:"bytes,7,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	Metadata             map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // caller-defined context, at most 32 keys
+	Tags                 []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`                                                                                   // at most 16
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -114,6 +218,20 @@ func (x *CreateTransactionRequest) GetPlatformName() string {
 	return ""
 }
 
+func (x *CreateTransactionRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CreateTransactionRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type Transaction struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -124,6 +242,14 @@ type Transaction struct {
 	TransactionTimestamp *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=transaction_timestamp,json=transactionTimestamp,proto3" json:"transaction_timestamp,omitempty"`
 	ExpiryDate           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`
 	PlatformName         string                 `protobuf:"bytes,8,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	TenantId             string                 `protobuf:"bytes,9,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	DeletedAt            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`               // set on soft-deleted rows
+	Status               string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`                                      // authorized, captured or voided
+	HoldExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=hold_expires_at,json=holdExpiresAt,proto3" json:"hold_expires_at,omitempty"` // set on authorized holds
+	RenewsId             string                 `protobuf:"bytes,13,opt,name=renews_id,json=renewsId,proto3" json:"renews_id,omitempty"`                  // original purchase of a renewal
+	Version              int64                  `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`                                   // bumped on every update; send it back with UpdateTransaction
+	Metadata             map[string]string      `protobuf:"bytes,15,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Tags                 []string               `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"` // sorted, without duplicates
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -214,32 +340,6712 @@ func (x *Transaction) GetPlatformName() string {
 	return ""
 }
 
-var File_proto_transactions_proto protoreflect.FileDescriptor
+func (x *Transaction) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
 
-const file_proto_transactions_proto_rawDesc = "" +
-	"\n" +
-	"\x18proto/transactions.proto\x12\x0ftransactions.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb1\x02\n" +
-	"\x18CreateTransactionRequest\x12\x16\n" +
-	"\x06coinid\x18\x01 \x01(\tR\x06coinid\x12\x16\n" +
-	"\x06userid\x18\x02 \x01(\tR\x06userid\x12\x16\n" +
-	"\x06dataid\x18\x03 \x01(\tR\x06dataid\x12\x1a\n" +
-	"\bcoinused\x18\x04 \x01(\x01R\bcoinused\x12O\n" +
-	"\x15transaction_timestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x14transactionTimestamp\x12;\n" +
-	"\vexpiry_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"expiryDate\x12#\n" +
-	"\rplatform_name\x18\a \x01(\tR\fplatformName\"\xb4\x02\n" +
-	"\vTransaction\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
-	"\x06coinid\x18\x02 \x01(\tR\x06coinid\x12\x16\n" +
-	"\x06userid\x18\x03 \x01(\tR\x06userid\x12\x16\n" +
-	"\x06dataid\x18\x04 \x01(\tR\x06dataid\x12\x1a\n" +
-	"\bcoinused\x18\x05 \x01(\x01R\bcoinused\x12O\n" +
-	"\x15transaction_timestamp\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x14transactionTimestamp\x12;\n" +
-	"\vexpiry_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"expiryDate\x12#\n" +
-	"\rplatform_name\x18\b \x01(\tR\fplatformName2l\n" +
+func (x *Transaction) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Transaction) GetHoldExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HoldExpiresAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetRenewsId() string {
+	if x != nil {
+		return x.RenewsId
+	}
+	return ""
+}
+
+func (x *Transaction) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Transaction) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Transaction) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Lists match any of their values; every set condition must hold.
+type TransactionFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Userids       []string               `protobuf:"bytes,1,rep,name=userids,proto3" json:"userids,omitempty"`
+	Coinids       []string               `protobuf:"bytes,2,rep,name=coinids,proto3" json:"coinids,omitempty"`
+	Dataids       []string               `protobuf:"bytes,3,rep,name=dataids,proto3" json:"dataids,omitempty"`
+	PlatformNames []string               `protobuf:"bytes,4,rep,name=platform_names,json=platformNames,proto3" json:"platform_names,omitempty"`
+	DataidPrefix  string                 `protobuf:"bytes,5,opt,name=dataid_prefix,json=dataidPrefix,proto3" json:"dataid_prefix,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	MinCoinused   *float64               `protobuf:"fixed64,7,opt,name=min_coinused,json=minCoinused,proto3,oneof" json:"min_coinused,omitempty"`                                           // inclusive
+	MaxCoinused   *float64               `protobuf:"fixed64,8,opt,name=max_coinused,json=maxCoinused,proto3,oneof" json:"max_coinused,omitempty"`                                           // inclusive
+	From          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=from,proto3" json:"from,omitempty"`                                                                                    // inclusive, on transaction_timestamp
+	To            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=to,proto3" json:"to,omitempty"`                                                                                       // inclusive, on transaction_timestamp
+	ExpiresFrom   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=expires_from,json=expiresFrom,proto3" json:"expires_from,omitempty"`                                                  // inclusive, on expiry_date
+	ExpiresTo     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=expires_to,json=expiresTo,proto3" json:"expires_to,omitempty"`                                                        // inclusive, on expiry_date
+	ActiveAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=active_at,json=activeAt,proto3" json:"active_at,omitempty"`                                                           // transaction_timestamp <= active_at < expiry_date
+	Metadata      map[string]string      `protobuf:"bytes,14,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // every key must have the given value
+	Tags          []string               `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty"`                                                                                   // every tag must be present
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionFilter) Reset() {
+	*x = TransactionFilter{}
+	mi := &file_proto_transactions_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionFilter) ProtoMessage() {}
+
+func (x *TransactionFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionFilter.ProtoReflect.Descriptor instead.
+func (*TransactionFilter) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TransactionFilter) GetUserids() []string {
+	if x != nil {
+		return x.Userids
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetCoinids() []string {
+	if x != nil {
+		return x.Coinids
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetDataids() []string {
+	if x != nil {
+		return x.Dataids
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetPlatformNames() []string {
+	if x != nil {
+		return x.PlatformNames
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetDataidPrefix() string {
+	if x != nil {
+		return x.DataidPrefix
+	}
+	return ""
+}
+
+func (x *TransactionFilter) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TransactionFilter) GetMinCoinused() float64 {
+	if x != nil && x.MinCoinused != nil {
+		return *x.MinCoinused
+	}
+	return 0
+}
+
+func (x *TransactionFilter) GetMaxCoinused() float64 {
+	if x != nil && x.MaxCoinused != nil {
+		return *x.MaxCoinused
+	}
+	return 0
+}
+
+func (x *TransactionFilter) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetExpiresFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresFrom
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetExpiresTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresTo
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetActiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveAt
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListTransactionsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Filter         *TransactionFilter     `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	SortBy         TransactionSortField   `protobuf:"varint,2,opt,name=sort_by,json=sortBy,proto3,enum=transactions.v1.TransactionSortField" json:"sort_by,omitempty"`
+	Ascending      bool                   `protobuf:"varint,3,opt,name=ascending,proto3" json:"ascending,omitempty"` // newest/largest first unless set
+	Limit          int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`         // default 100, at most 1000
+	Offset         int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,6,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // admin only
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListTransactionsRequest) Reset() {
+	*x = ListTransactionsRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsRequest) ProtoMessage() {}
+
+func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListTransactionsRequest) GetFilter() *TransactionFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListTransactionsRequest) GetSortBy() TransactionSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return TransactionSortField_TRANSACTION_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListTransactionsRequest) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
+func (x *ListTransactionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ListTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsResponse) Reset() {
+	*x = ListTransactionsResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsResponse) ProtoMessage() {}
+
+func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+// A spending cap; empty coinid/platform_name apply to all coins/platforms.
+type SpendingCap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Userid        string                 `protobuf:"bytes,2,opt,name=userid,proto3" json:"userid,omitempty"`
+	Coinid        string                 `protobuf:"bytes,3,opt,name=coinid,proto3" json:"coinid,omitempty"`
+	PlatformName  string                 `protobuf:"bytes,4,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	Period        CapPeriod              `protobuf:"varint,5,opt,name=period,proto3,enum=transactions.v1.CapPeriod" json:"period,omitempty"`
+	Amount        float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpendingCap) Reset() {
+	*x = SpendingCap{}
+	mi := &file_proto_transactions_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendingCap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendingCap) ProtoMessage() {}
+
+func (x *SpendingCap) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendingCap.ProtoReflect.Descriptor instead.
+func (*SpendingCap) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SpendingCap) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SpendingCap) GetUserid() string {
+	if x != nil {
+		return x.Userid
+	}
+	return ""
+}
+
+func (x *SpendingCap) GetCoinid() string {
+	if x != nil {
+		return x.Coinid
+	}
+	return ""
+}
+
+func (x *SpendingCap) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+func (x *SpendingCap) GetPeriod() CapPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return CapPeriod_CAP_PERIOD_UNSPECIFIED
+}
+
+func (x *SpendingCap) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type SetSpendingCapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cap           *SpendingCap           `protobuf:"bytes,1,opt,name=cap,proto3" json:"cap,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSpendingCapRequest) Reset() {
+	*x = SetSpendingCapRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSpendingCapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSpendingCapRequest) ProtoMessage() {}
+
+func (x *SetSpendingCapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSpendingCapRequest.ProtoReflect.Descriptor instead.
+func (*SetSpendingCapRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetSpendingCapRequest) GetCap() *SpendingCap {
+	if x != nil {
+		return x.Cap
+	}
+	return nil
+}
+
+type ListSpendingCapsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Userid        string                 `protobuf:"bytes,1,opt,name=userid,proto3" json:"userid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSpendingCapsRequest) Reset() {
+	*x = ListSpendingCapsRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSpendingCapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSpendingCapsRequest) ProtoMessage() {}
+
+func (x *ListSpendingCapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSpendingCapsRequest.ProtoReflect.Descriptor instead.
+func (*ListSpendingCapsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListSpendingCapsRequest) GetUserid() string {
+	if x != nil {
+		return x.Userid
+	}
+	return ""
+}
+
+type ListSpendingCapsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Caps          []*SpendingCap         `protobuf:"bytes,1,rep,name=caps,proto3" json:"caps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSpendingCapsResponse) Reset() {
+	*x = ListSpendingCapsResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSpendingCapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSpendingCapsResponse) ProtoMessage() {}
+
+func (x *ListSpendingCapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSpendingCapsResponse.ProtoReflect.Descriptor instead.
+func (*ListSpendingCapsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListSpendingCapsResponse) GetCaps() []*SpendingCap {
+	if x != nil {
+		return x.Caps
+	}
+	return nil
+}
+
+type ClearSpendingCapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Userid        string                 `protobuf:"bytes,1,opt,name=userid,proto3" json:"userid,omitempty"`
+	Coinid        string                 `protobuf:"bytes,2,opt,name=coinid,proto3" json:"coinid,omitempty"`
+	PlatformName  string                 `protobuf:"bytes,3,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	Period        CapPeriod              `protobuf:"varint,4,opt,name=period,proto3,enum=transactions.v1.CapPeriod" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearSpendingCapRequest) Reset() {
+	*x = ClearSpendingCapRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearSpendingCapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearSpendingCapRequest) ProtoMessage() {}
+
+func (x *ClearSpendingCapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearSpendingCapRequest.ProtoReflect.Descriptor instead.
+func (*ClearSpendingCapRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ClearSpendingCapRequest) GetUserid() string {
+	if x != nil {
+		return x.Userid
+	}
+	return ""
+}
+
+func (x *ClearSpendingCapRequest) GetCoinid() string {
+	if x != nil {
+		return x.Coinid
+	}
+	return ""
+}
+
+func (x *ClearSpendingCapRequest) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+func (x *ClearSpendingCapRequest) GetPeriod() CapPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return CapPeriod_CAP_PERIOD_UNSPECIFIED
+}
+
+type ClearSpendingCapResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cleared       bool                   `protobuf:"varint,1,opt,name=cleared,proto3" json:"cleared,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearSpendingCapResponse) Reset() {
+	*x = ClearSpendingCapResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearSpendingCapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearSpendingCapResponse) ProtoMessage() {}
+
+func (x *ClearSpendingCapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearSpendingCapResponse.ProtoReflect.Descriptor instead.
+func (*ClearSpendingCapResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ClearSpendingCapResponse) GetCleared() bool {
+	if x != nil {
+		return x.Cleared
+	}
+	return false
+}
+
+type GetRemainingBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Userid        string                 `protobuf:"bytes,1,opt,name=userid,proto3" json:"userid,omitempty"`
+	Coinid        string                 `protobuf:"bytes,2,opt,name=coinid,proto3" json:"coinid,omitempty"`
+	PlatformName  string                 `protobuf:"bytes,3,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRemainingBudgetRequest) Reset() {
+	*x = GetRemainingBudgetRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRemainingBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRemainingBudgetRequest) ProtoMessage() {}
+
+func (x *GetRemainingBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRemainingBudgetRequest.ProtoReflect.Descriptor instead.
+func (*GetRemainingBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetRemainingBudgetRequest) GetUserid() string {
+	if x != nil {
+		return x.Userid
+	}
+	return ""
+}
+
+func (x *GetRemainingBudgetRequest) GetCoinid() string {
+	if x != nil {
+		return x.Coinid
+	}
+	return ""
+}
+
+func (x *GetRemainingBudgetRequest) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+type Budget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cap           *SpendingCap           `protobuf:"bytes,1,opt,name=cap,proto3" json:"cap,omitempty"`
+	Spent         float64                `protobuf:"fixed64,2,opt,name=spent,proto3" json:"spent,omitempty"`
+	Remaining     float64                `protobuf:"fixed64,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	WindowStart   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Budget) Reset() {
+	*x = Budget{}
+	mi := &file_proto_transactions_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Budget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Budget) ProtoMessage() {}
+
+func (x *Budget) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
+func (*Budget) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Budget) GetCap() *SpendingCap {
+	if x != nil {
+		return x.Cap
+	}
+	return nil
+}
+
+func (x *Budget) GetSpent() float64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *Budget) GetRemaining() float64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *Budget) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+type GetRemainingBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budgets       []*Budget              `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRemainingBudgetResponse) Reset() {
+	*x = GetRemainingBudgetResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRemainingBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRemainingBudgetResponse) ProtoMessage() {}
+
+func (x *GetRemainingBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRemainingBudgetResponse.ProtoReflect.Descriptor instead.
+func (*GetRemainingBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetRemainingBudgetResponse) GetBudgets() []*Budget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seq           int64                  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"` // keyed hash of the caller's user id, or "anonymous"
+	Admin         bool                   `protobuf:"varint,4,opt,name=admin,proto3" json:"admin,omitempty"`
+	Transport     string                 `protobuf:"bytes,5,opt,name=transport,proto3" json:"transport,omitempty"`
+	Operation     string                 `protobuf:"bytes,6,opt,name=operation,proto3" json:"operation,omitempty"`
+	Args          string                 `protobuf:"bytes,7,opt,name=args,proto3" json:"args,omitempty"`
+	ResultIds     []string               `protobuf:"bytes,8,rep,name=result_ids,json=resultIds,proto3" json:"result_ids,omitempty"`
+	Outcome       string                 `protobuf:"bytes,9,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,10,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	RequestId     string                 `protobuf:"bytes,11,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	PrevHash      string                 `protobuf:"bytes,12,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	Hash          string                 `protobuf:"bytes,13,opt,name=hash,proto3" json:"hash,omitempty"`
+	TenantId      string                 `protobuf:"bytes,14,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_proto_transactions_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AuditEvent) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
+func (x *AuditEvent) GetTransport() string {
+	if x != nil {
+		return x.Transport
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetArgs() string {
+	if x != nil {
+		return x.Args
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResultIds() []string {
+	if x != nil {
+		return x.ResultIds
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetPrevHash() string {
+	if x != nil {
+		return x.PrevHash
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         string                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"` // user id; matched against its stored pseudonym
+	Operation     string                 `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	ResultId      string                 `protobuf:"bytes,3,opt,name=result_id,json=resultId,proto3" json:"result_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListAuditEventsRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetResultId() string {
+	if x != nil {
+		return x.ResultId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type Tenant struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IpRatePerMinute   int32                  `protobuf:"varint,3,opt,name=ip_rate_per_minute,json=ipRatePerMinute,proto3" json:"ip_rate_per_minute,omitempty"`       // 0 inherits the global limit
+	UserRatePerMinute int32                  `protobuf:"varint,4,opt,name=user_rate_per_minute,json=userRatePerMinute,proto3" json:"user_rate_per_minute,omitempty"` // 0 inherits the global limit
+	RetentionDays     int32                  `protobuf:"varint,5,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"`                 // 0 keeps history forever
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Tenant) Reset() {
+	*x = Tenant{}
+	mi := &file_proto_transactions_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant) ProtoMessage() {}
+
+func (x *Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Tenant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tenant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tenant) GetIpRatePerMinute() int32 {
+	if x != nil {
+		return x.IpRatePerMinute
+	}
+	return 0
+}
+
+func (x *Tenant) GetUserRatePerMinute() int32 {
+	if x != nil {
+		return x.UserRatePerMinute
+	}
+	return 0
+}
+
+func (x *Tenant) GetRetentionDays() int32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+func (x *Tenant) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type TenantCredentials struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	ApiKey        string                 `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"` // shown once; send as x-api-key metadata
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantCredentials) Reset() {
+	*x = TenantCredentials{}
+	mi := &file_proto_transactions_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantCredentials) ProtoMessage() {}
+
+func (x *TenantCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantCredentials.ProtoReflect.Descriptor instead.
+func (*TenantCredentials) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TenantCredentials) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+func (x *TenantCredentials) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+type ListTenantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantsRequest) Reset() {
+	*x = ListTenantsRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantsRequest) ProtoMessage() {}
+
+func (x *ListTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantsRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{19}
+}
+
+type ListTenantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenants       []*Tenant              `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantsResponse) Reset() {
+	*x = ListTenantsResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantsResponse) ProtoMessage() {}
+
+func (x *ListTenantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantsResponse.ProtoReflect.Descriptor instead.
+func (*ListTenantsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListTenantsResponse) GetTenants() []*Tenant {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type RotateTenantKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateTenantKeyRequest) Reset() {
+	*x = RotateTenantKeyRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateTenantKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateTenantKeyRequest) ProtoMessage() {}
+
+func (x *RotateTenantKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateTenantKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateTenantKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RotateTenantKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type EraseUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Userid        string                 `protobuf:"bytes,1,opt,name=userid,proto3" json:"userid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseUserRequest) Reset() {
+	*x = EraseUserRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseUserRequest) ProtoMessage() {}
+
+func (x *EraseUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseUserRequest.ProtoReflect.Descriptor instead.
+func (*EraseUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EraseUserRequest) GetUserid() string {
+	if x != nil {
+		return x.Userid
+	}
+	return ""
+}
+
+type ErasureReceipt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Pseudonym     string                 `protobuf:"bytes,3,opt,name=pseudonym,proto3" json:"pseudonym,omitempty"` // replaces the userid on every affected row
+	SubjectHash   string                 `protobuf:"bytes,4,opt,name=subject_hash,json=subjectHash,proto3" json:"subject_hash,omitempty"`
+	Transactions  int64                  `protobuf:"varint,5,opt,name=transactions,proto3" json:"transactions,omitempty"`
+	SpendingCaps  int64                  `protobuf:"varint,6,opt,name=spending_caps,json=spendingCaps,proto3" json:"spending_caps,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,7,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	ErasedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=erased_at,json=erasedAt,proto3" json:"erased_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErasureReceipt) Reset() {
+	*x = ErasureReceipt{}
+	mi := &file_proto_transactions_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErasureReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErasureReceipt) ProtoMessage() {}
+
+func (x *ErasureReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErasureReceipt.ProtoReflect.Descriptor instead.
+func (*ErasureReceipt) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ErasureReceipt) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ErasureReceipt) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ErasureReceipt) GetPseudonym() string {
+	if x != nil {
+		return x.Pseudonym
+	}
+	return ""
+}
+
+func (x *ErasureReceipt) GetSubjectHash() string {
+	if x != nil {
+		return x.SubjectHash
+	}
+	return ""
+}
+
+func (x *ErasureReceipt) GetTransactions() int64 {
+	if x != nil {
+		return x.Transactions
+	}
+	return 0
+}
+
+func (x *ErasureReceipt) GetSpendingCaps() int64 {
+	if x != nil {
+		return x.SpendingCaps
+	}
+	return 0
+}
+
+func (x *ErasureReceipt) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *ErasureReceipt) GetErasedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ErasedAt
+	}
+	return nil
+}
+
+type DeleteTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTransactionRequest) Reset() {
+	*x = DeleteTransactionRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransactionRequest) ProtoMessage() {}
+
+func (x *DeleteTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTransactionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteTransactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Coin struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Precision     int32                  `protobuf:"varint,3,opt,name=precision,proto3" json:"precision,omitempty"` // decimal places allowed in coinused
+	Active        bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	MinSpend      float64                `protobuf:"fixed64,5,opt,name=min_spend,json=minSpend,proto3" json:"min_spend,omitempty"`
+	MaxSpend      float64                `protobuf:"fixed64,6,opt,name=max_spend,json=maxSpend,proto3" json:"max_spend,omitempty"` // 0 means no maximum
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Coin) Reset() {
+	*x = Coin{}
+	mi := &file_proto_transactions_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Coin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coin) ProtoMessage() {}
+
+func (x *Coin) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coin.ProtoReflect.Descriptor instead.
+func (*Coin) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Coin) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Coin) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Coin) GetPrecision() int32 {
+	if x != nil {
+		return x.Precision
+	}
+	return 0
+}
+
+func (x *Coin) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Coin) GetMinSpend() float64 {
+	if x != nil {
+		return x.MinSpend
+	}
+	return 0
+}
+
+func (x *Coin) GetMaxSpend() float64 {
+	if x != nil {
+		return x.MaxSpend
+	}
+	return 0
+}
+
+func (x *Coin) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Coin) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListCoinsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeInactive bool                   `protobuf:"varint,1,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListCoinsRequest) Reset() {
+	*x = ListCoinsRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoinsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoinsRequest) ProtoMessage() {}
+
+func (x *ListCoinsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoinsRequest.ProtoReflect.Descriptor instead.
+func (*ListCoinsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListCoinsRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type ListCoinsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coins         []*Coin                `protobuf:"bytes,1,rep,name=coins,proto3" json:"coins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoinsResponse) Reset() {
+	*x = ListCoinsResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoinsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoinsResponse) ProtoMessage() {}
+
+func (x *ListCoinsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoinsResponse.ProtoReflect.Descriptor instead.
+func (*ListCoinsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListCoinsResponse) GetCoins() []*Coin {
+	if x != nil {
+		return x.Coins
+	}
+	return nil
+}
+
+type Platform struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName          string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	OwnerContact         string                 `protobuf:"bytes,3,opt,name=owner_contact,json=ownerContact,proto3" json:"owner_contact,omitempty"`
+	AllowedCoins         []string               `protobuf:"bytes,4,rep,name=allowed_coins,json=allowedCoins,proto3" json:"allowed_coins,omitempty"`                            // empty allows every coin
+	DefaultAccessSeconds int64                  `protobuf:"varint,5,opt,name=default_access_seconds,json=defaultAccessSeconds,proto3" json:"default_access_seconds,omitempty"` // fills a missing expiry_date; 0 requires one
+	WebhookUrl           string                 `protobuf:"bytes,6,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	Enabled              bool                   `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DedupWindowSeconds   int64                  `protobuf:"varint,10,opt,name=dedup_window_seconds,json=dedupWindowSeconds,proto3" json:"dedup_window_seconds,omitempty"` // 0 disables duplicate detection
+	DedupAction          string                 `protobuf:"bytes,11,opt,name=dedup_action,json=dedupAction,proto3" json:"dedup_action,omitempty"`                         // "return" (default) or "reject"
+	WebhookSecret        string                 `protobuf:"bytes,12,opt,name=webhook_secret,json=webhookSecret,proto3" json:"webhook_secret,omitempty"`                   // output only; signs webhook deliveries
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Platform) Reset() {
+	*x = Platform{}
+	mi := &file_proto_transactions_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Platform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Platform) ProtoMessage() {}
+
+func (x *Platform) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Platform.ProtoReflect.Descriptor instead.
+func (*Platform) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Platform) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Platform) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Platform) GetOwnerContact() string {
+	if x != nil {
+		return x.OwnerContact
+	}
+	return ""
+}
+
+func (x *Platform) GetAllowedCoins() []string {
+	if x != nil {
+		return x.AllowedCoins
+	}
+	return nil
+}
+
+func (x *Platform) GetDefaultAccessSeconds() int64 {
+	if x != nil {
+		return x.DefaultAccessSeconds
+	}
+	return 0
+}
+
+func (x *Platform) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *Platform) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Platform) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Platform) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Platform) GetDedupWindowSeconds() int64 {
+	if x != nil {
+		return x.DedupWindowSeconds
+	}
+	return 0
+}
+
+func (x *Platform) GetDedupAction() string {
+	if x != nil {
+		return x.DedupAction
+	}
+	return ""
+}
+
+func (x *Platform) GetWebhookSecret() string {
+	if x != nil {
+		return x.WebhookSecret
+	}
+	return ""
+}
+
+type GetPlatformRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlatformRequest) Reset() {
+	*x = GetPlatformRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlatformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlatformRequest) ProtoMessage() {}
+
+func (x *GetPlatformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlatformRequest.ProtoReflect.Descriptor instead.
+func (*GetPlatformRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetPlatformRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListPlatformsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeDisabled bool                   `protobuf:"varint,1,opt,name=include_disabled,json=includeDisabled,proto3" json:"include_disabled,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListPlatformsRequest) Reset() {
+	*x = ListPlatformsRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlatformsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlatformsRequest) ProtoMessage() {}
+
+func (x *ListPlatformsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlatformsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlatformsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListPlatformsRequest) GetIncludeDisabled() bool {
+	if x != nil {
+		return x.IncludeDisabled
+	}
+	return false
+}
+
+type ListPlatformsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Platforms     []*Platform            `protobuf:"bytes,1,rep,name=platforms,proto3" json:"platforms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlatformsResponse) Reset() {
+	*x = ListPlatformsResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlatformsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlatformsResponse) ProtoMessage() {}
+
+func (x *ListPlatformsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlatformsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlatformsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListPlatformsResponse) GetPlatforms() []*Platform {
+	if x != nil {
+		return x.Platforms
+	}
+	return nil
+}
+
+type RotateWebhookSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // platform
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateWebhookSecretRequest) Reset() {
+	*x = RotateWebhookSecretRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateWebhookSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateWebhookSecretRequest) ProtoMessage() {}
+
+func (x *RotateWebhookSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateWebhookSecretRequest.ProtoReflect.Descriptor instead.
+func (*RotateWebhookSecretRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RotateWebhookSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeletePlatformRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlatformRequest) Reset() {
+	*x = DeletePlatformRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlatformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlatformRequest) ProtoMessage() {}
+
+func (x *DeletePlatformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlatformRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlatformRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeletePlatformRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeletePlatformResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlatformResponse) Reset() {
+	*x = DeletePlatformResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlatformResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlatformResponse) ProtoMessage() {}
+
+func (x *DeletePlatformResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlatformResponse.ProtoReflect.Descriptor instead.
+func (*DeletePlatformResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeletePlatformResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type ExchangeRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseCoin      string                 `protobuf:"bytes,1,opt,name=base_coin,json=baseCoin,proto3" json:"base_coin,omitempty"`
+	QuoteCoin     string                 `protobuf:"bytes,2,opt,name=quote_coin,json=quoteCoin,proto3" json:"quote_coin,omitempty"`
+	Rate          float64                `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"` // 1 base_coin is worth rate quote_coin
+	EffectiveAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_proto_transactions_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ExchangeRate) GetBaseCoin() string {
+	if x != nil {
+		return x.BaseCoin
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetQuoteCoin() string {
+	if x != nil {
+		return x.QuoteCoin
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+type ImportExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportExchangeRatesRequest) Reset() {
+	*x = ImportExchangeRatesRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExchangeRatesRequest) ProtoMessage() {}
+
+func (x *ImportExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*ImportExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ImportExchangeRatesRequest) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type ImportExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Imported      int32                  `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportExchangeRatesResponse) Reset() {
+	*x = ImportExchangeRatesResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExchangeRatesResponse) ProtoMessage() {}
+
+func (x *ImportExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*ImportExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ImportExchangeRatesResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+type ListExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseCoin      string                 `protobuf:"bytes,1,opt,name=base_coin,json=baseCoin,proto3" json:"base_coin,omitempty"`
+	QuoteCoin     string                 `protobuf:"bytes,2,opt,name=quote_coin,json=quoteCoin,proto3" json:"quote_coin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesRequest) Reset() {
+	*x = ListExchangeRatesRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesRequest) ProtoMessage() {}
+
+func (x *ListExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListExchangeRatesRequest) GetBaseCoin() string {
+	if x != nil {
+		return x.BaseCoin
+	}
+	return ""
+}
+
+func (x *ListExchangeRatesRequest) GetQuoteCoin() string {
+	if x != nil {
+		return x.QuoteCoin
+	}
+	return ""
+}
+
+type ListExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesResponse) Reset() {
+	*x = ListExchangeRatesResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesResponse) ProtoMessage() {}
+
+func (x *ListExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListExchangeRatesResponse) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type GetTransactionTotalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReferenceCoin string                 `protobuf:"bytes,1,opt,name=reference_coin,json=referenceCoin,proto3" json:"reference_coin,omitempty"`
+	Userid        string                 `protobuf:"bytes,2,opt,name=userid,proto3" json:"userid,omitempty"`
+	Coinid        string                 `protobuf:"bytes,3,opt,name=coinid,proto3" json:"coinid,omitempty"`
+	PlatformName  string                 `protobuf:"bytes,4,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionTotalsRequest) Reset() {
+	*x = GetTransactionTotalsRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionTotalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionTotalsRequest) ProtoMessage() {}
+
+func (x *GetTransactionTotalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionTotalsRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionTotalsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetTransactionTotalsRequest) GetReferenceCoin() string {
+	if x != nil {
+		return x.ReferenceCoin
+	}
+	return ""
+}
+
+func (x *GetTransactionTotalsRequest) GetUserid() string {
+	if x != nil {
+		return x.Userid
+	}
+	return ""
+}
+
+func (x *GetTransactionTotalsRequest) GetCoinid() string {
+	if x != nil {
+		return x.Coinid
+	}
+	return ""
+}
+
+func (x *GetTransactionTotalsRequest) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+func (x *GetTransactionTotalsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetTransactionTotalsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type CoinTotal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coinid        string                 `protobuf:"bytes,1,opt,name=coinid,proto3" json:"coinid,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Total         float64                `protobuf:"fixed64,3,opt,name=total,proto3" json:"total,omitempty"`            // in coinid
+	Value         float64                `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`            // in the reference coin
+	Unconverted   int64                  `protobuf:"varint,5,opt,name=unconverted,proto3" json:"unconverted,omitempty"` // rows without a rate, missing from value
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoinTotal) Reset() {
+	*x = CoinTotal{}
+	mi := &file_proto_transactions_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoinTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoinTotal) ProtoMessage() {}
+
+func (x *CoinTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoinTotal.ProtoReflect.Descriptor instead.
+func (*CoinTotal) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CoinTotal) GetCoinid() string {
+	if x != nil {
+		return x.Coinid
+	}
+	return ""
+}
+
+func (x *CoinTotal) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CoinTotal) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CoinTotal) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *CoinTotal) GetUnconverted() int64 {
+	if x != nil {
+		return x.Unconverted
+	}
+	return 0
+}
+
+type TransactionTotals struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReferenceCoin string                 `protobuf:"bytes,1,opt,name=reference_coin,json=referenceCoin,proto3" json:"reference_coin,omitempty"`
+	ByCoin        []*CoinTotal           `protobuf:"bytes,2,rep,name=by_coin,json=byCoin,proto3" json:"by_coin,omitempty"`
+	Value         float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	Complete      bool                   `protobuf:"varint,4,opt,name=complete,proto3" json:"complete,omitempty"` // false when some rows had no rate
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionTotals) Reset() {
+	*x = TransactionTotals{}
+	mi := &file_proto_transactions_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionTotals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionTotals) ProtoMessage() {}
+
+func (x *TransactionTotals) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionTotals.ProtoReflect.Descriptor instead.
+func (*TransactionTotals) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TransactionTotals) GetReferenceCoin() string {
+	if x != nil {
+		return x.ReferenceCoin
+	}
+	return ""
+}
+
+func (x *TransactionTotals) GetByCoin() []*CoinTotal {
+	if x != nil {
+		return x.ByCoin
+	}
+	return nil
+}
+
+func (x *TransactionTotals) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *TransactionTotals) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+type AccountBalance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       string                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Coinid        string                 `protobuf:"bytes,2,opt,name=coinid,proto3" json:"coinid,omitempty"`
+	Balance       float64                `protobuf:"fixed64,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountBalance) Reset() {
+	*x = AccountBalance{}
+	mi := &file_proto_transactions_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountBalance) ProtoMessage() {}
+
+func (x *AccountBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountBalance.ProtoReflect.Descriptor instead.
+func (*AccountBalance) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AccountBalance) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AccountBalance) GetCoinid() string {
+	if x != nil {
+		return x.Coinid
+	}
+	return ""
+}
+
+func (x *AccountBalance) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type GetAccountBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       string                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"` // user:<userid> or platform:<platform_name>
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountBalanceRequest) Reset() {
+	*x = GetAccountBalanceRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountBalanceRequest) ProtoMessage() {}
+
+func (x *GetAccountBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetAccountBalanceRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+type GetAccountBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balances      []*AccountBalance      `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountBalanceResponse) Reset() {
+	*x = GetAccountBalanceResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountBalanceResponse) ProtoMessage() {}
+
+func (x *GetAccountBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetAccountBalanceResponse) GetBalances() []*AccountBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+type Posting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EntryId       string                 `protobuf:"bytes,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Account       string                 `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	Coinid        string                 `protobuf:"bytes,5,opt,name=coinid,proto3" json:"coinid,omitempty"`
+	Amount        float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"` // debits are positive, credits negative
+	PostedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=posted_at,json=postedAt,proto3" json:"posted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Posting) Reset() {
+	*x = Posting{}
+	mi := &file_proto_transactions_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Posting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Posting) ProtoMessage() {}
+
+func (x *Posting) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Posting.ProtoReflect.Descriptor instead.
+func (*Posting) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Posting) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Posting) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *Posting) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Posting) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *Posting) GetCoinid() string {
+	if x != nil {
+		return x.Coinid
+	}
+	return ""
+}
+
+func (x *Posting) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Posting) GetPostedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PostedAt
+	}
+	return nil
+}
+
+type GetAccountStatementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       string                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Coinid        string                 `protobuf:"bytes,2,opt,name=coinid,proto3" json:"coinid,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountStatementRequest) Reset() {
+	*x = GetAccountStatementRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountStatementRequest) ProtoMessage() {}
+
+func (x *GetAccountStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountStatementRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountStatementRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetAccountStatementRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *GetAccountStatementRequest) GetCoinid() string {
+	if x != nil {
+		return x.Coinid
+	}
+	return ""
+}
+
+func (x *GetAccountStatementRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetAccountStatementRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetAccountStatementRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAccountStatementRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type AccountStatement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       string                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Coinid        string                 `protobuf:"bytes,2,opt,name=coinid,proto3" json:"coinid,omitempty"`
+	Opening       float64                `protobuf:"fixed64,3,opt,name=opening,proto3" json:"opening,omitempty"`
+	Closing       float64                `protobuf:"fixed64,4,opt,name=closing,proto3" json:"closing,omitempty"`
+	Postings      []*Posting             `protobuf:"bytes,5,rep,name=postings,proto3" json:"postings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountStatement) Reset() {
+	*x = AccountStatement{}
+	mi := &file_proto_transactions_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountStatement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountStatement) ProtoMessage() {}
+
+func (x *AccountStatement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountStatement.ProtoReflect.Descriptor instead.
+func (*AccountStatement) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AccountStatement) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AccountStatement) GetCoinid() string {
+	if x != nil {
+		return x.Coinid
+	}
+	return ""
+}
+
+func (x *AccountStatement) GetOpening() float64 {
+	if x != nil {
+		return x.Opening
+	}
+	return 0
+}
+
+func (x *AccountStatement) GetClosing() float64 {
+	if x != nil {
+		return x.Closing
+	}
+	return 0
+}
+
+func (x *AccountStatement) GetPostings() []*Posting {
+	if x != nil {
+		return x.Postings
+	}
+	return nil
+}
+
+type CaptureTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptureTransactionRequest) Reset() {
+	*x = CaptureTransactionRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureTransactionRequest) ProtoMessage() {}
+
+func (x *CaptureTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureTransactionRequest.ProtoReflect.Descriptor instead.
+func (*CaptureTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CaptureTransactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type VoidTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidTransactionRequest) Reset() {
+	*x = VoidTransactionRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidTransactionRequest) ProtoMessage() {}
+
+func (x *VoidTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidTransactionRequest.ProtoReflect.Descriptor instead.
+func (*VoidTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *VoidTransactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VoidTransactionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListStatusChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStatusChangesRequest) Reset() {
+	*x = ListStatusChangesRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStatusChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatusChangesRequest) ProtoMessage() {}
+
+func (x *ListStatusChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStatusChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListStatusChangesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListStatusChangesRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type StatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"` // pending for the creation
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Actor         string                 `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusChange) Reset() {
+	*x = StatusChange{}
+	mi := &file_proto_transactions_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusChange) ProtoMessage() {}
+
+func (x *StatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusChange.ProtoReflect.Descriptor instead.
+func (*StatusChange) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *StatusChange) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *StatusChange) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *StatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StatusChange) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *StatusChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type ListStatusChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*StatusChange        `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStatusChangesResponse) Reset() {
+	*x = ListStatusChangesResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStatusChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatusChangesResponse) ProtoMessage() {}
+
+func (x *ListStatusChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStatusChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListStatusChangesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListStatusChangesResponse) GetChanges() []*StatusChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type UpdateTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`                        // version the edit is based on
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // expiry_date, platform_name
+	ExpiryDate    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`
+	PlatformName  string                 `protobuf:"bytes,5,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTransactionRequest) Reset() {
+	*x = UpdateTransactionRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransactionRequest) ProtoMessage() {}
+
+func (x *UpdateTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransactionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpdateTransactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateTransactionRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateTransactionRequest) GetExpiryDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiryDate
+	}
+	return nil
+}
+
+func (x *UpdateTransactionRequest) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_proto_transactions_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *FieldChange) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type TransactionRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int64                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // version the edit produced
+	Changes       []*FieldChange         `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Actor         string                 `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionRevision) Reset() {
+	*x = TransactionRevision{}
+	mi := &file_proto_transactions_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionRevision) ProtoMessage() {}
+
+func (x *TransactionRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionRevision.ProtoReflect.Descriptor instead.
+func (*TransactionRevision) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TransactionRevision) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TransactionRevision) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *TransactionRevision) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TransactionRevision) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *TransactionRevision) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type ListTransactionRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionRevisionsRequest) Reset() {
+	*x = ListTransactionRevisionsRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionRevisionsRequest) ProtoMessage() {}
+
+func (x *ListTransactionRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListTransactionRevisionsRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type ListTransactionRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*TransactionRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionRevisionsResponse) Reset() {
+	*x = ListTransactionRevisionsResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionRevisionsResponse) ProtoMessage() {}
+
+func (x *ListTransactionRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListTransactionRevisionsResponse) GetRevisions() []*TransactionRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type ExtendAccessRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Userid          string                 `protobuf:"bytes,1,opt,name=userid,proto3" json:"userid,omitempty"`
+	Dataid          string                 `protobuf:"bytes,2,opt,name=dataid,proto3" json:"dataid,omitempty"`
+	PlatformName    string                 `protobuf:"bytes,3,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	Coinid          string                 `protobuf:"bytes,4,opt,name=coinid,proto3" json:"coinid,omitempty"`
+	Coinused        float64                `protobuf:"fixed64,5,opt,name=coinused,proto3" json:"coinused,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // 0 uses the platform's default access
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExtendAccessRequest) Reset() {
+	*x = ExtendAccessRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendAccessRequest) ProtoMessage() {}
+
+func (x *ExtendAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendAccessRequest.ProtoReflect.Descriptor instead.
+func (*ExtendAccessRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ExtendAccessRequest) GetUserid() string {
+	if x != nil {
+		return x.Userid
+	}
+	return ""
+}
+
+func (x *ExtendAccessRequest) GetDataid() string {
+	if x != nil {
+		return x.Dataid
+	}
+	return ""
+}
+
+func (x *ExtendAccessRequest) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+func (x *ExtendAccessRequest) GetCoinid() string {
+	if x != nil {
+		return x.Coinid
+	}
+	return ""
+}
+
+func (x *ExtendAccessRequest) GetCoinused() float64 {
+	if x != nil {
+		return x.Coinused
+	}
+	return 0
+}
+
+func (x *ExtendAccessRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type ExtendAccessResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Transaction    *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"` // the renewal charge
+	OriginalId     string                 `protobuf:"bytes,2,opt,name=original_id,json=originalId,proto3" json:"original_id,omitempty"`
+	PreviousExpiry *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=previous_expiry,json=previousExpiry,proto3" json:"previous_expiry,omitempty"`
+	ExpiryDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExtendAccessResponse) Reset() {
+	*x = ExtendAccessResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendAccessResponse) ProtoMessage() {}
+
+func (x *ExtendAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendAccessResponse.ProtoReflect.Descriptor instead.
+func (*ExtendAccessResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ExtendAccessResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *ExtendAccessResponse) GetOriginalId() string {
+	if x != nil {
+		return x.OriginalId
+	}
+	return ""
+}
+
+func (x *ExtendAccessResponse) GetPreviousExpiry() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousExpiry
+	}
+	return nil
+}
+
+func (x *ExtendAccessResponse) GetExpiryDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiryDate
+	}
+	return nil
+}
+
+type SubscriptionPlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PlatformName  string                 `protobuf:"bytes,3,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	Dataid        string                 `protobuf:"bytes,4,opt,name=dataid,proto3" json:"dataid,omitempty"`
+	Coinid        string                 `protobuf:"bytes,5,opt,name=coinid,proto3" json:"coinid,omitempty"`
+	Amount        float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Period        string                 `protobuf:"bytes,7,opt,name=period,proto3" json:"period,omitempty"`  // daily, weekly, monthly or yearly
+	Active        bool                   `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"` // open to new subscribers
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscriptionPlan) Reset() {
+	*x = SubscriptionPlan{}
+	mi := &file_proto_transactions_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionPlan) ProtoMessage() {}
+
+func (x *SubscriptionPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionPlan.ProtoReflect.Descriptor instead.
+func (*SubscriptionPlan) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SubscriptionPlan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SubscriptionPlan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubscriptionPlan) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+func (x *SubscriptionPlan) GetDataid() string {
+	if x != nil {
+		return x.Dataid
+	}
+	return ""
+}
+
+func (x *SubscriptionPlan) GetCoinid() string {
+	if x != nil {
+		return x.Coinid
+	}
+	return ""
+}
+
+func (x *SubscriptionPlan) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SubscriptionPlan) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *SubscriptionPlan) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *SubscriptionPlan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SetSubscriptionPlanActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Active        bool                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSubscriptionPlanActiveRequest) Reset() {
+	*x = SetSubscriptionPlanActiveRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSubscriptionPlanActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSubscriptionPlanActiveRequest) ProtoMessage() {}
+
+func (x *SetSubscriptionPlanActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSubscriptionPlanActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetSubscriptionPlanActiveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SetSubscriptionPlanActiveRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetSubscriptionPlanActiveRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type ListSubscriptionPlansRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeInactive bool                   `protobuf:"varint,1,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionPlansRequest) Reset() {
+	*x = ListSubscriptionPlansRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionPlansRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionPlansRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListSubscriptionPlansRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type ListSubscriptionPlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plans         []*SubscriptionPlan    `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionPlansResponse) Reset() {
+	*x = ListSubscriptionPlansResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionPlansResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionPlansResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListSubscriptionPlansResponse) GetPlans() []*SubscriptionPlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+type Subscription struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlanId         string                 `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Userid         string                 `protobuf:"bytes,3,opt,name=userid,proto3" json:"userid,omitempty"`
+	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // active, past_due, paused, cancelled or lapsed
+	StartedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	Cycle          int32                  `protobuf:"varint,6,opt,name=cycle,proto3" json:"cycle,omitempty"` // periods charged so far
+	NextChargeAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=next_charge_at,json=nextChargeAt,proto3" json:"next_charge_at,omitempty"`
+	FailedAttempts int32                  `protobuf:"varint,8,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+	GraceUntil     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=grace_until,json=graceUntil,proto3" json:"grace_until,omitempty"`
+	LastError      string                 `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_proto_transactions_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Subscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Subscription) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *Subscription) GetUserid() string {
+	if x != nil {
+		return x.Userid
+	}
+	return ""
+}
+
+func (x *Subscription) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Subscription) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Subscription) GetCycle() int32 {
+	if x != nil {
+		return x.Cycle
+	}
+	return 0
+}
+
+func (x *Subscription) GetNextChargeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextChargeAt
+	}
+	return nil
+}
+
+func (x *Subscription) GetFailedAttempts() int32 {
+	if x != nil {
+		return x.FailedAttempts
+	}
+	return 0
+}
+
+func (x *Subscription) GetGraceUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GraceUntil
+	}
+	return nil
+}
+
+func (x *Subscription) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Subscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Subscription) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SubscribeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        string                 `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Userid        string                 `protobuf:"bytes,2,opt,name=userid,proto3" json:"userid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SubscribeRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetUserid() string {
+	if x != nil {
+		return x.Userid
+	}
+	return ""
+}
+
+type SubscribeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	Transaction   *Transaction           `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"` // the charge for the first period
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeResponse) Reset() {
+	*x = SubscribeResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeResponse) ProtoMessage() {}
+
+func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SubscribeResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *SubscribeResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type GetSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubscriptionRequest) Reset() {
+	*x = GetSubscriptionRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionRequest) ProtoMessage() {}
+
+func (x *GetSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Userid        string                 `protobuf:"bytes,1,opt,name=userid,proto3" json:"userid,omitempty"` // non-admin callers only see their own subscriptions
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsRequest) Reset() {
+	*x = ListSubscriptionsRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListSubscriptionsRequest) GetUserid() string {
+	if x != nil {
+		return x.Userid
+	}
+	return ""
+}
+
+func (x *ListSubscriptionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*Subscription        `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsResponse) Reset() {
+	*x = ListSubscriptionsResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListSubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type CancelSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSubscriptionRequest) Reset() {
+	*x = CancelSubscriptionRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSubscriptionRequest) ProtoMessage() {}
+
+func (x *CancelSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CancelSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PauseSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseSubscriptionRequest) Reset() {
+	*x = PauseSubscriptionRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseSubscriptionRequest) ProtoMessage() {}
+
+func (x *PauseSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*PauseSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PauseSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ResumeSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeSubscriptionRequest) Reset() {
+	*x = ResumeSubscriptionRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSubscriptionRequest) ProtoMessage() {}
+
+func (x *ResumeSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ResumeSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SubscriptionEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscriptionId string                 `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	PlatformName   string                 `protobuf:"bytes,3,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	Userid         string                 `protobuf:"bytes,4,opt,name=userid,proto3" json:"userid,omitempty"`
+	Type           string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"` // charged, charge_failed, lapsed, paused, resumed or cancelled
+	TransactionId  string                 `protobuf:"bytes,6,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Detail         string                 `protobuf:"bytes,7,opt,name=detail,proto3" json:"detail,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeliveredAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubscriptionEvent) Reset() {
+	*x = SubscriptionEvent{}
+	mi := &file_proto_transactions_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionEvent) ProtoMessage() {}
+
+func (x *SubscriptionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionEvent.ProtoReflect.Descriptor instead.
+func (*SubscriptionEvent) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SubscriptionEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SubscriptionEvent) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *SubscriptionEvent) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+func (x *SubscriptionEvent) GetUserid() string {
+	if x != nil {
+		return x.Userid
+	}
+	return ""
+}
+
+func (x *SubscriptionEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SubscriptionEvent) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *SubscriptionEvent) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *SubscriptionEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SubscriptionEvent) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+type ListSubscriptionEventsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId string                 `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionEventsRequest) Reset() {
+	*x = ListSubscriptionEventsRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionEventsRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListSubscriptionEventsRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+type ListSubscriptionEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*SubscriptionEvent   `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionEventsResponse) Reset() {
+	*x = ListSubscriptionEventsResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionEventsResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListSubscriptionEventsResponse) GetEvents() []*SubscriptionEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ScheduledTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Coinid        string                 `protobuf:"bytes,2,opt,name=coinid,proto3" json:"coinid,omitempty"`
+	Userid        string                 `protobuf:"bytes,3,opt,name=userid,proto3" json:"userid,omitempty"`
+	Dataid        string                 `protobuf:"bytes,4,opt,name=dataid,proto3" json:"dataid,omitempty"`
+	Coinused      float64                `protobuf:"fixed64,5,opt,name=coinused,proto3" json:"coinused,omitempty"`
+	ExpiryDate    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"` // unset defaults from the platform at execution
+	PlatformName  string                 `protobuf:"bytes,7,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	ExecuteAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=execute_at,json=executeAt,proto3" json:"execute_at,omitempty"`
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`                                     // pending, executed, failed or cancelled
+	TransactionId string                 `protobuf:"bytes,10,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"` // set once executed
+	Error         string                 `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`                                      // why the last execution failed
+	CreatedBy     string                 `protobuf:"bytes,12,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledTransaction) Reset() {
+	*x = ScheduledTransaction{}
+	mi := &file_proto_transactions_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledTransaction) ProtoMessage() {}
+
+func (x *ScheduledTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledTransaction.ProtoReflect.Descriptor instead.
+func (*ScheduledTransaction) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ScheduledTransaction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduledTransaction) GetCoinid() string {
+	if x != nil {
+		return x.Coinid
+	}
+	return ""
+}
+
+func (x *ScheduledTransaction) GetUserid() string {
+	if x != nil {
+		return x.Userid
+	}
+	return ""
+}
+
+func (x *ScheduledTransaction) GetDataid() string {
+	if x != nil {
+		return x.Dataid
+	}
+	return ""
+}
+
+func (x *ScheduledTransaction) GetCoinused() float64 {
+	if x != nil {
+		return x.Coinused
+	}
+	return 0
+}
+
+func (x *ScheduledTransaction) GetExpiryDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiryDate
+	}
+	return nil
+}
+
+func (x *ScheduledTransaction) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+func (x *ScheduledTransaction) GetExecuteAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExecuteAt
+	}
+	return nil
+}
+
+func (x *ScheduledTransaction) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ScheduledTransaction) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *ScheduledTransaction) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ScheduledTransaction) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ScheduledTransaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ScheduledTransaction) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ScheduleTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coinid        string                 `protobuf:"bytes,1,opt,name=coinid,proto3" json:"coinid,omitempty"`
+	Userid        string                 `protobuf:"bytes,2,opt,name=userid,proto3" json:"userid,omitempty"`
+	Dataid        string                 `protobuf:"bytes,3,opt,name=dataid,proto3" json:"dataid,omitempty"`
+	Coinused      float64                `protobuf:"fixed64,4,opt,name=coinused,proto3" json:"coinused,omitempty"`
+	ExpiryDate    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"` // unset defaults from the platform at execution
+	PlatformName  string                 `protobuf:"bytes,6,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	ExecuteAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=execute_at,json=executeAt,proto3" json:"execute_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleTransactionRequest) Reset() {
+	*x = ScheduleTransactionRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleTransactionRequest) ProtoMessage() {}
+
+func (x *ScheduleTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleTransactionRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ScheduleTransactionRequest) GetCoinid() string {
+	if x != nil {
+		return x.Coinid
+	}
+	return ""
+}
+
+func (x *ScheduleTransactionRequest) GetUserid() string {
+	if x != nil {
+		return x.Userid
+	}
+	return ""
+}
+
+func (x *ScheduleTransactionRequest) GetDataid() string {
+	if x != nil {
+		return x.Dataid
+	}
+	return ""
+}
+
+func (x *ScheduleTransactionRequest) GetCoinused() float64 {
+	if x != nil {
+		return x.Coinused
+	}
+	return 0
+}
+
+func (x *ScheduleTransactionRequest) GetExpiryDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiryDate
+	}
+	return nil
+}
+
+func (x *ScheduleTransactionRequest) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+func (x *ScheduleTransactionRequest) GetExecuteAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExecuteAt
+	}
+	return nil
+}
+
+type ListScheduledTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Userid        string                 `protobuf:"bytes,1,opt,name=userid,proto3" json:"userid,omitempty"` // non-admin callers only see spends they own
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledTransactionsRequest) Reset() {
+	*x = ListScheduledTransactionsRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledTransactionsRequest) ProtoMessage() {}
+
+func (x *ListScheduledTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListScheduledTransactionsRequest) GetUserid() string {
+	if x != nil {
+		return x.Userid
+	}
+	return ""
+}
+
+func (x *ListScheduledTransactionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListScheduledTransactionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListScheduledTransactionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListScheduledTransactionsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Scheduled     []*ScheduledTransaction `protobuf:"bytes,1,rep,name=scheduled,proto3" json:"scheduled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledTransactionsResponse) Reset() {
+	*x = ListScheduledTransactionsResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledTransactionsResponse) ProtoMessage() {}
+
+func (x *ListScheduledTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListScheduledTransactionsResponse) GetScheduled() []*ScheduledTransaction {
+	if x != nil {
+		return x.Scheduled
+	}
+	return nil
+}
+
+type CancelScheduledTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledTransactionRequest) Reset() {
+	*x = CancelScheduledTransactionRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledTransactionRequest) ProtoMessage() {}
+
+func (x *CancelScheduledTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledTransactionRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CancelScheduledTransactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type FraudFinding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // flag, hold or reject
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FraudFinding) Reset() {
+	*x = FraudFinding{}
+	mi := &file_proto_transactions_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FraudFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FraudFinding) ProtoMessage() {}
+
+func (x *FraudFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FraudFinding.ProtoReflect.Descriptor instead.
+func (*FraudFinding) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *FraudFinding) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *FraudFinding) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *FraudFinding) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type FraudReview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Userid        string                 `protobuf:"bytes,3,opt,name=userid,proto3" json:"userid,omitempty"`
+	PlatformName  string                 `protobuf:"bytes,4,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	Coinused      float64                `protobuf:"fixed64,5,opt,name=coinused,proto3" json:"coinused,omitempty"`
+	Action        string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"` // flag (already captured) or hold (captured on approval, voided on denial)
+	Findings      []*FraudFinding        `protobuf:"bytes,7,rep,name=findings,proto3" json:"findings,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // open, approved or denied
+	ReviewedBy    string                 `protobuf:"bytes,9,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	Note          string                 `protobuf:"bytes,10,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReviewedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FraudReview) Reset() {
+	*x = FraudReview{}
+	mi := &file_proto_transactions_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FraudReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FraudReview) ProtoMessage() {}
+
+func (x *FraudReview) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FraudReview.ProtoReflect.Descriptor instead.
+func (*FraudReview) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *FraudReview) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FraudReview) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *FraudReview) GetUserid() string {
+	if x != nil {
+		return x.Userid
+	}
+	return ""
+}
+
+func (x *FraudReview) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+func (x *FraudReview) GetCoinused() float64 {
+	if x != nil {
+		return x.Coinused
+	}
+	return 0
+}
+
+func (x *FraudReview) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *FraudReview) GetFindings() []*FraudFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *FraudReview) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FraudReview) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *FraudReview) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *FraudReview) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FraudReview) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+type ListFraudReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFraudReviewsRequest) Reset() {
+	*x = ListFraudReviewsRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFraudReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFraudReviewsRequest) ProtoMessage() {}
+
+func (x *ListFraudReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFraudReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListFraudReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListFraudReviewsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListFraudReviewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListFraudReviewsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListFraudReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*FraudReview         `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFraudReviewsResponse) Reset() {
+	*x = ListFraudReviewsResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFraudReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFraudReviewsResponse) ProtoMessage() {}
+
+func (x *ListFraudReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFraudReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListFraudReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListFraudReviewsResponse) GetReviews() []*FraudReview {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+type DecideFraudReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideFraudReviewRequest) Reset() {
+	*x = DecideFraudReviewRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideFraudReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideFraudReviewRequest) ProtoMessage() {}
+
+func (x *DecideFraudReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideFraudReviewRequest.ProtoReflect.Descriptor instead.
+func (*DecideFraudReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DecideFraudReviewRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DecideFraudReviewRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ReconcileSettlementRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	PlatformName         string                 `protobuf:"bytes,1,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	Csv                  []byte                 `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"` // header dataid,userid,coinused,timestamp
+	FileName             string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	AmountTolerance      *float64               `protobuf:"fixed64,4,opt,name=amount_tolerance,json=amountTolerance,proto3,oneof" json:"amount_tolerance,omitempty"`                 // defaults from the server config
+	TimeToleranceSeconds *int64                 `protobuf:"varint,5,opt,name=time_tolerance_seconds,json=timeToleranceSeconds,proto3,oneof" json:"time_tolerance_seconds,omitempty"` // defaults from the server config
+	From                 *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`                                                                      // defaults to the first line minus the time tolerance
+	To                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`                                                                          // defaults to the last line plus the time tolerance
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ReconcileSettlementRequest) Reset() {
+	*x = ReconcileSettlementRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileSettlementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileSettlementRequest) ProtoMessage() {}
+
+func (x *ReconcileSettlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileSettlementRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileSettlementRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ReconcileSettlementRequest) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+func (x *ReconcileSettlementRequest) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+func (x *ReconcileSettlementRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ReconcileSettlementRequest) GetAmountTolerance() float64 {
+	if x != nil && x.AmountTolerance != nil {
+		return *x.AmountTolerance
+	}
+	return 0
+}
+
+func (x *ReconcileSettlementRequest) GetTimeToleranceSeconds() int64 {
+	if x != nil && x.TimeToleranceSeconds != nil {
+		return *x.TimeToleranceSeconds
+	}
+	return 0
+}
+
+func (x *ReconcileSettlementRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ReconcileSettlementRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ReconciliationRun struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlatformName         string                 `protobuf:"bytes,2,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	FileName             string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	From                 *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	AmountTolerance      float64                `protobuf:"fixed64,6,opt,name=amount_tolerance,json=amountTolerance,proto3" json:"amount_tolerance,omitempty"`
+	TimeToleranceSeconds int64                  `protobuf:"varint,7,opt,name=time_tolerance_seconds,json=timeToleranceSeconds,proto3" json:"time_tolerance_seconds,omitempty"`
+	Matched              int32                  `protobuf:"varint,8,opt,name=matched,proto3" json:"matched,omitempty"`
+	MissingOurs          int32                  `protobuf:"varint,9,opt,name=missing_ours,json=missingOurs,proto3" json:"missing_ours,omitempty"`        // settlement lines without a transaction
+	MissingTheirs        int32                  `protobuf:"varint,10,opt,name=missing_theirs,json=missingTheirs,proto3" json:"missing_theirs,omitempty"` // transactions absent from the settlement file
+	AmountMismatches     int32                  `protobuf:"varint,11,opt,name=amount_mismatches,json=amountMismatches,proto3" json:"amount_mismatches,omitempty"`
+	CreatedBy            string                 `protobuf:"bytes,12,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ReconciliationRun) Reset() {
+	*x = ReconciliationRun{}
+	mi := &file_proto_transactions_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationRun) ProtoMessage() {}
+
+func (x *ReconciliationRun) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationRun.ProtoReflect.Descriptor instead.
+func (*ReconciliationRun) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ReconciliationRun) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReconciliationRun) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+func (x *ReconciliationRun) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ReconciliationRun) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ReconciliationRun) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ReconciliationRun) GetAmountTolerance() float64 {
+	if x != nil {
+		return x.AmountTolerance
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetTimeToleranceSeconds() int64 {
+	if x != nil {
+		return x.TimeToleranceSeconds
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetMatched() int32 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetMissingOurs() int32 {
+	if x != nil {
+		return x.MissingOurs
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetMissingTheirs() int32 {
+	if x != nil {
+		return x.MissingTheirs
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetAmountMismatches() int32 {
+	if x != nil {
+		return x.AmountMismatches
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ReconciliationRun) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListReconciliationRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlatformName  string                 `protobuf:"bytes,1,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReconciliationRunsRequest) Reset() {
+	*x = ListReconciliationRunsRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReconciliationRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationRunsRequest) ProtoMessage() {}
+
+func (x *ListReconciliationRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListReconciliationRunsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListReconciliationRunsRequest) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+func (x *ListReconciliationRunsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListReconciliationRunsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListReconciliationRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*ReconciliationRun   `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReconciliationRunsResponse) Reset() {
+	*x = ListReconciliationRunsResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReconciliationRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationRunsResponse) ProtoMessage() {}
+
+func (x *ListReconciliationRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListReconciliationRunsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListReconciliationRunsResponse) GetRuns() []*ReconciliationRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type ReconciliationItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RunId          string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Kind           string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`     // matched, missing_ours, missing_theirs or amount_mismatch
+	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // open or resolved
+	Dataid         string                 `protobuf:"bytes,5,opt,name=dataid,proto3" json:"dataid,omitempty"`
+	Userid         string                 `protobuf:"bytes,6,opt,name=userid,proto3" json:"userid,omitempty"`
+	TheirAmount    *float64               `protobuf:"fixed64,7,opt,name=their_amount,json=theirAmount,proto3,oneof" json:"their_amount,omitempty"`
+	OurAmount      *float64               `protobuf:"fixed64,8,opt,name=our_amount,json=ourAmount,proto3,oneof" json:"our_amount,omitempty"`
+	TheirTimestamp *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=their_timestamp,json=theirTimestamp,proto3" json:"their_timestamp,omitempty"`
+	OurTimestamp   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=our_timestamp,json=ourTimestamp,proto3" json:"our_timestamp,omitempty"`
+	TransactionId  string                 `protobuf:"bytes,11,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Resolution     string                 `protobuf:"bytes,12,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	ResolvedBy     string                 `protobuf:"bytes,13,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	ResolvedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReconciliationItem) Reset() {
+	*x = ReconciliationItem{}
+	mi := &file_proto_transactions_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationItem) ProtoMessage() {}
+
+func (x *ReconciliationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationItem.ProtoReflect.Descriptor instead.
+func (*ReconciliationItem) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ReconciliationItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReconciliationItem) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *ReconciliationItem) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReconciliationItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReconciliationItem) GetDataid() string {
+	if x != nil {
+		return x.Dataid
+	}
+	return ""
+}
+
+func (x *ReconciliationItem) GetUserid() string {
+	if x != nil {
+		return x.Userid
+	}
+	return ""
+}
+
+func (x *ReconciliationItem) GetTheirAmount() float64 {
+	if x != nil && x.TheirAmount != nil {
+		return *x.TheirAmount
+	}
+	return 0
+}
+
+func (x *ReconciliationItem) GetOurAmount() float64 {
+	if x != nil && x.OurAmount != nil {
+		return *x.OurAmount
+	}
+	return 0
+}
+
+func (x *ReconciliationItem) GetTheirTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TheirTimestamp
+	}
+	return nil
+}
+
+func (x *ReconciliationItem) GetOurTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OurTimestamp
+	}
+	return nil
+}
+
+func (x *ReconciliationItem) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *ReconciliationItem) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *ReconciliationItem) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+func (x *ReconciliationItem) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+type ListReconciliationItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"` // empty lists items of every run
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReconciliationItemsRequest) Reset() {
+	*x = ListReconciliationItemsRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReconciliationItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationItemsRequest) ProtoMessage() {}
+
+func (x *ListReconciliationItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListReconciliationItemsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListReconciliationItemsRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *ListReconciliationItemsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ListReconciliationItemsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListReconciliationItemsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListReconciliationItemsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListReconciliationItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ReconciliationItem  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReconciliationItemsResponse) Reset() {
+	*x = ListReconciliationItemsResponse{}
+	mi := &file_proto_transactions_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReconciliationItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationItemsResponse) ProtoMessage() {}
+
+func (x *ListReconciliationItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListReconciliationItemsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListReconciliationItemsResponse) GetItems() []*ReconciliationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ResolveReconciliationItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveReconciliationItemRequest) Reset() {
+	*x = ResolveReconciliationItemRequest{}
+	mi := &file_proto_transactions_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReconciliationItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReconciliationItemRequest) ProtoMessage() {}
+
+func (x *ResolveReconciliationItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transactions_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReconciliationItemRequest.ProtoReflect.Descriptor instead.
+func (*ResolveReconciliationItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transactions_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ResolveReconciliationItemRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResolveReconciliationItemRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+var File_proto_transactions_proto protoreflect.FileDescriptor
+
+const file_proto_transactions_proto_rawDesc = "" +
+	"\n" +
+	"\x18proto/transactions.proto\x12\x0ftransactions.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd7\x03\n" +
+	"\x18CreateTransactionRequest\x12\x16\n" +
+	"\x06coinid\x18\x01 \x01(\tR\x06coinid\x12\x16\n" +
+	"\x06userid\x18\x02 \x01(\tR\x06userid\x12\x16\n" +
+	"\x06dataid\x18\x03 \x01(\tR\x06dataid\x12\x1a\n" +
+	"\bcoinused\x18\x04 \x01(\x01R\bcoinused\x12O\n" +
+	"\x15transaction_timestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x14transactionTimestamp\x12;\n" +
+	"\vexpiry_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expiryDate\x12#\n" +
+	"\rplatform_name\x18\a \x01(\tR\fplatformName\x12S\n" +
+	"\bmetadata\x18\b \x03(\v27.transactions.v1.CreateTransactionRequest.MetadataEntryR\bmetadata\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb8\x05\n" +
+	"\vTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06coinid\x18\x02 \x01(\tR\x06coinid\x12\x16\n" +
+	"\x06userid\x18\x03 \x01(\tR\x06userid\x12\x16\n" +
+	"\x06dataid\x18\x04 \x01(\tR\x06dataid\x12\x1a\n" +
+	"\bcoinused\x18\x05 \x01(\x01R\bcoinused\x12O\n" +
+	"\x15transaction_timestamp\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x14transactionTimestamp\x12;\n" +
+	"\vexpiry_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expiryDate\x12#\n" +
+	"\rplatform_name\x18\b \x01(\tR\fplatformName\x12\x1b\n" +
+	"\ttenant_id\x18\t \x01(\tR\btenantId\x129\n" +
+	"\n" +
+	"deleted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12B\n" +
+	"\x0fhold_expires_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\rholdExpiresAt\x12\x1b\n" +
+	"\trenews_id\x18\r \x01(\tR\brenewsId\x12\x18\n" +
+	"\aversion\x18\x0e \x01(\x03R\aversion\x12F\n" +
+	"\bmetadata\x18\x0f \x03(\v2*.transactions.v1.Transaction.MetadataEntryR\bmetadata\x12\x12\n" +
+	"\x04tags\x18\x10 \x03(\tR\x04tags\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe5\x05\n" +
+	"\x11TransactionFilter\x12\x18\n" +
+	"\auserids\x18\x01 \x03(\tR\auserids\x12\x18\n" +
+	"\acoinids\x18\x02 \x03(\tR\acoinids\x12\x18\n" +
+	"\adataids\x18\x03 \x03(\tR\adataids\x12%\n" +
+	"\x0eplatform_names\x18\x04 \x03(\tR\rplatformNames\x12#\n" +
+	"\rdataid_prefix\x18\x05 \x01(\tR\fdataidPrefix\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12&\n" +
+	"\fmin_coinused\x18\a \x01(\x01H\x00R\vminCoinused\x88\x01\x01\x12&\n" +
+	"\fmax_coinused\x18\b \x01(\x01H\x01R\vmaxCoinused\x88\x01\x01\x12.\n" +
+	"\x04from\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12=\n" +
+	"\fexpires_from\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vexpiresFrom\x129\n" +
+	"\n" +
+	"expires_to\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\texpiresTo\x127\n" +
+	"\tactive_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\bactiveAt\x12L\n" +
+	"\bmetadata\x18\x0e \x03(\v20.transactions.v1.TransactionFilter.MetadataEntryR\bmetadata\x12\x12\n" +
+	"\x04tags\x18\x0f \x03(\tR\x04tags\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0f\n" +
+	"\r_min_coinusedB\x0f\n" +
+	"\r_max_coinused\"\x8a\x02\n" +
+	"\x17ListTransactionsRequest\x12:\n" +
+	"\x06filter\x18\x01 \x01(\v2\".transactions.v1.TransactionFilterR\x06filter\x12>\n" +
+	"\asort_by\x18\x02 \x01(\x0e2%.transactions.v1.TransactionSortFieldR\x06sortBy\x12\x1c\n" +
+	"\tascending\x18\x03 \x01(\bR\tascending\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\x12'\n" +
+	"\x0finclude_deleted\x18\x06 \x01(\bR\x0eincludeDeleted\"\\\n" +
+	"\x18ListTransactionsResponse\x12@\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1c.transactions.v1.TransactionR\ftransactions\"\xbe\x01\n" +
+	"\vSpendingCap\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06userid\x18\x02 \x01(\tR\x06userid\x12\x16\n" +
+	"\x06coinid\x18\x03 \x01(\tR\x06coinid\x12#\n" +
+	"\rplatform_name\x18\x04 \x01(\tR\fplatformName\x122\n" +
+	"\x06period\x18\x05 \x01(\x0e2\x1a.transactions.v1.CapPeriodR\x06period\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\"G\n" +
+	"\x15SetSpendingCapRequest\x12.\n" +
+	"\x03cap\x18\x01 \x01(\v2\x1c.transactions.v1.SpendingCapR\x03cap\"1\n" +
+	"\x17ListSpendingCapsRequest\x12\x16\n" +
+	"\x06userid\x18\x01 \x01(\tR\x06userid\"L\n" +
+	"\x18ListSpendingCapsResponse\x120\n" +
+	"\x04caps\x18\x01 \x03(\v2\x1c.transactions.v1.SpendingCapR\x04caps\"\xa2\x01\n" +
+	"\x17ClearSpendingCapRequest\x12\x16\n" +
+	"\x06userid\x18\x01 \x01(\tR\x06userid\x12\x16\n" +
+	"\x06coinid\x18\x02 \x01(\tR\x06coinid\x12#\n" +
+	"\rplatform_name\x18\x03 \x01(\tR\fplatformName\x122\n" +
+	"\x06period\x18\x04 \x01(\x0e2\x1a.transactions.v1.CapPeriodR\x06period\"4\n" +
+	"\x18ClearSpendingCapResponse\x12\x18\n" +
+	"\acleared\x18\x01 \x01(\bR\acleared\"p\n" +
+	"\x19GetRemainingBudgetRequest\x12\x16\n" +
+	"\x06userid\x18\x01 \x01(\tR\x06userid\x12\x16\n" +
+	"\x06coinid\x18\x02 \x01(\tR\x06coinid\x12#\n" +
+	"\rplatform_name\x18\x03 \x01(\tR\fplatformName\"\xab\x01\n" +
+	"\x06Budget\x12.\n" +
+	"\x03cap\x18\x01 \x01(\v2\x1c.transactions.v1.SpendingCapR\x03cap\x12\x14\n" +
+	"\x05spent\x18\x02 \x01(\x01R\x05spent\x12\x1c\n" +
+	"\tremaining\x18\x03 \x01(\x01R\tremaining\x12=\n" +
+	"\fwindow_start\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\"O\n" +
+	"\x1aGetRemainingBudgetResponse\x121\n" +
+	"\abudgets\x18\x01 \x03(\v2\x17.transactions.v1.BudgetR\abudgets\"\x9a\x03\n" +
+	"\n" +
+	"AuditEvent\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x03R\x03seq\x12;\n" +
+	"\voccurred_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x14\n" +
+	"\x05admin\x18\x04 \x01(\bR\x05admin\x12\x1c\n" +
+	"\ttransport\x18\x05 \x01(\tR\ttransport\x12\x1c\n" +
+	"\toperation\x18\x06 \x01(\tR\toperation\x12\x12\n" +
+	"\x04args\x18\a \x01(\tR\x04args\x12\x1d\n" +
+	"\n" +
+	"result_ids\x18\b \x03(\tR\tresultIds\x12\x18\n" +
+	"\aoutcome\x18\t \x01(\tR\aoutcome\x12\x1b\n" +
+	"\tclient_ip\x18\n" +
+	" \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\v \x01(\tR\trequestId\x12\x1b\n" +
+	"\tprev_hash\x18\f \x01(\tR\bprevHash\x12\x12\n" +
+	"\x04hash\x18\r \x01(\tR\x04hash\x12\x1b\n" +
+	"\ttenant_id\x18\x0e \x01(\tR\btenantId\"\xf3\x01\n" +
+	"\x16ListAuditEventsRequest\x12\x14\n" +
+	"\x05actor\x18\x01 \x01(\tR\x05actor\x12\x1c\n" +
+	"\toperation\x18\x02 \x01(\tR\toperation\x12\x1b\n" +
+	"\tresult_id\x18\x03 \x01(\tR\bresultId\x12.\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\a \x01(\x05R\x06offset\"N\n" +
+	"\x17ListAuditEventsResponse\x123\n" +
+	"\x06events\x18\x01 \x03(\v2\x1b.transactions.v1.AuditEventR\x06events\"\xec\x01\n" +
+	"\x06Tenant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12+\n" +
+	"\x12ip_rate_per_minute\x18\x03 \x01(\x05R\x0fipRatePerMinute\x12/\n" +
+	"\x14user_rate_per_minute\x18\x04 \x01(\x05R\x11userRatePerMinute\x12%\n" +
+	"\x0eretention_days\x18\x05 \x01(\x05R\rretentionDays\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"]\n" +
+	"\x11TenantCredentials\x12/\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x17.transactions.v1.TenantR\x06tenant\x12\x17\n" +
+	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\"\x14\n" +
+	"\x12ListTenantsRequest\"H\n" +
+	"\x13ListTenantsResponse\x121\n" +
+	"\atenants\x18\x01 \x03(\v2\x17.transactions.v1.TenantR\atenants\"(\n" +
+	"\x16RotateTenantKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"*\n" +
+	"\x10EraseUserRequest\x12\x16\n" +
+	"\x06userid\x18\x01 \x01(\tR\x06userid\"\xa3\x02\n" +
+	"\x0eErasureReceipt\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1c\n" +
+	"\tpseudonym\x18\x03 \x01(\tR\tpseudonym\x12!\n" +
+	"\fsubject_hash\x18\x04 \x01(\tR\vsubjectHash\x12\"\n" +
+	"\ftransactions\x18\x05 \x01(\x03R\ftransactions\x12#\n" +
+	"\rspending_caps\x18\x06 \x01(\x03R\fspendingCaps\x12!\n" +
+	"\frequested_by\x18\a \x01(\tR\vrequestedBy\x127\n" +
+	"\terased_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\berasedAt\"*\n" +
+	"\x18DeleteTransactionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x90\x02\n" +
+	"\x04Coin\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tprecision\x18\x03 \x01(\x05R\tprecision\x12\x16\n" +
+	"\x06active\x18\x04 \x01(\bR\x06active\x12\x1b\n" +
+	"\tmin_spend\x18\x05 \x01(\x01R\bminSpend\x12\x1b\n" +
+	"\tmax_spend\x18\x06 \x01(\x01R\bmaxSpend\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"=\n" +
+	"\x10ListCoinsRequest\x12)\n" +
+	"\x10include_inactive\x18\x01 \x01(\bR\x0fincludeInactive\"@\n" +
+	"\x11ListCoinsResponse\x12+\n" +
+	"\x05coins\x18\x01 \x03(\v2\x15.transactions.v1.CoinR\x05coins\"\xee\x03\n" +
+	"\bPlatform\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12#\n" +
+	"\rowner_contact\x18\x03 \x01(\tR\fownerContact\x12#\n" +
+	"\rallowed_coins\x18\x04 \x03(\tR\fallowedCoins\x124\n" +
+	"\x16default_access_seconds\x18\x05 \x01(\x03R\x14defaultAccessSeconds\x12\x1f\n" +
+	"\vwebhook_url\x18\x06 \x01(\tR\n" +
+	"webhookUrl\x12\x18\n" +
+	"\aenabled\x18\a \x01(\bR\aenabled\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x120\n" +
+	"\x14dedup_window_seconds\x18\n" +
+	" \x01(\x03R\x12dedupWindowSeconds\x12!\n" +
+	"\fdedup_action\x18\v \x01(\tR\vdedupAction\x12%\n" +
+	"\x0ewebhook_secret\x18\f \x01(\tR\rwebhookSecret\"(\n" +
+	"\x12GetPlatformRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"A\n" +
+	"\x14ListPlatformsRequest\x12)\n" +
+	"\x10include_disabled\x18\x01 \x01(\bR\x0fincludeDisabled\"P\n" +
+	"\x15ListPlatformsResponse\x127\n" +
+	"\tplatforms\x18\x01 \x03(\v2\x19.transactions.v1.PlatformR\tplatforms\"0\n" +
+	"\x1aRotateWebhookSecretRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"+\n" +
+	"\x15DeletePlatformRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"2\n" +
+	"\x16DeletePlatformResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\bR\adeleted\"\x9d\x01\n" +
+	"\fExchangeRate\x12\x1b\n" +
+	"\tbase_coin\x18\x01 \x01(\tR\bbaseCoin\x12\x1d\n" +
+	"\n" +
+	"quote_coin\x18\x02 \x01(\tR\tquoteCoin\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\x01R\x04rate\x12=\n" +
+	"\feffective_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\"Q\n" +
+	"\x1aImportExchangeRatesRequest\x123\n" +
+	"\x05rates\x18\x01 \x03(\v2\x1d.transactions.v1.ExchangeRateR\x05rates\"9\n" +
+	"\x1bImportExchangeRatesResponse\x12\x1a\n" +
+	"\bimported\x18\x01 \x01(\x05R\bimported\"V\n" +
+	"\x18ListExchangeRatesRequest\x12\x1b\n" +
+	"\tbase_coin\x18\x01 \x01(\tR\bbaseCoin\x12\x1d\n" +
+	"\n" +
+	"quote_coin\x18\x02 \x01(\tR\tquoteCoin\"P\n" +
+	"\x19ListExchangeRatesResponse\x123\n" +
+	"\x05rates\x18\x01 \x03(\v2\x1d.transactions.v1.ExchangeRateR\x05rates\"\xf5\x01\n" +
+	"\x1bGetTransactionTotalsRequest\x12%\n" +
+	"\x0ereference_coin\x18\x01 \x01(\tR\rreferenceCoin\x12\x16\n" +
+	"\x06userid\x18\x02 \x01(\tR\x06userid\x12\x16\n" +
+	"\x06coinid\x18\x03 \x01(\tR\x06coinid\x12#\n" +
+	"\rplatform_name\x18\x04 \x01(\tR\fplatformName\x12.\n" +
+	"\x04from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\x87\x01\n" +
+	"\tCoinTotal\x12\x16\n" +
+	"\x06coinid\x18\x01 \x01(\tR\x06coinid\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x01R\x05total\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\x01R\x05value\x12 \n" +
+	"\vunconverted\x18\x05 \x01(\x03R\vunconverted\"\xa1\x01\n" +
+	"\x11TransactionTotals\x12%\n" +
+	"\x0ereference_coin\x18\x01 \x01(\tR\rreferenceCoin\x123\n" +
+	"\aby_coin\x18\x02 \x03(\v2\x1a.transactions.v1.CoinTotalR\x06byCoin\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\x12\x1a\n" +
+	"\bcomplete\x18\x04 \x01(\bR\bcomplete\"\\\n" +
+	"\x0eAccountBalance\x12\x18\n" +
+	"\aaccount\x18\x01 \x01(\tR\aaccount\x12\x16\n" +
+	"\x06coinid\x18\x02 \x01(\tR\x06coinid\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\x01R\abalance\"4\n" +
+	"\x18GetAccountBalanceRequest\x12\x18\n" +
+	"\aaccount\x18\x01 \x01(\tR\aaccount\"X\n" +
+	"\x19GetAccountBalanceResponse\x12;\n" +
+	"\bbalances\x18\x01 \x03(\v2\x1f.transactions.v1.AccountBalanceR\bbalances\"\xde\x01\n" +
+	"\aPosting\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bentry_id\x18\x02 \x01(\tR\aentryId\x12%\n" +
+	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\x12\x18\n" +
+	"\aaccount\x18\x04 \x01(\tR\aaccount\x12\x16\n" +
+	"\x06coinid\x18\x05 \x01(\tR\x06coinid\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x127\n" +
+	"\tposted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bpostedAt\"\xd8\x01\n" +
+	"\x1aGetAccountStatementRequest\x12\x18\n" +
+	"\aaccount\x18\x01 \x01(\tR\aaccount\x12\x16\n" +
+	"\x06coinid\x18\x02 \x01(\tR\x06coinid\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offset\"\xae\x01\n" +
+	"\x10AccountStatement\x12\x18\n" +
+	"\aaccount\x18\x01 \x01(\tR\aaccount\x12\x16\n" +
+	"\x06coinid\x18\x02 \x01(\tR\x06coinid\x12\x18\n" +
+	"\aopening\x18\x03 \x01(\x01R\aopening\x12\x18\n" +
+	"\aclosing\x18\x04 \x01(\x01R\aclosing\x124\n" +
+	"\bpostings\x18\x05 \x03(\v2\x18.transactions.v1.PostingR\bpostings\"+\n" +
+	"\x19CaptureTransactionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
+	"\x16VoidTransactionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"A\n" +
+	"\x18ListStatusChangesRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\"\x9b\x01\n" +
+	"\fStatusChange\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\x129\n" +
+	"\n" +
+	"changed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"T\n" +
+	"\x19ListStatusChangesResponse\x127\n" +
+	"\achanges\x18\x01 \x03(\v2\x1d.transactions.v1.StatusChangeR\achanges\"\xfb\x01\n" +
+	"\x18UpdateTransactionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12;\n" +
+	"\vexpiry_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expiryDate\x12#\n" +
+	"\rplatform_name\x18\x05 \x01(\tR\fplatformName\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"G\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"\xd0\x01\n" +
+	"\x13TransactionRevision\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\x126\n" +
+	"\achanges\x18\x02 \x03(\v2\x1c.transactions.v1.FieldChangeR\achanges\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\x129\n" +
+	"\n" +
+	"changed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"H\n" +
+	"\x1fListTransactionRevisionsRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\"f\n" +
+	" ListTransactionRevisionsResponse\x12B\n" +
+	"\trevisions\x18\x01 \x03(\v2$.transactions.v1.TransactionRevisionR\trevisions\"\xc9\x01\n" +
+	"\x13ExtendAccessRequest\x12\x16\n" +
+	"\x06userid\x18\x01 \x01(\tR\x06userid\x12\x16\n" +
+	"\x06dataid\x18\x02 \x01(\tR\x06dataid\x12#\n" +
+	"\rplatform_name\x18\x03 \x01(\tR\fplatformName\x12\x16\n" +
+	"\x06coinid\x18\x04 \x01(\tR\x06coinid\x12\x1a\n" +
+	"\bcoinused\x18\x05 \x01(\x01R\bcoinused\x12)\n" +
+	"\x10duration_seconds\x18\x06 \x01(\x03R\x0fdurationSeconds\"\xf9\x01\n" +
+	"\x14ExtendAccessResponse\x12>\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x1c.transactions.v1.TransactionR\vtransaction\x12\x1f\n" +
+	"\voriginal_id\x18\x02 \x01(\tR\n" +
+	"originalId\x12C\n" +
+	"\x0fprevious_expiry\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0epreviousExpiry\x12;\n" +
+	"\vexpiry_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expiryDate\"\x8e\x02\n" +
+	"\x10SubscriptionPlan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rplatform_name\x18\x03 \x01(\tR\fplatformName\x12\x16\n" +
+	"\x06dataid\x18\x04 \x01(\tR\x06dataid\x12\x16\n" +
+	"\x06coinid\x18\x05 \x01(\tR\x06coinid\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12\x16\n" +
+	"\x06period\x18\a \x01(\tR\x06period\x12\x16\n" +
+	"\x06active\x18\b \x01(\bR\x06active\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"J\n" +
+	" SetSubscriptionPlanActiveRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\bR\x06active\"I\n" +
+	"\x1cListSubscriptionPlansRequest\x12)\n" +
+	"\x10include_inactive\x18\x01 \x01(\bR\x0fincludeInactive\"X\n" +
+	"\x1dListSubscriptionPlansResponse\x127\n" +
+	"\x05plans\x18\x01 \x03(\v2!.transactions.v1.SubscriptionPlanR\x05plans\"\xf5\x03\n" +
+	"\fSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aplan_id\x18\x02 \x01(\tR\x06planId\x12\x16\n" +
+	"\x06userid\x18\x03 \x01(\tR\x06userid\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12\x14\n" +
+	"\x05cycle\x18\x06 \x01(\x05R\x05cycle\x12@\n" +
+	"\x0enext_charge_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\fnextChargeAt\x12'\n" +
+	"\x0ffailed_attempts\x18\b \x01(\x05R\x0efailedAttempts\x12;\n" +
+	"\vgrace_until\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"graceUntil\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\n" +
+	" \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"C\n" +
+	"\x10SubscribeRequest\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\tR\x06planId\x12\x16\n" +
+	"\x06userid\x18\x02 \x01(\tR\x06userid\"\x96\x01\n" +
+	"\x11SubscribeResponse\x12A\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x1d.transactions.v1.SubscriptionR\fsubscription\x12>\n" +
+	"\vtransaction\x18\x02 \x01(\v2\x1c.transactions.v1.TransactionR\vtransaction\"(\n" +
+	"\x16GetSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"J\n" +
+	"\x18ListSubscriptionsRequest\x12\x16\n" +
+	"\x06userid\x18\x01 \x01(\tR\x06userid\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"`\n" +
+	"\x19ListSubscriptionsResponse\x12C\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x1d.transactions.v1.SubscriptionR\rsubscriptions\"+\n" +
+	"\x19CancelSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"*\n" +
+	"\x18PauseSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"+\n" +
+	"\x19ResumeSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xd6\x02\n" +
+	"\x11SubscriptionEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\x12#\n" +
+	"\rplatform_name\x18\x03 \x01(\tR\fplatformName\x12\x16\n" +
+	"\x06userid\x18\x04 \x01(\tR\x06userid\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12%\n" +
+	"\x0etransaction_id\x18\x06 \x01(\tR\rtransactionId\x12\x16\n" +
+	"\x06detail\x18\a \x01(\tR\x06detail\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fdelivered_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\"H\n" +
+	"\x1dListSubscriptionEventsRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\tR\x0esubscriptionId\"\\\n" +
+	"\x1eListSubscriptionEventsResponse\x12:\n" +
+	"\x06events\x18\x01 \x03(\v2\".transactions.v1.SubscriptionEventR\x06events\"\x91\x04\n" +
+	"\x14ScheduledTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06coinid\x18\x02 \x01(\tR\x06coinid\x12\x16\n" +
+	"\x06userid\x18\x03 \x01(\tR\x06userid\x12\x16\n" +
+	"\x06dataid\x18\x04 \x01(\tR\x06dataid\x12\x1a\n" +
+	"\bcoinused\x18\x05 \x01(\x01R\bcoinused\x12;\n" +
+	"\vexpiry_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expiryDate\x12#\n" +
+	"\rplatform_name\x18\a \x01(\tR\fplatformName\x129\n" +
+	"\n" +
+	"execute_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texecuteAt\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12%\n" +
+	"\x0etransaction_id\x18\n" +
+	" \x01(\tR\rtransactionId\x12\x14\n" +
+	"\x05error\x18\v \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\f \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9d\x02\n" +
+	"\x1aScheduleTransactionRequest\x12\x16\n" +
+	"\x06coinid\x18\x01 \x01(\tR\x06coinid\x12\x16\n" +
+	"\x06userid\x18\x02 \x01(\tR\x06userid\x12\x16\n" +
+	"\x06dataid\x18\x03 \x01(\tR\x06dataid\x12\x1a\n" +
+	"\bcoinused\x18\x04 \x01(\x01R\bcoinused\x12;\n" +
+	"\vexpiry_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expiryDate\x12#\n" +
+	"\rplatform_name\x18\x06 \x01(\tR\fplatformName\x129\n" +
+	"\n" +
+	"execute_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texecuteAt\"\x80\x01\n" +
+	" ListScheduledTransactionsRequest\x12\x16\n" +
+	"\x06userid\x18\x01 \x01(\tR\x06userid\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"h\n" +
+	"!ListScheduledTransactionsResponse\x12C\n" +
+	"\tscheduled\x18\x01 \x03(\v2%.transactions.v1.ScheduledTransactionR\tscheduled\"3\n" +
+	"!CancelScheduledTransactionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"R\n" +
+	"\fFraudFinding\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xb5\x03\n" +
+	"\vFraudReview\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12\x16\n" +
+	"\x06userid\x18\x03 \x01(\tR\x06userid\x12#\n" +
+	"\rplatform_name\x18\x04 \x01(\tR\fplatformName\x12\x1a\n" +
+	"\bcoinused\x18\x05 \x01(\x01R\bcoinused\x12\x16\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\x129\n" +
+	"\bfindings\x18\a \x03(\v2\x1d.transactions.v1.FraudFindingR\bfindings\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1f\n" +
+	"\vreviewed_by\x18\t \x01(\tR\n" +
+	"reviewedBy\x12\x12\n" +
+	"\x04note\x18\n" +
+	" \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vreviewed_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\"_\n" +
+	"\x17ListFraudReviewsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"R\n" +
+	"\x18ListFraudReviewsResponse\x126\n" +
+	"\areviews\x18\x01 \x03(\v2\x1c.transactions.v1.FraudReviewR\areviews\">\n" +
+	"\x18DecideFraudReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\"\xe7\x02\n" +
+	"\x1aReconcileSettlementRequest\x12#\n" +
+	"\rplatform_name\x18\x01 \x01(\tR\fplatformName\x12\x10\n" +
+	"\x03csv\x18\x02 \x01(\fR\x03csv\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12.\n" +
+	"\x10amount_tolerance\x18\x04 \x01(\x01H\x00R\x0famountTolerance\x88\x01\x01\x129\n" +
+	"\x16time_tolerance_seconds\x18\x05 \x01(\x03H\x01R\x14timeToleranceSeconds\x88\x01\x01\x12.\n" +
+	"\x04from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x02toB\x13\n" +
+	"\x11_amount_toleranceB\x19\n" +
+	"\x17_time_tolerance_seconds\"\x8d\x04\n" +
+	"\x11ReconciliationRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rplatform_name\x18\x02 \x01(\tR\fplatformName\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12.\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12)\n" +
+	"\x10amount_tolerance\x18\x06 \x01(\x01R\x0famountTolerance\x124\n" +
+	"\x16time_tolerance_seconds\x18\a \x01(\x03R\x14timeToleranceSeconds\x12\x18\n" +
+	"\amatched\x18\b \x01(\x05R\amatched\x12!\n" +
+	"\fmissing_ours\x18\t \x01(\x05R\vmissingOurs\x12%\n" +
+	"\x0emissing_theirs\x18\n" +
+	" \x01(\x05R\rmissingTheirs\x12+\n" +
+	"\x11amount_mismatches\x18\v \x01(\x05R\x10amountMismatches\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\f \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"r\n" +
+	"\x1dListReconciliationRunsRequest\x12#\n" +
+	"\rplatform_name\x18\x01 \x01(\tR\fplatformName\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"X\n" +
+	"\x1eListReconciliationRunsResponse\x126\n" +
+	"\x04runs\x18\x01 \x03(\v2\".transactions.v1.ReconciliationRunR\x04runs\"\xae\x04\n" +
+	"\x12ReconciliationItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x16\n" +
+	"\x06dataid\x18\x05 \x01(\tR\x06dataid\x12\x16\n" +
+	"\x06userid\x18\x06 \x01(\tR\x06userid\x12&\n" +
+	"\ftheir_amount\x18\a \x01(\x01H\x00R\vtheirAmount\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"our_amount\x18\b \x01(\x01H\x01R\tourAmount\x88\x01\x01\x12C\n" +
+	"\x0ftheir_timestamp\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0etheirTimestamp\x12?\n" +
+	"\rour_timestamp\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\fourTimestamp\x12%\n" +
+	"\x0etransaction_id\x18\v \x01(\tR\rtransactionId\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\f \x01(\tR\n" +
+	"resolution\x12\x1f\n" +
+	"\vresolved_by\x18\r \x01(\tR\n" +
+	"resolvedBy\x12;\n" +
+	"\vresolved_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAtB\x0f\n" +
+	"\r_their_amountB\r\n" +
+	"\v_our_amount\"\x91\x01\n" +
+	"\x1eListReconciliationItemsRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\"\\\n" +
+	"\x1fListReconciliationItemsResponse\x129\n" +
+	"\x05items\x18\x01 \x03(\v2#.transactions.v1.ReconciliationItemR\x05items\"F\n" +
+	" ResolveReconciliationItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note*\xbd\x01\n" +
+	"\x14TransactionSortField\x12&\n" +
+	"\"TRANSACTION_SORT_FIELD_UNSPECIFIED\x10\x00\x120\n" +
+	",TRANSACTION_SORT_FIELD_TRANSACTION_TIMESTAMP\x10\x01\x12&\n" +
+	"\"TRANSACTION_SORT_FIELD_EXPIRY_DATE\x10\x02\x12#\n" +
+	"\x1fTRANSACTION_SORT_FIELD_COINUSED\x10\x03*U\n" +
+	"\tCapPeriod\x12\x1a\n" +
+	"\x16CAP_PERIOD_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10CAP_PERIOD_DAILY\x10\x01\x12\x16\n" +
+	"\x12CAP_PERIOD_MONTHLY\x10\x022\x9d)\n" +
 	"\fTransactions\x12\\\n" +
-	"\x11CreateTransaction\x12).transactions.v1.CreateTransactionRequest\x1a\x1c.transactions.v1.TransactionB>Z<transaction-service/proto/gen/transactions/v1;transactionsv1b\x06proto3"
+	"\x11CreateTransaction\x12).transactions.v1.CreateTransactionRequest\x1a\x1c.transactions.v1.Transaction\x12g\n" +
+	"\x10ListTransactions\x12(.transactions.v1.ListTransactionsRequest\x1a).transactions.v1.ListTransactionsResponse\x12_\n" +
+	"\x14AuthorizeTransaction\x12).transactions.v1.CreateTransactionRequest\x1a\x1c.transactions.v1.Transaction\x12^\n" +
+	"\x12CaptureTransaction\x12*.transactions.v1.CaptureTransactionRequest\x1a\x1c.transactions.v1.Transaction\x12X\n" +
+	"\x0fVoidTransaction\x12'.transactions.v1.VoidTransactionRequest\x1a\x1c.transactions.v1.Transaction\x12j\n" +
+	"\x11ListStatusChanges\x12).transactions.v1.ListStatusChangesRequest\x1a*.transactions.v1.ListStatusChangesResponse\x12\\\n" +
+	"\x11UpdateTransaction\x12).transactions.v1.UpdateTransactionRequest\x1a\x1c.transactions.v1.Transaction\x12\x7f\n" +
+	"\x18ListTransactionRevisions\x120.transactions.v1.ListTransactionRevisionsRequest\x1a1.transactions.v1.ListTransactionRevisionsResponse\x12[\n" +
+	"\fExtendAccess\x12$.transactions.v1.ExtendAccessRequest\x1a%.transactions.v1.ExtendAccessResponse\x12i\n" +
+	"\x13ScheduleTransaction\x12+.transactions.v1.ScheduleTransactionRequest\x1a%.transactions.v1.ScheduledTransaction\x12\x82\x01\n" +
+	"\x19ListScheduledTransactions\x121.transactions.v1.ListScheduledTransactionsRequest\x1a2.transactions.v1.ListScheduledTransactionsResponse\x12w\n" +
+	"\x1aCancelScheduledTransaction\x122.transactions.v1.CancelScheduledTransactionRequest\x1a%.transactions.v1.ScheduledTransaction\x12^\n" +
+	"\x16CreateSubscriptionPlan\x12!.transactions.v1.SubscriptionPlan\x1a!.transactions.v1.SubscriptionPlan\x12q\n" +
+	"\x19SetSubscriptionPlanActive\x121.transactions.v1.SetSubscriptionPlanActiveRequest\x1a!.transactions.v1.SubscriptionPlan\x12v\n" +
+	"\x15ListSubscriptionPlans\x12-.transactions.v1.ListSubscriptionPlansRequest\x1a..transactions.v1.ListSubscriptionPlansResponse\x12R\n" +
+	"\tSubscribe\x12!.transactions.v1.SubscribeRequest\x1a\".transactions.v1.SubscribeResponse\x12Y\n" +
+	"\x0fGetSubscription\x12'.transactions.v1.GetSubscriptionRequest\x1a\x1d.transactions.v1.Subscription\x12j\n" +
+	"\x11ListSubscriptions\x12).transactions.v1.ListSubscriptionsRequest\x1a*.transactions.v1.ListSubscriptionsResponse\x12_\n" +
+	"\x12CancelSubscription\x12*.transactions.v1.CancelSubscriptionRequest\x1a\x1d.transactions.v1.Subscription\x12]\n" +
+	"\x11PauseSubscription\x12).transactions.v1.PauseSubscriptionRequest\x1a\x1d.transactions.v1.Subscription\x12_\n" +
+	"\x12ResumeSubscription\x12*.transactions.v1.ResumeSubscriptionRequest\x1a\x1d.transactions.v1.Subscription\x12y\n" +
+	"\x16ListSubscriptionEvents\x12..transactions.v1.ListSubscriptionEventsRequest\x1a/.transactions.v1.ListSubscriptionEventsResponse\x12V\n" +
+	"\x0eSetSpendingCap\x12&.transactions.v1.SetSpendingCapRequest\x1a\x1c.transactions.v1.SpendingCap\x12g\n" +
+	"\x10ListSpendingCaps\x12(.transactions.v1.ListSpendingCapsRequest\x1a).transactions.v1.ListSpendingCapsResponse\x12g\n" +
+	"\x10ClearSpendingCap\x12(.transactions.v1.ClearSpendingCapRequest\x1a).transactions.v1.ClearSpendingCapResponse\x12m\n" +
+	"\x12GetRemainingBudget\x12*.transactions.v1.GetRemainingBudgetRequest\x1a+.transactions.v1.GetRemainingBudgetResponse\x12d\n" +
+	"\x0fListAuditEvents\x12'.transactions.v1.ListAuditEventsRequest\x1a(.transactions.v1.ListAuditEventsResponse\x12K\n" +
+	"\fCreateTenant\x12\x17.transactions.v1.Tenant\x1a\".transactions.v1.TenantCredentials\x12@\n" +
+	"\fUpdateTenant\x12\x17.transactions.v1.Tenant\x1a\x17.transactions.v1.Tenant\x12X\n" +
+	"\vListTenants\x12#.transactions.v1.ListTenantsRequest\x1a$.transactions.v1.ListTenantsResponse\x12^\n" +
+	"\x0fRotateTenantKey\x12'.transactions.v1.RotateTenantKeyRequest\x1a\".transactions.v1.TenantCredentials\x127\n" +
+	"\aSetCoin\x12\x15.transactions.v1.Coin\x1a\x15.transactions.v1.Coin\x12R\n" +
+	"\tListCoins\x12!.transactions.v1.ListCoinsRequest\x1a\".transactions.v1.ListCoinsResponse\x12F\n" +
+	"\x0eCreatePlatform\x12\x19.transactions.v1.Platform\x1a\x19.transactions.v1.Platform\x12F\n" +
+	"\x0eUpdatePlatform\x12\x19.transactions.v1.Platform\x1a\x19.transactions.v1.Platform\x12M\n" +
+	"\vGetPlatform\x12#.transactions.v1.GetPlatformRequest\x1a\x19.transactions.v1.Platform\x12^\n" +
+	"\rListPlatforms\x12%.transactions.v1.ListPlatformsRequest\x1a&.transactions.v1.ListPlatformsResponse\x12a\n" +
+	"\x0eDeletePlatform\x12&.transactions.v1.DeletePlatformRequest\x1a'.transactions.v1.DeletePlatformResponse\x12]\n" +
+	"\x13RotateWebhookSecret\x12+.transactions.v1.RotateWebhookSecretRequest\x1a\x19.transactions.v1.Platform\x12p\n" +
+	"\x13ImportExchangeRates\x12+.transactions.v1.ImportExchangeRatesRequest\x1a,.transactions.v1.ImportExchangeRatesResponse\x12j\n" +
+	"\x11ListExchangeRates\x12).transactions.v1.ListExchangeRatesRequest\x1a*.transactions.v1.ListExchangeRatesResponse\x12h\n" +
+	"\x14GetTransactionTotals\x12,.transactions.v1.GetTransactionTotalsRequest\x1a\".transactions.v1.TransactionTotals\x12j\n" +
+	"\x11GetAccountBalance\x12).transactions.v1.GetAccountBalanceRequest\x1a*.transactions.v1.GetAccountBalanceResponse\x12e\n" +
+	"\x13GetAccountStatement\x12+.transactions.v1.GetAccountStatementRequest\x1a!.transactions.v1.AccountStatement\x12f\n" +
+	"\x13ReconcileSettlement\x12+.transactions.v1.ReconcileSettlementRequest\x1a\".transactions.v1.ReconciliationRun\x12y\n" +
+	"\x16ListReconciliationRuns\x12..transactions.v1.ListReconciliationRunsRequest\x1a/.transactions.v1.ListReconciliationRunsResponse\x12|\n" +
+	"\x17ListReconciliationItems\x12/.transactions.v1.ListReconciliationItemsRequest\x1a0.transactions.v1.ListReconciliationItemsResponse\x12s\n" +
+	"\x19ResolveReconciliationItem\x121.transactions.v1.ResolveReconciliationItemRequest\x1a#.transactions.v1.ReconciliationItem\x12g\n" +
+	"\x10ListFraudReviews\x12(.transactions.v1.ListFraudReviewsRequest\x1a).transactions.v1.ListFraudReviewsResponse\x12]\n" +
+	"\x12ApproveFraudReview\x12).transactions.v1.DecideFraudReviewRequest\x1a\x1c.transactions.v1.FraudReview\x12Z\n" +
+	"\x0fDenyFraudReview\x12).transactions.v1.DecideFraudReviewRequest\x1a\x1c.transactions.v1.FraudReview\x12O\n" +
+	"\tEraseUser\x12!.transactions.v1.EraseUserRequest\x1a\x1f.transactions.v1.ErasureReceipt\x12\\\n" +
+	"\x11DeleteTransaction\x12).transactions.v1.DeleteTransactionRequest\x1a\x1c.transactions.v1.TransactionB>Z<transaction-service/proto/gen/transactions/v1;transactionsv1b\x06proto3"
 
 var (
 	file_proto_transactions_proto_rawDescOnce sync.Once
@@ -253,24 +7059,317 @@ func file_proto_transactions_proto_rawDescGZIP() []byte {
 	return file_proto_transactions_proto_rawDescData
 }
 
-var file_proto_transactions_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_transactions_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_transactions_proto_msgTypes = make([]protoimpl.MessageInfo, 98)
 var file_proto_transactions_proto_goTypes = []any{
-	(*CreateTransactionRequest)(nil), // 0: transactions.v1.CreateTransactionRequest
-	(*Transaction)(nil),              // 1: transactions.v1.Transaction
-	(*timestamppb.Timestamp)(nil),    // 2: google.protobuf.Timestamp
+	(TransactionSortField)(0),                 // 0: transactions.v1.TransactionSortField
+	(CapPeriod)(0),                            // 1: transactions.v1.CapPeriod
+	(*CreateTransactionRequest)(nil),          // 2: transactions.v1.CreateTransactionRequest
+	(*Transaction)(nil),                       // 3: transactions.v1.Transaction
+	(*TransactionFilter)(nil),                 // 4: transactions.v1.TransactionFilter
+	(*ListTransactionsRequest)(nil),           // 5: transactions.v1.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil),          // 6: transactions.v1.ListTransactionsResponse
+	(*SpendingCap)(nil),                       // 7: transactions.v1.SpendingCap
+	(*SetSpendingCapRequest)(nil),             // 8: transactions.v1.SetSpendingCapRequest
+	(*ListSpendingCapsRequest)(nil),           // 9: transactions.v1.ListSpendingCapsRequest
+	(*ListSpendingCapsResponse)(nil),          // 10: transactions.v1.ListSpendingCapsResponse
+	(*ClearSpendingCapRequest)(nil),           // 11: transactions.v1.ClearSpendingCapRequest
+	(*ClearSpendingCapResponse)(nil),          // 12: transactions.v1.ClearSpendingCapResponse
+	(*GetRemainingBudgetRequest)(nil),         // 13: transactions.v1.GetRemainingBudgetRequest
+	(*Budget)(nil),                            // 14: transactions.v1.Budget
+	(*GetRemainingBudgetResponse)(nil),        // 15: transactions.v1.GetRemainingBudgetResponse
+	(*AuditEvent)(nil),                        // 16: transactions.v1.AuditEvent
+	(*ListAuditEventsRequest)(nil),            // 17: transactions.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),           // 18: transactions.v1.ListAuditEventsResponse
+	(*Tenant)(nil),                            // 19: transactions.v1.Tenant
+	(*TenantCredentials)(nil),                 // 20: transactions.v1.TenantCredentials
+	(*ListTenantsRequest)(nil),                // 21: transactions.v1.ListTenantsRequest
+	(*ListTenantsResponse)(nil),               // 22: transactions.v1.ListTenantsResponse
+	(*RotateTenantKeyRequest)(nil),            // 23: transactions.v1.RotateTenantKeyRequest
+	(*EraseUserRequest)(nil),                  // 24: transactions.v1.EraseUserRequest
+	(*ErasureReceipt)(nil),                    // 25: transactions.v1.ErasureReceipt
+	(*DeleteTransactionRequest)(nil),          // 26: transactions.v1.DeleteTransactionRequest
+	(*Coin)(nil),                              // 27: transactions.v1.Coin
+	(*ListCoinsRequest)(nil),                  // 28: transactions.v1.ListCoinsRequest
+	(*ListCoinsResponse)(nil),                 // 29: transactions.v1.ListCoinsResponse
+	(*Platform)(nil),                          // 30: transactions.v1.Platform
+	(*GetPlatformRequest)(nil),                // 31: transactions.v1.GetPlatformRequest
+	(*ListPlatformsRequest)(nil),              // 32: transactions.v1.ListPlatformsRequest
+	(*ListPlatformsResponse)(nil),             // 33: transactions.v1.ListPlatformsResponse
+	(*RotateWebhookSecretRequest)(nil),        // 34: transactions.v1.RotateWebhookSecretRequest
+	(*DeletePlatformRequest)(nil),             // 35: transactions.v1.DeletePlatformRequest
+	(*DeletePlatformResponse)(nil),            // 36: transactions.v1.DeletePlatformResponse
+	(*ExchangeRate)(nil),                      // 37: transactions.v1.ExchangeRate
+	(*ImportExchangeRatesRequest)(nil),        // 38: transactions.v1.ImportExchangeRatesRequest
+	(*ImportExchangeRatesResponse)(nil),       // 39: transactions.v1.ImportExchangeRatesResponse
+	(*ListExchangeRatesRequest)(nil),          // 40: transactions.v1.ListExchangeRatesRequest
+	(*ListExchangeRatesResponse)(nil),         // 41: transactions.v1.ListExchangeRatesResponse
+	(*GetTransactionTotalsRequest)(nil),       // 42: transactions.v1.GetTransactionTotalsRequest
+	(*CoinTotal)(nil),                         // 43: transactions.v1.CoinTotal
+	(*TransactionTotals)(nil),                 // 44: transactions.v1.TransactionTotals
+	(*AccountBalance)(nil),                    // 45: transactions.v1.AccountBalance
+	(*GetAccountBalanceRequest)(nil),          // 46: transactions.v1.GetAccountBalanceRequest
+	(*GetAccountBalanceResponse)(nil),         // 47: transactions.v1.GetAccountBalanceResponse
+	(*Posting)(nil),                           // 48: transactions.v1.Posting
+	(*GetAccountStatementRequest)(nil),        // 49: transactions.v1.GetAccountStatementRequest
+	(*AccountStatement)(nil),                  // 50: transactions.v1.AccountStatement
+	(*CaptureTransactionRequest)(nil),         // 51: transactions.v1.CaptureTransactionRequest
+	(*VoidTransactionRequest)(nil),            // 52: transactions.v1.VoidTransactionRequest
+	(*ListStatusChangesRequest)(nil),          // 53: transactions.v1.ListStatusChangesRequest
+	(*StatusChange)(nil),                      // 54: transactions.v1.StatusChange
+	(*ListStatusChangesResponse)(nil),         // 55: transactions.v1.ListStatusChangesResponse
+	(*UpdateTransactionRequest)(nil),          // 56: transactions.v1.UpdateTransactionRequest
+	(*FieldChange)(nil),                       // 57: transactions.v1.FieldChange
+	(*TransactionRevision)(nil),               // 58: transactions.v1.TransactionRevision
+	(*ListTransactionRevisionsRequest)(nil),   // 59: transactions.v1.ListTransactionRevisionsRequest
+	(*ListTransactionRevisionsResponse)(nil),  // 60: transactions.v1.ListTransactionRevisionsResponse
+	(*ExtendAccessRequest)(nil),               // 61: transactions.v1.ExtendAccessRequest
+	(*ExtendAccessResponse)(nil),              // 62: transactions.v1.ExtendAccessResponse
+	(*SubscriptionPlan)(nil),                  // 63: transactions.v1.SubscriptionPlan
+	(*SetSubscriptionPlanActiveRequest)(nil),  // 64: transactions.v1.SetSubscriptionPlanActiveRequest
+	(*ListSubscriptionPlansRequest)(nil),      // 65: transactions.v1.ListSubscriptionPlansRequest
+	(*ListSubscriptionPlansResponse)(nil),     // 66: transactions.v1.ListSubscriptionPlansResponse
+	(*Subscription)(nil),                      // 67: transactions.v1.Subscription
+	(*SubscribeRequest)(nil),                  // 68: transactions.v1.SubscribeRequest
+	(*SubscribeResponse)(nil),                 // 69: transactions.v1.SubscribeResponse
+	(*GetSubscriptionRequest)(nil),            // 70: transactions.v1.GetSubscriptionRequest
+	(*ListSubscriptionsRequest)(nil),          // 71: transactions.v1.ListSubscriptionsRequest
+	(*ListSubscriptionsResponse)(nil),         // 72: transactions.v1.ListSubscriptionsResponse
+	(*CancelSubscriptionRequest)(nil),         // 73: transactions.v1.CancelSubscriptionRequest
+	(*PauseSubscriptionRequest)(nil),          // 74: transactions.v1.PauseSubscriptionRequest
+	(*ResumeSubscriptionRequest)(nil),         // 75: transactions.v1.ResumeSubscriptionRequest
+	(*SubscriptionEvent)(nil),                 // 76: transactions.v1.SubscriptionEvent
+	(*ListSubscriptionEventsRequest)(nil),     // 77: transactions.v1.ListSubscriptionEventsRequest
+	(*ListSubscriptionEventsResponse)(nil),    // 78: transactions.v1.ListSubscriptionEventsResponse
+	(*ScheduledTransaction)(nil),              // 79: transactions.v1.ScheduledTransaction
+	(*ScheduleTransactionRequest)(nil),        // 80: transactions.v1.ScheduleTransactionRequest
+	(*ListScheduledTransactionsRequest)(nil),  // 81: transactions.v1.ListScheduledTransactionsRequest
+	(*ListScheduledTransactionsResponse)(nil), // 82: transactions.v1.ListScheduledTransactionsResponse
+	(*CancelScheduledTransactionRequest)(nil), // 83: transactions.v1.CancelScheduledTransactionRequest
+	(*FraudFinding)(nil),                      // 84: transactions.v1.FraudFinding
+	(*FraudReview)(nil),                       // 85: transactions.v1.FraudReview
+	(*ListFraudReviewsRequest)(nil),           // 86: transactions.v1.ListFraudReviewsRequest
+	(*ListFraudReviewsResponse)(nil),          // 87: transactions.v1.ListFraudReviewsResponse
+	(*DecideFraudReviewRequest)(nil),          // 88: transactions.v1.DecideFraudReviewRequest
+	(*ReconcileSettlementRequest)(nil),        // 89: transactions.v1.ReconcileSettlementRequest
+	(*ReconciliationRun)(nil),                 // 90: transactions.v1.ReconciliationRun
+	(*ListReconciliationRunsRequest)(nil),     // 91: transactions.v1.ListReconciliationRunsRequest
+	(*ListReconciliationRunsResponse)(nil),    // 92: transactions.v1.ListReconciliationRunsResponse
+	(*ReconciliationItem)(nil),                // 93: transactions.v1.ReconciliationItem
+	(*ListReconciliationItemsRequest)(nil),    // 94: transactions.v1.ListReconciliationItemsRequest
+	(*ListReconciliationItemsResponse)(nil),   // 95: transactions.v1.ListReconciliationItemsResponse
+	(*ResolveReconciliationItemRequest)(nil),  // 96: transactions.v1.ResolveReconciliationItemRequest
+	nil,                                       // 97: transactions.v1.CreateTransactionRequest.MetadataEntry
+	nil,                                       // 98: transactions.v1.Transaction.MetadataEntry
+	nil,                                       // 99: transactions.v1.TransactionFilter.MetadataEntry
+	(*timestamppb.Timestamp)(nil),             // 100: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),             // 101: google.protobuf.FieldMask
 }
 var file_proto_transactions_proto_depIdxs = []int32{
-	2, // 0: transactions.v1.CreateTransactionRequest.transaction_timestamp:type_name -> google.protobuf.Timestamp
-	2, // 1: transactions.v1.CreateTransactionRequest.expiry_date:type_name -> google.protobuf.Timestamp
-	2, // 2: transactions.v1.Transaction.transaction_timestamp:type_name -> google.protobuf.Timestamp
-	2, // 3: transactions.v1.Transaction.expiry_date:type_name -> google.protobuf.Timestamp
-	0, // 4: transactions.v1.Transactions.CreateTransaction:input_type -> transactions.v1.CreateTransactionRequest
-	1, // 5: transactions.v1.Transactions.CreateTransaction:output_type -> transactions.v1.Transaction
-	5, // [5:6] is the sub-list for method output_type
-	4, // [4:5] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	100, // 0: transactions.v1.CreateTransactionRequest.transaction_timestamp:type_name -> google.protobuf.Timestamp
+	100, // 1: transactions.v1.CreateTransactionRequest.expiry_date:type_name -> google.protobuf.Timestamp
+	97,  // 2: transactions.v1.CreateTransactionRequest.metadata:type_name -> transactions.v1.CreateTransactionRequest.MetadataEntry
+	100, // 3: transactions.v1.Transaction.transaction_timestamp:type_name -> google.protobuf.Timestamp
+	100, // 4: transactions.v1.Transaction.expiry_date:type_name -> google.protobuf.Timestamp
+	100, // 5: transactions.v1.Transaction.deleted_at:type_name -> google.protobuf.Timestamp
+	100, // 6: transactions.v1.Transaction.hold_expires_at:type_name -> google.protobuf.Timestamp
+	98,  // 7: transactions.v1.Transaction.metadata:type_name -> transactions.v1.Transaction.MetadataEntry
+	100, // 8: transactions.v1.TransactionFilter.from:type_name -> google.protobuf.Timestamp
+	100, // 9: transactions.v1.TransactionFilter.to:type_name -> google.protobuf.Timestamp
+	100, // 10: transactions.v1.TransactionFilter.expires_from:type_name -> google.protobuf.Timestamp
+	100, // 11: transactions.v1.TransactionFilter.expires_to:type_name -> google.protobuf.Timestamp
+	100, // 12: transactions.v1.TransactionFilter.active_at:type_name -> google.protobuf.Timestamp
+	99,  // 13: transactions.v1.TransactionFilter.metadata:type_name -> transactions.v1.TransactionFilter.MetadataEntry
+	4,   // 14: transactions.v1.ListTransactionsRequest.filter:type_name -> transactions.v1.TransactionFilter
+	0,   // 15: transactions.v1.ListTransactionsRequest.sort_by:type_name -> transactions.v1.TransactionSortField
+	3,   // 16: transactions.v1.ListTransactionsResponse.transactions:type_name -> transactions.v1.Transaction
+	1,   // 17: transactions.v1.SpendingCap.period:type_name -> transactions.v1.CapPeriod
+	7,   // 18: transactions.v1.SetSpendingCapRequest.cap:type_name -> transactions.v1.SpendingCap
+	7,   // 19: transactions.v1.ListSpendingCapsResponse.caps:type_name -> transactions.v1.SpendingCap
+	1,   // 20: transactions.v1.ClearSpendingCapRequest.period:type_name -> transactions.v1.CapPeriod
+	7,   // 21: transactions.v1.Budget.cap:type_name -> transactions.v1.SpendingCap
+	100, // 22: transactions.v1.Budget.window_start:type_name -> google.protobuf.Timestamp
+	14,  // 23: transactions.v1.GetRemainingBudgetResponse.budgets:type_name -> transactions.v1.Budget
+	100, // 24: transactions.v1.AuditEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	100, // 25: transactions.v1.ListAuditEventsRequest.from:type_name -> google.protobuf.Timestamp
+	100, // 26: transactions.v1.ListAuditEventsRequest.to:type_name -> google.protobuf.Timestamp
+	16,  // 27: transactions.v1.ListAuditEventsResponse.events:type_name -> transactions.v1.AuditEvent
+	100, // 28: transactions.v1.Tenant.created_at:type_name -> google.protobuf.Timestamp
+	19,  // 29: transactions.v1.TenantCredentials.tenant:type_name -> transactions.v1.Tenant
+	19,  // 30: transactions.v1.ListTenantsResponse.tenants:type_name -> transactions.v1.Tenant
+	100, // 31: transactions.v1.ErasureReceipt.erased_at:type_name -> google.protobuf.Timestamp
+	100, // 32: transactions.v1.Coin.created_at:type_name -> google.protobuf.Timestamp
+	100, // 33: transactions.v1.Coin.updated_at:type_name -> google.protobuf.Timestamp
+	27,  // 34: transactions.v1.ListCoinsResponse.coins:type_name -> transactions.v1.Coin
+	100, // 35: transactions.v1.Platform.created_at:type_name -> google.protobuf.Timestamp
+	100, // 36: transactions.v1.Platform.updated_at:type_name -> google.protobuf.Timestamp
+	30,  // 37: transactions.v1.ListPlatformsResponse.platforms:type_name -> transactions.v1.Platform
+	100, // 38: transactions.v1.ExchangeRate.effective_at:type_name -> google.protobuf.Timestamp
+	37,  // 39: transactions.v1.ImportExchangeRatesRequest.rates:type_name -> transactions.v1.ExchangeRate
+	37,  // 40: transactions.v1.ListExchangeRatesResponse.rates:type_name -> transactions.v1.ExchangeRate
+	100, // 41: transactions.v1.GetTransactionTotalsRequest.from:type_name -> google.protobuf.Timestamp
+	100, // 42: transactions.v1.GetTransactionTotalsRequest.to:type_name -> google.protobuf.Timestamp
+	43,  // 43: transactions.v1.TransactionTotals.by_coin:type_name -> transactions.v1.CoinTotal
+	45,  // 44: transactions.v1.GetAccountBalanceResponse.balances:type_name -> transactions.v1.AccountBalance
+	100, // 45: transactions.v1.Posting.posted_at:type_name -> google.protobuf.Timestamp
+	100, // 46: transactions.v1.GetAccountStatementRequest.from:type_name -> google.protobuf.Timestamp
+	100, // 47: transactions.v1.GetAccountStatementRequest.to:type_name -> google.protobuf.Timestamp
+	48,  // 48: transactions.v1.AccountStatement.postings:type_name -> transactions.v1.Posting
+	100, // 49: transactions.v1.StatusChange.changed_at:type_name -> google.protobuf.Timestamp
+	54,  // 50: transactions.v1.ListStatusChangesResponse.changes:type_name -> transactions.v1.StatusChange
+	101, // 51: transactions.v1.UpdateTransactionRequest.update_mask:type_name -> google.protobuf.FieldMask
+	100, // 52: transactions.v1.UpdateTransactionRequest.expiry_date:type_name -> google.protobuf.Timestamp
+	57,  // 53: transactions.v1.TransactionRevision.changes:type_name -> transactions.v1.FieldChange
+	100, // 54: transactions.v1.TransactionRevision.changed_at:type_name -> google.protobuf.Timestamp
+	58,  // 55: transactions.v1.ListTransactionRevisionsResponse.revisions:type_name -> transactions.v1.TransactionRevision
+	3,   // 56: transactions.v1.ExtendAccessResponse.transaction:type_name -> transactions.v1.Transaction
+	100, // 57: transactions.v1.ExtendAccessResponse.previous_expiry:type_name -> google.protobuf.Timestamp
+	100, // 58: transactions.v1.ExtendAccessResponse.expiry_date:type_name -> google.protobuf.Timestamp
+	100, // 59: transactions.v1.SubscriptionPlan.created_at:type_name -> google.protobuf.Timestamp
+	63,  // 60: transactions.v1.ListSubscriptionPlansResponse.plans:type_name -> transactions.v1.SubscriptionPlan
+	100, // 61: transactions.v1.Subscription.started_at:type_name -> google.protobuf.Timestamp
+	100, // 62: transactions.v1.Subscription.next_charge_at:type_name -> google.protobuf.Timestamp
+	100, // 63: transactions.v1.Subscription.grace_until:type_name -> google.protobuf.Timestamp
+	100, // 64: transactions.v1.Subscription.created_at:type_name -> google.protobuf.Timestamp
+	100, // 65: transactions.v1.Subscription.updated_at:type_name -> google.protobuf.Timestamp
+	67,  // 66: transactions.v1.SubscribeResponse.subscription:type_name -> transactions.v1.Subscription
+	3,   // 67: transactions.v1.SubscribeResponse.transaction:type_name -> transactions.v1.Transaction
+	67,  // 68: transactions.v1.ListSubscriptionsResponse.subscriptions:type_name -> transactions.v1.Subscription
+	100, // 69: transactions.v1.SubscriptionEvent.created_at:type_name -> google.protobuf.Timestamp
+	100, // 70: transactions.v1.SubscriptionEvent.delivered_at:type_name -> google.protobuf.Timestamp
+	76,  // 71: transactions.v1.ListSubscriptionEventsResponse.events:type_name -> transactions.v1.SubscriptionEvent
+	100, // 72: transactions.v1.ScheduledTransaction.expiry_date:type_name -> google.protobuf.Timestamp
+	100, // 73: transactions.v1.ScheduledTransaction.execute_at:type_name -> google.protobuf.Timestamp
+	100, // 74: transactions.v1.ScheduledTransaction.created_at:type_name -> google.protobuf.Timestamp
+	100, // 75: transactions.v1.ScheduledTransaction.updated_at:type_name -> google.protobuf.Timestamp
+	100, // 76: transactions.v1.ScheduleTransactionRequest.expiry_date:type_name -> google.protobuf.Timestamp
+	100, // 77: transactions.v1.ScheduleTransactionRequest.execute_at:type_name -> google.protobuf.Timestamp
+	79,  // 78: transactions.v1.ListScheduledTransactionsResponse.scheduled:type_name -> transactions.v1.ScheduledTransaction
+	84,  // 79: transactions.v1.FraudReview.findings:type_name -> transactions.v1.FraudFinding
+	100, // 80: transactions.v1.FraudReview.created_at:type_name -> google.protobuf.Timestamp
+	100, // 81: transactions.v1.FraudReview.reviewed_at:type_name -> google.protobuf.Timestamp
+	85,  // 82: transactions.v1.ListFraudReviewsResponse.reviews:type_name -> transactions.v1.FraudReview
+	100, // 83: transactions.v1.ReconcileSettlementRequest.from:type_name -> google.protobuf.Timestamp
+	100, // 84: transactions.v1.ReconcileSettlementRequest.to:type_name -> google.protobuf.Timestamp
+	100, // 85: transactions.v1.ReconciliationRun.from:type_name -> google.protobuf.Timestamp
+	100, // 86: transactions.v1.ReconciliationRun.to:type_name -> google.protobuf.Timestamp
+	100, // 87: transactions.v1.ReconciliationRun.created_at:type_name -> google.protobuf.Timestamp
+	90,  // 88: transactions.v1.ListReconciliationRunsResponse.runs:type_name -> transactions.v1.ReconciliationRun
+	100, // 89: transactions.v1.ReconciliationItem.their_timestamp:type_name -> google.protobuf.Timestamp
+	100, // 90: transactions.v1.ReconciliationItem.our_timestamp:type_name -> google.protobuf.Timestamp
+	100, // 91: transactions.v1.ReconciliationItem.resolved_at:type_name -> google.protobuf.Timestamp
+	93,  // 92: transactions.v1.ListReconciliationItemsResponse.items:type_name -> transactions.v1.ReconciliationItem
+	2,   // 93: transactions.v1.Transactions.CreateTransaction:input_type -> transactions.v1.CreateTransactionRequest
+	5,   // 94: transactions.v1.Transactions.ListTransactions:input_type -> transactions.v1.ListTransactionsRequest
+	2,   // 95: transactions.v1.Transactions.AuthorizeTransaction:input_type -> transactions.v1.CreateTransactionRequest
+	51,  // 96: transactions.v1.Transactions.CaptureTransaction:input_type -> transactions.v1.CaptureTransactionRequest
+	52,  // 97: transactions.v1.Transactions.VoidTransaction:input_type -> transactions.v1.VoidTransactionRequest
+	53,  // 98: transactions.v1.Transactions.ListStatusChanges:input_type -> transactions.v1.ListStatusChangesRequest
+	56,  // 99: transactions.v1.Transactions.UpdateTransaction:input_type -> transactions.v1.UpdateTransactionRequest
+	59,  // 100: transactions.v1.Transactions.ListTransactionRevisions:input_type -> transactions.v1.ListTransactionRevisionsRequest
+	61,  // 101: transactions.v1.Transactions.ExtendAccess:input_type -> transactions.v1.ExtendAccessRequest
+	80,  // 102: transactions.v1.Transactions.ScheduleTransaction:input_type -> transactions.v1.ScheduleTransactionRequest
+	81,  // 103: transactions.v1.Transactions.ListScheduledTransactions:input_type -> transactions.v1.ListScheduledTransactionsRequest
+	83,  // 104: transactions.v1.Transactions.CancelScheduledTransaction:input_type -> transactions.v1.CancelScheduledTransactionRequest
+	63,  // 105: transactions.v1.Transactions.CreateSubscriptionPlan:input_type -> transactions.v1.SubscriptionPlan
+	64,  // 106: transactions.v1.Transactions.SetSubscriptionPlanActive:input_type -> transactions.v1.SetSubscriptionPlanActiveRequest
+	65,  // 107: transactions.v1.Transactions.ListSubscriptionPlans:input_type -> transactions.v1.ListSubscriptionPlansRequest
+	68,  // 108: transactions.v1.Transactions.Subscribe:input_type -> transactions.v1.SubscribeRequest
+	70,  // 109: transactions.v1.Transactions.GetSubscription:input_type -> transactions.v1.GetSubscriptionRequest
+	71,  // 110: transactions.v1.Transactions.ListSubscriptions:input_type -> transactions.v1.ListSubscriptionsRequest
+	73,  // 111: transactions.v1.Transactions.CancelSubscription:input_type -> transactions.v1.CancelSubscriptionRequest
+	74,  // 112: transactions.v1.Transactions.PauseSubscription:input_type -> transactions.v1.PauseSubscriptionRequest
+	75,  // 113: transactions.v1.Transactions.ResumeSubscription:input_type -> transactions.v1.ResumeSubscriptionRequest
+	77,  // 114: transactions.v1.Transactions.ListSubscriptionEvents:input_type -> transactions.v1.ListSubscriptionEventsRequest
+	8,   // 115: transactions.v1.Transactions.SetSpendingCap:input_type -> transactions.v1.SetSpendingCapRequest
+	9,   // 116: transactions.v1.Transactions.ListSpendingCaps:input_type -> transactions.v1.ListSpendingCapsRequest
+	11,  // 117: transactions.v1.Transactions.ClearSpendingCap:input_type -> transactions.v1.ClearSpendingCapRequest
+	13,  // 118: transactions.v1.Transactions.GetRemainingBudget:input_type -> transactions.v1.GetRemainingBudgetRequest
+	17,  // 119: transactions.v1.Transactions.ListAuditEvents:input_type -> transactions.v1.ListAuditEventsRequest
+	19,  // 120: transactions.v1.Transactions.CreateTenant:input_type -> transactions.v1.Tenant
+	19,  // 121: transactions.v1.Transactions.UpdateTenant:input_type -> transactions.v1.Tenant
+	21,  // 122: transactions.v1.Transactions.ListTenants:input_type -> transactions.v1.ListTenantsRequest
+	23,  // 123: transactions.v1.Transactions.RotateTenantKey:input_type -> transactions.v1.RotateTenantKeyRequest
+	27,  // 124: transactions.v1.Transactions.SetCoin:input_type -> transactions.v1.Coin
+	28,  // 125: transactions.v1.Transactions.ListCoins:input_type -> transactions.v1.ListCoinsRequest
+	30,  // 126: transactions.v1.Transactions.CreatePlatform:input_type -> transactions.v1.Platform
+	30,  // 127: transactions.v1.Transactions.UpdatePlatform:input_type -> transactions.v1.Platform
+	31,  // 128: transactions.v1.Transactions.GetPlatform:input_type -> transactions.v1.GetPlatformRequest
+	32,  // 129: transactions.v1.Transactions.ListPlatforms:input_type -> transactions.v1.ListPlatformsRequest
+	35,  // 130: transactions.v1.Transactions.DeletePlatform:input_type -> transactions.v1.DeletePlatformRequest
+	34,  // 131: transactions.v1.Transactions.RotateWebhookSecret:input_type -> transactions.v1.RotateWebhookSecretRequest
+	38,  // 132: transactions.v1.Transactions.ImportExchangeRates:input_type -> transactions.v1.ImportExchangeRatesRequest
+	40,  // 133: transactions.v1.Transactions.ListExchangeRates:input_type -> transactions.v1.ListExchangeRatesRequest
+	42,  // 134: transactions.v1.Transactions.GetTransactionTotals:input_type -> transactions.v1.GetTransactionTotalsRequest
+	46,  // 135: transactions.v1.Transactions.GetAccountBalance:input_type -> transactions.v1.GetAccountBalanceRequest
+	49,  // 136: transactions.v1.Transactions.GetAccountStatement:input_type -> transactions.v1.GetAccountStatementRequest
+	89,  // 137: transactions.v1.Transactions.ReconcileSettlement:input_type -> transactions.v1.ReconcileSettlementRequest
+	91,  // 138: transactions.v1.Transactions.ListReconciliationRuns:input_type -> transactions.v1.ListReconciliationRunsRequest
+	94,  // 139: transactions.v1.Transactions.ListReconciliationItems:input_type -> transactions.v1.ListReconciliationItemsRequest
+	96,  // 140: transactions.v1.Transactions.ResolveReconciliationItem:input_type -> transactions.v1.ResolveReconciliationItemRequest
+	86,  // 141: transactions.v1.Transactions.ListFraudReviews:input_type -> transactions.v1.ListFraudReviewsRequest
+	88,  // 142: transactions.v1.Transactions.ApproveFraudReview:input_type -> transactions.v1.DecideFraudReviewRequest
+	88,  // 143: transactions.v1.Transactions.DenyFraudReview:input_type -> transactions.v1.DecideFraudReviewRequest
+	24,  // 144: transactions.v1.Transactions.EraseUser:input_type -> transactions.v1.EraseUserRequest
+	26,  // 145: transactions.v1.Transactions.DeleteTransaction:input_type -> transactions.v1.DeleteTransactionRequest
+	3,   // 146: transactions.v1.Transactions.CreateTransaction:output_type -> transactions.v1.Transaction
+	6,   // 147: transactions.v1.Transactions.ListTransactions:output_type -> transactions.v1.ListTransactionsResponse
+	3,   // 148: transactions.v1.Transactions.AuthorizeTransaction:output_type -> transactions.v1.Transaction
+	3,   // 149: transactions.v1.Transactions.CaptureTransaction:output_type -> transactions.v1.Transaction
+	3,   // 150: transactions.v1.Transactions.VoidTransaction:output_type -> transactions.v1.Transaction
+	55,  // 151: transactions.v1.Transactions.ListStatusChanges:output_type -> transactions.v1.ListStatusChangesResponse
+	3,   // 152: transactions.v1.Transactions.UpdateTransaction:output_type -> transactions.v1.Transaction
+	60,  // 153: transactions.v1.Transactions.ListTransactionRevisions:output_type -> transactions.v1.ListTransactionRevisionsResponse
+	62,  // 154: transactions.v1.Transactions.ExtendAccess:output_type -> transactions.v1.ExtendAccessResponse
+	79,  // 155: transactions.v1.Transactions.ScheduleTransaction:output_type -> transactions.v1.ScheduledTransaction
+	82,  // 156: transactions.v1.Transactions.ListScheduledTransactions:output_type -> transactions.v1.ListScheduledTransactionsResponse
+	79,  // 157: transactions.v1.Transactions.CancelScheduledTransaction:output_type -> transactions.v1.ScheduledTransaction
+	63,  // 158: transactions.v1.Transactions.CreateSubscriptionPlan:output_type -> transactions.v1.SubscriptionPlan
+	63,  // 159: transactions.v1.Transactions.SetSubscriptionPlanActive:output_type -> transactions.v1.SubscriptionPlan
+	66,  // 160: transactions.v1.Transactions.ListSubscriptionPlans:output_type -> transactions.v1.ListSubscriptionPlansResponse
+	69,  // 161: transactions.v1.Transactions.Subscribe:output_type -> transactions.v1.SubscribeResponse
+	67,  // 162: transactions.v1.Transactions.GetSubscription:output_type -> transactions.v1.Subscription
+	72,  // 163: transactions.v1.Transactions.ListSubscriptions:output_type -> transactions.v1.ListSubscriptionsResponse
+	67,  // 164: transactions.v1.Transactions.CancelSubscription:output_type -> transactions.v1.Subscription
+	67,  // 165: transactions.v1.Transactions.PauseSubscription:output_type -> transactions.v1.Subscription
+	67,  // 166: transactions.v1.Transactions.ResumeSubscription:output_type -> transactions.v1.Subscription
+	78,  // 167: transactions.v1.Transactions.ListSubscriptionEvents:output_type -> transactions.v1.ListSubscriptionEventsResponse
+	7,   // 168: transactions.v1.Transactions.SetSpendingCap:output_type -> transactions.v1.SpendingCap
+	10,  // 169: transactions.v1.Transactions.ListSpendingCaps:output_type -> transactions.v1.ListSpendingCapsResponse
+	12,  // 170: transactions.v1.Transactions.ClearSpendingCap:output_type -> transactions.v1.ClearSpendingCapResponse
+	15,  // 171: transactions.v1.Transactions.GetRemainingBudget:output_type -> transactions.v1.GetRemainingBudgetResponse
+	18,  // 172: transactions.v1.Transactions.ListAuditEvents:output_type -> transactions.v1.ListAuditEventsResponse
+	20,  // 173: transactions.v1.Transactions.CreateTenant:output_type -> transactions.v1.TenantCredentials
+	19,  // 174: transactions.v1.Transactions.UpdateTenant:output_type -> transactions.v1.Tenant
+	22,  // 175: transactions.v1.Transactions.ListTenants:output_type -> transactions.v1.ListTenantsResponse
+	20,  // 176: transactions.v1.Transactions.RotateTenantKey:output_type -> transactions.v1.TenantCredentials
+	27,  // 177: transactions.v1.Transactions.SetCoin:output_type -> transactions.v1.Coin
+	29,  // 178: transactions.v1.Transactions.ListCoins:output_type -> transactions.v1.ListCoinsResponse
+	30,  // 179: transactions.v1.Transactions.CreatePlatform:output_type -> transactions.v1.Platform
+	30,  // 180: transactions.v1.Transactions.UpdatePlatform:output_type -> transactions.v1.Platform
+	30,  // 181: transactions.v1.Transactions.GetPlatform:output_type -> transactions.v1.Platform
+	33,  // 182: transactions.v1.Transactions.ListPlatforms:output_type -> transactions.v1.ListPlatformsResponse
+	36,  // 183: transactions.v1.Transactions.DeletePlatform:output_type -> transactions.v1.DeletePlatformResponse
+	30,  // 184: transactions.v1.Transactions.RotateWebhookSecret:output_type -> transactions.v1.Platform
+	39,  // 185: transactions.v1.Transactions.ImportExchangeRates:output_type -> transactions.v1.ImportExchangeRatesResponse
+	41,  // 186: transactions.v1.Transactions.ListExchangeRates:output_type -> transactions.v1.ListExchangeRatesResponse
+	44,  // 187: transactions.v1.Transactions.GetTransactionTotals:output_type -> transactions.v1.TransactionTotals
+	47,  // 188: transactions.v1.Transactions.GetAccountBalance:output_type -> transactions.v1.GetAccountBalanceResponse
+	50,  // 189: transactions.v1.Transactions.GetAccountStatement:output_type -> transactions.v1.AccountStatement
+	90,  // 190: transactions.v1.Transactions.ReconcileSettlement:output_type -> transactions.v1.ReconciliationRun
+	92,  // 191: transactions.v1.Transactions.ListReconciliationRuns:output_type -> transactions.v1.ListReconciliationRunsResponse
+	95,  // 192: transactions.v1.Transactions.ListReconciliationItems:output_type -> transactions.v1.ListReconciliationItemsResponse
+	93,  // 193: transactions.v1.Transactions.ResolveReconciliationItem:output_type -> transactions.v1.ReconciliationItem
+	87,  // 194: transactions.v1.Transactions.ListFraudReviews:output_type -> transactions.v1.ListFraudReviewsResponse
+	85,  // 195: transactions.v1.Transactions.ApproveFraudReview:output_type -> transactions.v1.FraudReview
+	85,  // 196: transactions.v1.Transactions.DenyFraudReview:output_type -> transactions.v1.FraudReview
+	25,  // 197: transactions.v1.Transactions.EraseUser:output_type -> transactions.v1.ErasureReceipt
+	3,   // 198: transactions.v1.Transactions.DeleteTransaction:output_type -> transactions.v1.Transaction
+	146, // [146:199] is the sub-list for method output_type
+	93,  // [93:146] is the sub-list for method input_type
+	93,  // [93:93] is the sub-list for extension type_name
+	93,  // [93:93] is the sub-list for extension extendee
+	0,   // [0:93] is the sub-list for field type_name
 }
 
 func init() { file_proto_transactions_proto_init() }
@@ -278,18 +7377,22 @@ func file_proto_transactions_proto_init() {
 	if File_proto_transactions_proto != nil {
 		return
 	}
+	file_proto_transactions_proto_msgTypes[2].OneofWrappers = []any{}
+	file_proto_transactions_proto_msgTypes[87].OneofWrappers = []any{}
+	file_proto_transactions_proto_msgTypes[91].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_transactions_proto_rawDesc), len(file_proto_transactions_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      2,
+			NumMessages:   98,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_transactions_proto_goTypes,
 		DependencyIndexes: file_proto_transactions_proto_depIdxs,
+		EnumInfos:         file_proto_transactions_proto_enumTypes,
 		MessageInfos:      file_proto_transactions_proto_msgTypes,
 	}.Build()
 	File_proto_transactions_proto = out.File
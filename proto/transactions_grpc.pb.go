@@ -19,7 +19,59 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Transactions_CreateTransaction_FullMethodName = "/transactions.v1.Transactions/CreateTransaction"
+	Transactions_CreateTransaction_FullMethodName          = "/transactions.v1.Transactions/CreateTransaction"
+	Transactions_ListTransactions_FullMethodName           = "/transactions.v1.Transactions/ListTransactions"
+	Transactions_AuthorizeTransaction_FullMethodName       = "/transactions.v1.Transactions/AuthorizeTransaction"
+	Transactions_CaptureTransaction_FullMethodName         = "/transactions.v1.Transactions/CaptureTransaction"
+	Transactions_VoidTransaction_FullMethodName            = "/transactions.v1.Transactions/VoidTransaction"
+	Transactions_ListStatusChanges_FullMethodName          = "/transactions.v1.Transactions/ListStatusChanges"
+	Transactions_UpdateTransaction_FullMethodName          = "/transactions.v1.Transactions/UpdateTransaction"
+	Transactions_ListTransactionRevisions_FullMethodName   = "/transactions.v1.Transactions/ListTransactionRevisions"
+	Transactions_ExtendAccess_FullMethodName               = "/transactions.v1.Transactions/ExtendAccess"
+	Transactions_ScheduleTransaction_FullMethodName        = "/transactions.v1.Transactions/ScheduleTransaction"
+	Transactions_ListScheduledTransactions_FullMethodName  = "/transactions.v1.Transactions/ListScheduledTransactions"
+	Transactions_CancelScheduledTransaction_FullMethodName = "/transactions.v1.Transactions/CancelScheduledTransaction"
+	Transactions_CreateSubscriptionPlan_FullMethodName     = "/transactions.v1.Transactions/CreateSubscriptionPlan"
+	Transactions_SetSubscriptionPlanActive_FullMethodName  = "/transactions.v1.Transactions/SetSubscriptionPlanActive"
+	Transactions_ListSubscriptionPlans_FullMethodName      = "/transactions.v1.Transactions/ListSubscriptionPlans"
+	Transactions_Subscribe_FullMethodName                  = "/transactions.v1.Transactions/Subscribe"
+	Transactions_GetSubscription_FullMethodName            = "/transactions.v1.Transactions/GetSubscription"
+	Transactions_ListSubscriptions_FullMethodName          = "/transactions.v1.Transactions/ListSubscriptions"
+	Transactions_CancelSubscription_FullMethodName         = "/transactions.v1.Transactions/CancelSubscription"
+	Transactions_PauseSubscription_FullMethodName          = "/transactions.v1.Transactions/PauseSubscription"
+	Transactions_ResumeSubscription_FullMethodName         = "/transactions.v1.Transactions/ResumeSubscription"
+	Transactions_ListSubscriptionEvents_FullMethodName     = "/transactions.v1.Transactions/ListSubscriptionEvents"
+	Transactions_SetSpendingCap_FullMethodName             = "/transactions.v1.Transactions/SetSpendingCap"
+	Transactions_ListSpendingCaps_FullMethodName           = "/transactions.v1.Transactions/ListSpendingCaps"
+	Transactions_ClearSpendingCap_FullMethodName           = "/transactions.v1.Transactions/ClearSpendingCap"
+	Transactions_GetRemainingBudget_FullMethodName         = "/transactions.v1.Transactions/GetRemainingBudget"
+	Transactions_ListAuditEvents_FullMethodName            = "/transactions.v1.Transactions/ListAuditEvents"
+	Transactions_CreateTenant_FullMethodName               = "/transactions.v1.Transactions/CreateTenant"
+	Transactions_UpdateTenant_FullMethodName               = "/transactions.v1.Transactions/UpdateTenant"
+	Transactions_ListTenants_FullMethodName                = "/transactions.v1.Transactions/ListTenants"
+	Transactions_RotateTenantKey_FullMethodName            = "/transactions.v1.Transactions/RotateTenantKey"
+	Transactions_SetCoin_FullMethodName                    = "/transactions.v1.Transactions/SetCoin"
+	Transactions_ListCoins_FullMethodName                  = "/transactions.v1.Transactions/ListCoins"
+	Transactions_CreatePlatform_FullMethodName             = "/transactions.v1.Transactions/CreatePlatform"
+	Transactions_UpdatePlatform_FullMethodName             = "/transactions.v1.Transactions/UpdatePlatform"
+	Transactions_GetPlatform_FullMethodName                = "/transactions.v1.Transactions/GetPlatform"
+	Transactions_ListPlatforms_FullMethodName              = "/transactions.v1.Transactions/ListPlatforms"
+	Transactions_DeletePlatform_FullMethodName             = "/transactions.v1.Transactions/DeletePlatform"
+	Transactions_RotateWebhookSecret_FullMethodName        = "/transactions.v1.Transactions/RotateWebhookSecret"
+	Transactions_ImportExchangeRates_FullMethodName        = "/transactions.v1.Transactions/ImportExchangeRates"
+	Transactions_ListExchangeRates_FullMethodName          = "/transactions.v1.Transactions/ListExchangeRates"
+	Transactions_GetTransactionTotals_FullMethodName       = "/transactions.v1.Transactions/GetTransactionTotals"
+	Transactions_GetAccountBalance_FullMethodName          = "/transactions.v1.Transactions/GetAccountBalance"
+	Transactions_GetAccountStatement_FullMethodName        = "/transactions.v1.Transactions/GetAccountStatement"
+	Transactions_ReconcileSettlement_FullMethodName        = "/transactions.v1.Transactions/ReconcileSettlement"
+	Transactions_ListReconciliationRuns_FullMethodName     = "/transactions.v1.Transactions/ListReconciliationRuns"
+	Transactions_ListReconciliationItems_FullMethodName    = "/transactions.v1.Transactions/ListReconciliationItems"
+	Transactions_ResolveReconciliationItem_FullMethodName  = "/transactions.v1.Transactions/ResolveReconciliationItem"
+	Transactions_ListFraudReviews_FullMethodName           = "/transactions.v1.Transactions/ListFraudReviews"
+	Transactions_ApproveFraudReview_FullMethodName         = "/transactions.v1.Transactions/ApproveFraudReview"
+	Transactions_DenyFraudReview_FullMethodName            = "/transactions.v1.Transactions/DenyFraudReview"
+	Transactions_EraseUser_FullMethodName                  = "/transactions.v1.Transactions/EraseUser"
+	Transactions_DeleteTransaction_FullMethodName          = "/transactions.v1.Transactions/DeleteTransaction"
 )
 
 // TransactionsClient is the client API for Transactions service.
@@ -27,6 +79,76 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TransactionsClient interface {
 	CreateTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*Transaction, error)
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	// Holds: authorize now, capture or void later. Uncaptured holds are
+	// voided after the hold timeout.
+	AuthorizeTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*Transaction, error)
+	CaptureTransaction(ctx context.Context, in *CaptureTransactionRequest, opts ...grpc.CallOption) (*Transaction, error)
+	VoidTransaction(ctx context.Context, in *VoidTransactionRequest, opts ...grpc.CallOption) (*Transaction, error)
+	ListStatusChanges(ctx context.Context, in *ListStatusChangesRequest, opts ...grpc.CallOption) (*ListStatusChangesResponse, error)
+	// Operator edits guarded by the transaction version; updates are admin only.
+	UpdateTransaction(ctx context.Context, in *UpdateTransactionRequest, opts ...grpc.CallOption) (*Transaction, error)
+	ListTransactionRevisions(ctx context.Context, in *ListTransactionRevisionsRequest, opts ...grpc.CallOption) (*ListTransactionRevisionsResponse, error)
+	// Renewals: charge again and extend the existing entitlement.
+	ExtendAccess(ctx context.Context, in *ExtendAccessRequest, opts ...grpc.CallOption) (*ExtendAccessResponse, error)
+	// Scheduled spends, executed by the worker when due. Listing and
+	// cancelling are limited to the caller's own spends unless admin.
+	ScheduleTransaction(ctx context.Context, in *ScheduleTransactionRequest, opts ...grpc.CallOption) (*ScheduledTransaction, error)
+	ListScheduledTransactions(ctx context.Context, in *ListScheduledTransactionsRequest, opts ...grpc.CallOption) (*ListScheduledTransactionsResponse, error)
+	CancelScheduledTransaction(ctx context.Context, in *CancelScheduledTransactionRequest, opts ...grpc.CallOption) (*ScheduledTransaction, error)
+	// Subscriptions: recurring charges made by the worker. Plans are admin
+	// only; other callers only see and change their own subscriptions.
+	CreateSubscriptionPlan(ctx context.Context, in *SubscriptionPlan, opts ...grpc.CallOption) (*SubscriptionPlan, error)
+	SetSubscriptionPlanActive(ctx context.Context, in *SetSubscriptionPlanActiveRequest, opts ...grpc.CallOption) (*SubscriptionPlan, error)
+	ListSubscriptionPlans(ctx context.Context, in *ListSubscriptionPlansRequest, opts ...grpc.CallOption) (*ListSubscriptionPlansResponse, error)
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error)
+	GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error)
+	ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error)
+	CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error)
+	PauseSubscription(ctx context.Context, in *PauseSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error)
+	ResumeSubscription(ctx context.Context, in *ResumeSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error)
+	ListSubscriptionEvents(ctx context.Context, in *ListSubscriptionEventsRequest, opts ...grpc.CallOption) (*ListSubscriptionEventsResponse, error)
+	// Spending caps. Set and Clear require the admin token.
+	SetSpendingCap(ctx context.Context, in *SetSpendingCapRequest, opts ...grpc.CallOption) (*SpendingCap, error)
+	ListSpendingCaps(ctx context.Context, in *ListSpendingCapsRequest, opts ...grpc.CallOption) (*ListSpendingCapsResponse, error)
+	ClearSpendingCap(ctx context.Context, in *ClearSpendingCapRequest, opts ...grpc.CallOption) (*ClearSpendingCapResponse, error)
+	GetRemainingBudget(ctx context.Context, in *GetRemainingBudgetRequest, opts ...grpc.CallOption) (*GetRemainingBudgetResponse, error)
+	// Audit log of the caller's tenant (admin only).
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// Tenants (admin only).
+	CreateTenant(ctx context.Context, in *Tenant, opts ...grpc.CallOption) (*TenantCredentials, error)
+	UpdateTenant(ctx context.Context, in *Tenant, opts ...grpc.CallOption) (*Tenant, error)
+	ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...grpc.CallOption) (*ListTenantsResponse, error)
+	RotateTenantKey(ctx context.Context, in *RotateTenantKeyRequest, opts ...grpc.CallOption) (*TenantCredentials, error)
+	// Coin catalog. SetCoin requires the admin token.
+	SetCoin(ctx context.Context, in *Coin, opts ...grpc.CallOption) (*Coin, error)
+	ListCoins(ctx context.Context, in *ListCoinsRequest, opts ...grpc.CallOption) (*ListCoinsResponse, error)
+	// Platform registry (admin only).
+	CreatePlatform(ctx context.Context, in *Platform, opts ...grpc.CallOption) (*Platform, error)
+	UpdatePlatform(ctx context.Context, in *Platform, opts ...grpc.CallOption) (*Platform, error)
+	GetPlatform(ctx context.Context, in *GetPlatformRequest, opts ...grpc.CallOption) (*Platform, error)
+	ListPlatforms(ctx context.Context, in *ListPlatformsRequest, opts ...grpc.CallOption) (*ListPlatformsResponse, error)
+	DeletePlatform(ctx context.Context, in *DeletePlatformRequest, opts ...grpc.CallOption) (*DeletePlatformResponse, error)
+	RotateWebhookSecret(ctx context.Context, in *RotateWebhookSecretRequest, opts ...grpc.CallOption) (*Platform, error)
+	// Exchange rates and normalized totals. Writes require the admin token.
+	ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...grpc.CallOption) (*ImportExchangeRatesResponse, error)
+	ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error)
+	GetTransactionTotals(ctx context.Context, in *GetTransactionTotalsRequest, opts ...grpc.CallOption) (*TransactionTotals, error)
+	// Double-entry ledger (admin only).
+	GetAccountBalance(ctx context.Context, in *GetAccountBalanceRequest, opts ...grpc.CallOption) (*GetAccountBalanceResponse, error)
+	GetAccountStatement(ctx context.Context, in *GetAccountStatementRequest, opts ...grpc.CallOption) (*AccountStatement, error)
+	// Settlement reconciliation (admin only).
+	ReconcileSettlement(ctx context.Context, in *ReconcileSettlementRequest, opts ...grpc.CallOption) (*ReconciliationRun, error)
+	ListReconciliationRuns(ctx context.Context, in *ListReconciliationRunsRequest, opts ...grpc.CallOption) (*ListReconciliationRunsResponse, error)
+	ListReconciliationItems(ctx context.Context, in *ListReconciliationItemsRequest, opts ...grpc.CallOption) (*ListReconciliationItemsResponse, error)
+	ResolveReconciliationItem(ctx context.Context, in *ResolveReconciliationItemRequest, opts ...grpc.CallOption) (*ReconciliationItem, error)
+	// Fraud review queue (admin only).
+	ListFraudReviews(ctx context.Context, in *ListFraudReviewsRequest, opts ...grpc.CallOption) (*ListFraudReviewsResponse, error)
+	ApproveFraudReview(ctx context.Context, in *DecideFraudReviewRequest, opts ...grpc.CallOption) (*FraudReview, error)
+	DenyFraudReview(ctx context.Context, in *DecideFraudReviewRequest, opts ...grpc.CallOption) (*FraudReview, error)
+	// Erasure and soft delete (admin only).
+	EraseUser(ctx context.Context, in *EraseUserRequest, opts ...grpc.CallOption) (*ErasureReceipt, error)
+	DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*Transaction, error)
 }
 
 type transactionsClient struct {
@@ -47,59 +169,1741 @@ func (c *transactionsClient) CreateTransaction(ctx context.Context, in *CreateTr
 	return out, nil
 }
 
+func (c *transactionsClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, Transactions_ListTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) AuthorizeTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, Transactions_AuthorizeTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) CaptureTransaction(ctx context.Context, in *CaptureTransactionRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, Transactions_CaptureTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) VoidTransaction(ctx context.Context, in *VoidTransactionRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, Transactions_VoidTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ListStatusChanges(ctx context.Context, in *ListStatusChangesRequest, opts ...grpc.CallOption) (*ListStatusChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStatusChangesResponse)
+	err := c.cc.Invoke(ctx, Transactions_ListStatusChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) UpdateTransaction(ctx context.Context, in *UpdateTransactionRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, Transactions_UpdateTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ListTransactionRevisions(ctx context.Context, in *ListTransactionRevisionsRequest, opts ...grpc.CallOption) (*ListTransactionRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransactionRevisionsResponse)
+	err := c.cc.Invoke(ctx, Transactions_ListTransactionRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ExtendAccess(ctx context.Context, in *ExtendAccessRequest, opts ...grpc.CallOption) (*ExtendAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendAccessResponse)
+	err := c.cc.Invoke(ctx, Transactions_ExtendAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ScheduleTransaction(ctx context.Context, in *ScheduleTransactionRequest, opts ...grpc.CallOption) (*ScheduledTransaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledTransaction)
+	err := c.cc.Invoke(ctx, Transactions_ScheduleTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ListScheduledTransactions(ctx context.Context, in *ListScheduledTransactionsRequest, opts ...grpc.CallOption) (*ListScheduledTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledTransactionsResponse)
+	err := c.cc.Invoke(ctx, Transactions_ListScheduledTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) CancelScheduledTransaction(ctx context.Context, in *CancelScheduledTransactionRequest, opts ...grpc.CallOption) (*ScheduledTransaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledTransaction)
+	err := c.cc.Invoke(ctx, Transactions_CancelScheduledTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) CreateSubscriptionPlan(ctx context.Context, in *SubscriptionPlan, opts ...grpc.CallOption) (*SubscriptionPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionPlan)
+	err := c.cc.Invoke(ctx, Transactions_CreateSubscriptionPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) SetSubscriptionPlanActive(ctx context.Context, in *SetSubscriptionPlanActiveRequest, opts ...grpc.CallOption) (*SubscriptionPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionPlan)
+	err := c.cc.Invoke(ctx, Transactions_SetSubscriptionPlanActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ListSubscriptionPlans(ctx context.Context, in *ListSubscriptionPlansRequest, opts ...grpc.CallOption) (*ListSubscriptionPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscriptionPlansResponse)
+	err := c.cc.Invoke(ctx, Transactions_ListSubscriptionPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscribeResponse)
+	err := c.cc.Invoke(ctx, Transactions_Subscribe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subscription)
+	err := c.cc.Invoke(ctx, Transactions_GetSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, Transactions_ListSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subscription)
+	err := c.cc.Invoke(ctx, Transactions_CancelSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) PauseSubscription(ctx context.Context, in *PauseSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subscription)
+	err := c.cc.Invoke(ctx, Transactions_PauseSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ResumeSubscription(ctx context.Context, in *ResumeSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subscription)
+	err := c.cc.Invoke(ctx, Transactions_ResumeSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ListSubscriptionEvents(ctx context.Context, in *ListSubscriptionEventsRequest, opts ...grpc.CallOption) (*ListSubscriptionEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscriptionEventsResponse)
+	err := c.cc.Invoke(ctx, Transactions_ListSubscriptionEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) SetSpendingCap(ctx context.Context, in *SetSpendingCapRequest, opts ...grpc.CallOption) (*SpendingCap, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpendingCap)
+	err := c.cc.Invoke(ctx, Transactions_SetSpendingCap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ListSpendingCaps(ctx context.Context, in *ListSpendingCapsRequest, opts ...grpc.CallOption) (*ListSpendingCapsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSpendingCapsResponse)
+	err := c.cc.Invoke(ctx, Transactions_ListSpendingCaps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ClearSpendingCap(ctx context.Context, in *ClearSpendingCapRequest, opts ...grpc.CallOption) (*ClearSpendingCapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearSpendingCapResponse)
+	err := c.cc.Invoke(ctx, Transactions_ClearSpendingCap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) GetRemainingBudget(ctx context.Context, in *GetRemainingBudgetRequest, opts ...grpc.CallOption) (*GetRemainingBudgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRemainingBudgetResponse)
+	err := c.cc.Invoke(ctx, Transactions_GetRemainingBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, Transactions_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) CreateTenant(ctx context.Context, in *Tenant, opts ...grpc.CallOption) (*TenantCredentials, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantCredentials)
+	err := c.cc.Invoke(ctx, Transactions_CreateTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) UpdateTenant(ctx context.Context, in *Tenant, opts ...grpc.CallOption) (*Tenant, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tenant)
+	err := c.cc.Invoke(ctx, Transactions_UpdateTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...grpc.CallOption) (*ListTenantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenantsResponse)
+	err := c.cc.Invoke(ctx, Transactions_ListTenants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) RotateTenantKey(ctx context.Context, in *RotateTenantKeyRequest, opts ...grpc.CallOption) (*TenantCredentials, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantCredentials)
+	err := c.cc.Invoke(ctx, Transactions_RotateTenantKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) SetCoin(ctx context.Context, in *Coin, opts ...grpc.CallOption) (*Coin, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Coin)
+	err := c.cc.Invoke(ctx, Transactions_SetCoin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ListCoins(ctx context.Context, in *ListCoinsRequest, opts ...grpc.CallOption) (*ListCoinsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCoinsResponse)
+	err := c.cc.Invoke(ctx, Transactions_ListCoins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) CreatePlatform(ctx context.Context, in *Platform, opts ...grpc.CallOption) (*Platform, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Platform)
+	err := c.cc.Invoke(ctx, Transactions_CreatePlatform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) UpdatePlatform(ctx context.Context, in *Platform, opts ...grpc.CallOption) (*Platform, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Platform)
+	err := c.cc.Invoke(ctx, Transactions_UpdatePlatform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) GetPlatform(ctx context.Context, in *GetPlatformRequest, opts ...grpc.CallOption) (*Platform, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Platform)
+	err := c.cc.Invoke(ctx, Transactions_GetPlatform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ListPlatforms(ctx context.Context, in *ListPlatformsRequest, opts ...grpc.CallOption) (*ListPlatformsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlatformsResponse)
+	err := c.cc.Invoke(ctx, Transactions_ListPlatforms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) DeletePlatform(ctx context.Context, in *DeletePlatformRequest, opts ...grpc.CallOption) (*DeletePlatformResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePlatformResponse)
+	err := c.cc.Invoke(ctx, Transactions_DeletePlatform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) RotateWebhookSecret(ctx context.Context, in *RotateWebhookSecretRequest, opts ...grpc.CallOption) (*Platform, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Platform)
+	err := c.cc.Invoke(ctx, Transactions_RotateWebhookSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...grpc.CallOption) (*ImportExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, Transactions_ImportExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, Transactions_ListExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) GetTransactionTotals(ctx context.Context, in *GetTransactionTotalsRequest, opts ...grpc.CallOption) (*TransactionTotals, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionTotals)
+	err := c.cc.Invoke(ctx, Transactions_GetTransactionTotals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) GetAccountBalance(ctx context.Context, in *GetAccountBalanceRequest, opts ...grpc.CallOption) (*GetAccountBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountBalanceResponse)
+	err := c.cc.Invoke(ctx, Transactions_GetAccountBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) GetAccountStatement(ctx context.Context, in *GetAccountStatementRequest, opts ...grpc.CallOption) (*AccountStatement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountStatement)
+	err := c.cc.Invoke(ctx, Transactions_GetAccountStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ReconcileSettlement(ctx context.Context, in *ReconcileSettlementRequest, opts ...grpc.CallOption) (*ReconciliationRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconciliationRun)
+	err := c.cc.Invoke(ctx, Transactions_ReconcileSettlement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ListReconciliationRuns(ctx context.Context, in *ListReconciliationRunsRequest, opts ...grpc.CallOption) (*ListReconciliationRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReconciliationRunsResponse)
+	err := c.cc.Invoke(ctx, Transactions_ListReconciliationRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ListReconciliationItems(ctx context.Context, in *ListReconciliationItemsRequest, opts ...grpc.CallOption) (*ListReconciliationItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReconciliationItemsResponse)
+	err := c.cc.Invoke(ctx, Transactions_ListReconciliationItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ResolveReconciliationItem(ctx context.Context, in *ResolveReconciliationItemRequest, opts ...grpc.CallOption) (*ReconciliationItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconciliationItem)
+	err := c.cc.Invoke(ctx, Transactions_ResolveReconciliationItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ListFraudReviews(ctx context.Context, in *ListFraudReviewsRequest, opts ...grpc.CallOption) (*ListFraudReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFraudReviewsResponse)
+	err := c.cc.Invoke(ctx, Transactions_ListFraudReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) ApproveFraudReview(ctx context.Context, in *DecideFraudReviewRequest, opts ...grpc.CallOption) (*FraudReview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FraudReview)
+	err := c.cc.Invoke(ctx, Transactions_ApproveFraudReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) DenyFraudReview(ctx context.Context, in *DecideFraudReviewRequest, opts ...grpc.CallOption) (*FraudReview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FraudReview)
+	err := c.cc.Invoke(ctx, Transactions_DenyFraudReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) EraseUser(ctx context.Context, in *EraseUserRequest, opts ...grpc.CallOption) (*ErasureReceipt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ErasureReceipt)
+	err := c.cc.Invoke(ctx, Transactions_EraseUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsClient) DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, Transactions_DeleteTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransactionsServer is the server API for Transactions service.
 // All implementations must embed UnimplementedTransactionsServer
 // for forward compatibility.
 type TransactionsServer interface {
 	CreateTransaction(context.Context, *CreateTransactionRequest) (*Transaction, error)
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	// Holds: authorize now, capture or void later. Uncaptured holds are
+	// voided after the hold timeout.
+	AuthorizeTransaction(context.Context, *CreateTransactionRequest) (*Transaction, error)
+	CaptureTransaction(context.Context, *CaptureTransactionRequest) (*Transaction, error)
+	VoidTransaction(context.Context, *VoidTransactionRequest) (*Transaction, error)
+	ListStatusChanges(context.Context, *ListStatusChangesRequest) (*ListStatusChangesResponse, error)
+	// Operator edits guarded by the transaction version; updates are admin only.
+	UpdateTransaction(context.Context, *UpdateTransactionRequest) (*Transaction, error)
+	ListTransactionRevisions(context.Context, *ListTransactionRevisionsRequest) (*ListTransactionRevisionsResponse, error)
+	// Renewals: charge again and extend the existing entitlement.
+	ExtendAccess(context.Context, *ExtendAccessRequest) (*ExtendAccessResponse, error)
+	// Scheduled spends, executed by the worker when due. Listing and
+	// cancelling are limited to the caller's own spends unless admin.
+	ScheduleTransaction(context.Context, *ScheduleTransactionRequest) (*ScheduledTransaction, error)
+	ListScheduledTransactions(context.Context, *ListScheduledTransactionsRequest) (*ListScheduledTransactionsResponse, error)
+	CancelScheduledTransaction(context.Context, *CancelScheduledTransactionRequest) (*ScheduledTransaction, error)
+	// Subscriptions: recurring charges made by the worker. Plans are admin
+	// only; other callers only see and change their own subscriptions.
+	CreateSubscriptionPlan(context.Context, *SubscriptionPlan) (*SubscriptionPlan, error)
+	SetSubscriptionPlanActive(context.Context, *SetSubscriptionPlanActiveRequest) (*SubscriptionPlan, error)
+	ListSubscriptionPlans(context.Context, *ListSubscriptionPlansRequest) (*ListSubscriptionPlansResponse, error)
+	Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error)
+	GetSubscription(context.Context, *GetSubscriptionRequest) (*Subscription, error)
+	ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error)
+	CancelSubscription(context.Context, *CancelSubscriptionRequest) (*Subscription, error)
+	PauseSubscription(context.Context, *PauseSubscriptionRequest) (*Subscription, error)
+	ResumeSubscription(context.Context, *ResumeSubscriptionRequest) (*Subscription, error)
+	ListSubscriptionEvents(context.Context, *ListSubscriptionEventsRequest) (*ListSubscriptionEventsResponse, error)
+	// Spending caps. Set and Clear require the admin token.
+	SetSpendingCap(context.Context, *SetSpendingCapRequest) (*SpendingCap, error)
+	ListSpendingCaps(context.Context, *ListSpendingCapsRequest) (*ListSpendingCapsResponse, error)
+	ClearSpendingCap(context.Context, *ClearSpendingCapRequest) (*ClearSpendingCapResponse, error)
+	GetRemainingBudget(context.Context, *GetRemainingBudgetRequest) (*GetRemainingBudgetResponse, error)
+	// Audit log of the caller's tenant (admin only).
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// Tenants (admin only).
+	CreateTenant(context.Context, *Tenant) (*TenantCredentials, error)
+	UpdateTenant(context.Context, *Tenant) (*Tenant, error)
+	ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsResponse, error)
+	RotateTenantKey(context.Context, *RotateTenantKeyRequest) (*TenantCredentials, error)
+	// Coin catalog. SetCoin requires the admin token.
+	SetCoin(context.Context, *Coin) (*Coin, error)
+	ListCoins(context.Context, *ListCoinsRequest) (*ListCoinsResponse, error)
+	// Platform registry (admin only).
+	CreatePlatform(context.Context, *Platform) (*Platform, error)
+	UpdatePlatform(context.Context, *Platform) (*Platform, error)
+	GetPlatform(context.Context, *GetPlatformRequest) (*Platform, error)
+	ListPlatforms(context.Context, *ListPlatformsRequest) (*ListPlatformsResponse, error)
+	DeletePlatform(context.Context, *DeletePlatformRequest) (*DeletePlatformResponse, error)
+	RotateWebhookSecret(context.Context, *RotateWebhookSecretRequest) (*Platform, error)
+	// Exchange rates and normalized totals. Writes require the admin token.
+	ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ImportExchangeRatesResponse, error)
+	ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error)
+	GetTransactionTotals(context.Context, *GetTransactionTotalsRequest) (*TransactionTotals, error)
+	// Double-entry ledger (admin only).
+	GetAccountBalance(context.Context, *GetAccountBalanceRequest) (*GetAccountBalanceResponse, error)
+	GetAccountStatement(context.Context, *GetAccountStatementRequest) (*AccountStatement, error)
+	// Settlement reconciliation (admin only).
+	ReconcileSettlement(context.Context, *ReconcileSettlementRequest) (*ReconciliationRun, error)
+	ListReconciliationRuns(context.Context, *ListReconciliationRunsRequest) (*ListReconciliationRunsResponse, error)
+	ListReconciliationItems(context.Context, *ListReconciliationItemsRequest) (*ListReconciliationItemsResponse, error)
+	ResolveReconciliationItem(context.Context, *ResolveReconciliationItemRequest) (*ReconciliationItem, error)
+	// Fraud review queue (admin only).
+	ListFraudReviews(context.Context, *ListFraudReviewsRequest) (*ListFraudReviewsResponse, error)
+	ApproveFraudReview(context.Context, *DecideFraudReviewRequest) (*FraudReview, error)
+	DenyFraudReview(context.Context, *DecideFraudReviewRequest) (*FraudReview, error)
+	// Erasure and soft delete (admin only).
+	EraseUser(context.Context, *EraseUserRequest) (*ErasureReceipt, error)
+	DeleteTransaction(context.Context, *DeleteTransactionRequest) (*Transaction, error)
+	mustEmbedUnimplementedTransactionsServer()
+}
+
+// UnimplementedTransactionsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTransactionsServer struct{}
+
+func (UnimplementedTransactionsServer) CreateTransaction(context.Context, *CreateTransactionRequest) (*Transaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransaction not implemented")
+}
+func (UnimplementedTransactionsServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedTransactionsServer) AuthorizeTransaction(context.Context, *CreateTransactionRequest) (*Transaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeTransaction not implemented")
+}
+func (UnimplementedTransactionsServer) CaptureTransaction(context.Context, *CaptureTransactionRequest) (*Transaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptureTransaction not implemented")
+}
+func (UnimplementedTransactionsServer) VoidTransaction(context.Context, *VoidTransactionRequest) (*Transaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidTransaction not implemented")
+}
+func (UnimplementedTransactionsServer) ListStatusChanges(context.Context, *ListStatusChangesRequest) (*ListStatusChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStatusChanges not implemented")
+}
+func (UnimplementedTransactionsServer) UpdateTransaction(context.Context, *UpdateTransactionRequest) (*Transaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTransaction not implemented")
+}
+func (UnimplementedTransactionsServer) ListTransactionRevisions(context.Context, *ListTransactionRevisionsRequest) (*ListTransactionRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactionRevisions not implemented")
+}
+func (UnimplementedTransactionsServer) ExtendAccess(context.Context, *ExtendAccessRequest) (*ExtendAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendAccess not implemented")
+}
+func (UnimplementedTransactionsServer) ScheduleTransaction(context.Context, *ScheduleTransactionRequest) (*ScheduledTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleTransaction not implemented")
+}
+func (UnimplementedTransactionsServer) ListScheduledTransactions(context.Context, *ListScheduledTransactionsRequest) (*ListScheduledTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledTransactions not implemented")
+}
+func (UnimplementedTransactionsServer) CancelScheduledTransaction(context.Context, *CancelScheduledTransactionRequest) (*ScheduledTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledTransaction not implemented")
+}
+func (UnimplementedTransactionsServer) CreateSubscriptionPlan(context.Context, *SubscriptionPlan) (*SubscriptionPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscriptionPlan not implemented")
+}
+func (UnimplementedTransactionsServer) SetSubscriptionPlanActive(context.Context, *SetSubscriptionPlanActiveRequest) (*SubscriptionPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSubscriptionPlanActive not implemented")
+}
+func (UnimplementedTransactionsServer) ListSubscriptionPlans(context.Context, *ListSubscriptionPlansRequest) (*ListSubscriptionPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptionPlans not implemented")
+}
+func (UnimplementedTransactionsServer) Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedTransactionsServer) GetSubscription(context.Context, *GetSubscriptionRequest) (*Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscription not implemented")
+}
+func (UnimplementedTransactionsServer) ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptions not implemented")
+}
+func (UnimplementedTransactionsServer) CancelSubscription(context.Context, *CancelSubscriptionRequest) (*Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSubscription not implemented")
+}
+func (UnimplementedTransactionsServer) PauseSubscription(context.Context, *PauseSubscriptionRequest) (*Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSubscription not implemented")
+}
+func (UnimplementedTransactionsServer) ResumeSubscription(context.Context, *ResumeSubscriptionRequest) (*Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSubscription not implemented")
+}
+func (UnimplementedTransactionsServer) ListSubscriptionEvents(context.Context, *ListSubscriptionEventsRequest) (*ListSubscriptionEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptionEvents not implemented")
+}
+func (UnimplementedTransactionsServer) SetSpendingCap(context.Context, *SetSpendingCapRequest) (*SpendingCap, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSpendingCap not implemented")
+}
+func (UnimplementedTransactionsServer) ListSpendingCaps(context.Context, *ListSpendingCapsRequest) (*ListSpendingCapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSpendingCaps not implemented")
+}
+func (UnimplementedTransactionsServer) ClearSpendingCap(context.Context, *ClearSpendingCapRequest) (*ClearSpendingCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearSpendingCap not implemented")
+}
+func (UnimplementedTransactionsServer) GetRemainingBudget(context.Context, *GetRemainingBudgetRequest) (*GetRemainingBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRemainingBudget not implemented")
+}
+func (UnimplementedTransactionsServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedTransactionsServer) CreateTenant(context.Context, *Tenant) (*TenantCredentials, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTenant not implemented")
+}
+func (UnimplementedTransactionsServer) UpdateTenant(context.Context, *Tenant) (*Tenant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenant not implemented")
+}
+func (UnimplementedTransactionsServer) ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenants not implemented")
+}
+func (UnimplementedTransactionsServer) RotateTenantKey(context.Context, *RotateTenantKeyRequest) (*TenantCredentials, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateTenantKey not implemented")
+}
+func (UnimplementedTransactionsServer) SetCoin(context.Context, *Coin) (*Coin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCoin not implemented")
+}
+func (UnimplementedTransactionsServer) ListCoins(context.Context, *ListCoinsRequest) (*ListCoinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCoins not implemented")
+}
+func (UnimplementedTransactionsServer) CreatePlatform(context.Context, *Platform) (*Platform, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlatform not implemented")
+}
+func (UnimplementedTransactionsServer) UpdatePlatform(context.Context, *Platform) (*Platform, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlatform not implemented")
+}
+func (UnimplementedTransactionsServer) GetPlatform(context.Context, *GetPlatformRequest) (*Platform, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlatform not implemented")
+}
+func (UnimplementedTransactionsServer) ListPlatforms(context.Context, *ListPlatformsRequest) (*ListPlatformsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlatforms not implemented")
+}
+func (UnimplementedTransactionsServer) DeletePlatform(context.Context, *DeletePlatformRequest) (*DeletePlatformResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlatform not implemented")
+}
+func (UnimplementedTransactionsServer) RotateWebhookSecret(context.Context, *RotateWebhookSecretRequest) (*Platform, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateWebhookSecret not implemented")
+}
+func (UnimplementedTransactionsServer) ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ImportExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportExchangeRates not implemented")
+}
+func (UnimplementedTransactionsServer) ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExchangeRates not implemented")
+}
+func (UnimplementedTransactionsServer) GetTransactionTotals(context.Context, *GetTransactionTotalsRequest) (*TransactionTotals, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionTotals not implemented")
+}
+func (UnimplementedTransactionsServer) GetAccountBalance(context.Context, *GetAccountBalanceRequest) (*GetAccountBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountBalance not implemented")
+}
+func (UnimplementedTransactionsServer) GetAccountStatement(context.Context, *GetAccountStatementRequest) (*AccountStatement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountStatement not implemented")
+}
+func (UnimplementedTransactionsServer) ReconcileSettlement(context.Context, *ReconcileSettlementRequest) (*ReconciliationRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileSettlement not implemented")
+}
+func (UnimplementedTransactionsServer) ListReconciliationRuns(context.Context, *ListReconciliationRunsRequest) (*ListReconciliationRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReconciliationRuns not implemented")
+}
+func (UnimplementedTransactionsServer) ListReconciliationItems(context.Context, *ListReconciliationItemsRequest) (*ListReconciliationItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReconciliationItems not implemented")
+}
+func (UnimplementedTransactionsServer) ResolveReconciliationItem(context.Context, *ResolveReconciliationItemRequest) (*ReconciliationItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReconciliationItem not implemented")
+}
+func (UnimplementedTransactionsServer) ListFraudReviews(context.Context, *ListFraudReviewsRequest) (*ListFraudReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFraudReviews not implemented")
+}
+func (UnimplementedTransactionsServer) ApproveFraudReview(context.Context, *DecideFraudReviewRequest) (*FraudReview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveFraudReview not implemented")
+}
+func (UnimplementedTransactionsServer) DenyFraudReview(context.Context, *DecideFraudReviewRequest) (*FraudReview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenyFraudReview not implemented")
+}
+func (UnimplementedTransactionsServer) EraseUser(context.Context, *EraseUserRequest) (*ErasureReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseUser not implemented")
+}
+func (UnimplementedTransactionsServer) DeleteTransaction(context.Context, *DeleteTransactionRequest) (*Transaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTransaction not implemented")
+}
+func (UnimplementedTransactionsServer) mustEmbedUnimplementedTransactionsServer() {}
+func (UnimplementedTransactionsServer) testEmbeddedByValue()                      {}
+
+// UnsafeTransactionsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TransactionsServer will
+// result in compilation errors.
+type UnsafeTransactionsServer interface {
 	mustEmbedUnimplementedTransactionsServer()
 }
 
-// UnimplementedTransactionsServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedTransactionsServer struct{}
+func RegisterTransactionsServer(s grpc.ServiceRegistrar, srv TransactionsServer) {
+	// If the following call pancis, it indicates UnimplementedTransactionsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Transactions_ServiceDesc, srv)
+}
+
+func _Transactions_CreateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).CreateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_CreateTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).CreateTransaction(ctx, req.(*CreateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ListTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_AuthorizeTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).AuthorizeTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_AuthorizeTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).AuthorizeTransaction(ctx, req.(*CreateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_CaptureTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).CaptureTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_CaptureTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).CaptureTransaction(ctx, req.(*CaptureTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_VoidTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).VoidTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_VoidTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).VoidTransaction(ctx, req.(*VoidTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ListStatusChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStatusChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ListStatusChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ListStatusChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ListStatusChanges(ctx, req.(*ListStatusChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_UpdateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).UpdateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_UpdateTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).UpdateTransaction(ctx, req.(*UpdateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ListTransactionRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ListTransactionRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ListTransactionRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ListTransactionRevisions(ctx, req.(*ListTransactionRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ExtendAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ExtendAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ExtendAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ExtendAccess(ctx, req.(*ExtendAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ScheduleTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ScheduleTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ScheduleTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ScheduleTransaction(ctx, req.(*ScheduleTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ListScheduledTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ListScheduledTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ListScheduledTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ListScheduledTransactions(ctx, req.(*ListScheduledTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_CancelScheduledTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).CancelScheduledTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_CancelScheduledTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).CancelScheduledTransaction(ctx, req.(*CancelScheduledTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_CreateSubscriptionPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionPlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).CreateSubscriptionPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_CreateSubscriptionPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).CreateSubscriptionPlan(ctx, req.(*SubscriptionPlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_SetSubscriptionPlanActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSubscriptionPlanActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).SetSubscriptionPlanActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_SetSubscriptionPlanActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).SetSubscriptionPlanActive(ctx, req.(*SetSubscriptionPlanActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ListSubscriptionPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ListSubscriptionPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ListSubscriptionPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ListSubscriptionPlans(ctx, req.(*ListSubscriptionPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_Subscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).Subscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_Subscribe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).Subscribe(ctx, req.(*SubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_GetSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).GetSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_GetSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).GetSubscription(ctx, req.(*GetSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ListSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ListSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ListSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ListSubscriptions(ctx, req.(*ListSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).CancelSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_CancelSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).CancelSubscription(ctx, req.(*CancelSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_PauseSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).PauseSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_PauseSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).PauseSubscription(ctx, req.(*PauseSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ResumeSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ResumeSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ResumeSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ResumeSubscription(ctx, req.(*ResumeSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ListSubscriptionEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ListSubscriptionEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ListSubscriptionEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ListSubscriptionEvents(ctx, req.(*ListSubscriptionEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_SetSpendingCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSpendingCapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).SetSpendingCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_SetSpendingCap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).SetSpendingCap(ctx, req.(*SetSpendingCapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ListSpendingCaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSpendingCapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ListSpendingCaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ListSpendingCaps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ListSpendingCaps(ctx, req.(*ListSpendingCapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ClearSpendingCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearSpendingCapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ClearSpendingCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ClearSpendingCap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ClearSpendingCap(ctx, req.(*ClearSpendingCapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_GetRemainingBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRemainingBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).GetRemainingBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_GetRemainingBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).GetRemainingBudget(ctx, req.(*GetRemainingBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tenant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).CreateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_CreateTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).CreateTenant(ctx, req.(*Tenant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_UpdateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tenant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).UpdateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_UpdateTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).UpdateTenant(ctx, req.(*Tenant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ListTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ListTenants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ListTenants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ListTenants(ctx, req.(*ListTenantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_RotateTenantKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateTenantKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).RotateTenantKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_RotateTenantKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).RotateTenantKey(ctx, req.(*RotateTenantKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_SetCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Coin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).SetCoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_SetCoin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).SetCoin(ctx, req.(*Coin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ListCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCoinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ListCoins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ListCoins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ListCoins(ctx, req.(*ListCoinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_CreatePlatform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Platform)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).CreatePlatform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_CreatePlatform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).CreatePlatform(ctx, req.(*Platform))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_UpdatePlatform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Platform)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).UpdatePlatform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_UpdatePlatform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).UpdatePlatform(ctx, req.(*Platform))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_GetPlatform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlatformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).GetPlatform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_GetPlatform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).GetPlatform(ctx, req.(*GetPlatformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ListPlatforms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlatformsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ListPlatforms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ListPlatforms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ListPlatforms(ctx, req.(*ListPlatformsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_DeletePlatform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlatformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).DeletePlatform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_DeletePlatform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).DeletePlatform(ctx, req.(*DeletePlatformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_RotateWebhookSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateWebhookSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).RotateWebhookSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_RotateWebhookSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).RotateWebhookSecret(ctx, req.(*RotateWebhookSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ImportExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ImportExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ImportExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ImportExchangeRates(ctx, req.(*ImportExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ListExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ListExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ListExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ListExchangeRates(ctx, req.(*ListExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_GetTransactionTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).GetTransactionTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_GetTransactionTotals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).GetTransactionTotals(ctx, req.(*GetTransactionTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_GetAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).GetAccountBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_GetAccountBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).GetAccountBalance(ctx, req.(*GetAccountBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedTransactionsServer) CreateTransaction(context.Context, *CreateTransactionRequest) (*Transaction, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTransaction not implemented")
+func _Transactions_GetAccountStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).GetAccountStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_GetAccountStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).GetAccountStatement(ctx, req.(*GetAccountStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTransactionsServer) mustEmbedUnimplementedTransactionsServer() {}
-func (UnimplementedTransactionsServer) testEmbeddedByValue()                      {}
 
-// UnsafeTransactionsServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to TransactionsServer will
-// result in compilation errors.
-type UnsafeTransactionsServer interface {
-	mustEmbedUnimplementedTransactionsServer()
+func _Transactions_ReconcileSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileSettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ReconcileSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ReconcileSettlement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ReconcileSettlement(ctx, req.(*ReconcileSettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterTransactionsServer(s grpc.ServiceRegistrar, srv TransactionsServer) {
-	// If the following call pancis, it indicates UnimplementedTransactionsServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _Transactions_ListReconciliationRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReconciliationRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&Transactions_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(TransactionsServer).ListReconciliationRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ListReconciliationRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ListReconciliationRuns(ctx, req.(*ListReconciliationRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Transactions_CreateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTransactionRequest)
+func _Transactions_ListReconciliationItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReconciliationItemsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TransactionsServer).CreateTransaction(ctx, in)
+		return srv.(TransactionsServer).ListReconciliationItems(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Transactions_CreateTransaction_FullMethodName,
+		FullMethod: Transactions_ListReconciliationItems_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransactionsServer).CreateTransaction(ctx, req.(*CreateTransactionRequest))
+		return srv.(TransactionsServer).ListReconciliationItems(ctx, req.(*ListReconciliationItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ResolveReconciliationItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReconciliationItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ResolveReconciliationItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ResolveReconciliationItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ResolveReconciliationItem(ctx, req.(*ResolveReconciliationItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ListFraudReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFraudReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ListFraudReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ListFraudReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ListFraudReviews(ctx, req.(*ListFraudReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_ApproveFraudReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideFraudReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).ApproveFraudReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_ApproveFraudReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).ApproveFraudReview(ctx, req.(*DecideFraudReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_DenyFraudReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideFraudReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).DenyFraudReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_DenyFraudReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).DenyFraudReview(ctx, req.(*DecideFraudReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_EraseUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).EraseUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_EraseUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).EraseUser(ctx, req.(*EraseUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactions_DeleteTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServer).DeleteTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactions_DeleteTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServer).DeleteTransaction(ctx, req.(*DeleteTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -115,6 +1919,214 @@ var Transactions_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateTransaction",
 			Handler:    _Transactions_CreateTransaction_Handler,
 		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _Transactions_ListTransactions_Handler,
+		},
+		{
+			MethodName: "AuthorizeTransaction",
+			Handler:    _Transactions_AuthorizeTransaction_Handler,
+		},
+		{
+			MethodName: "CaptureTransaction",
+			Handler:    _Transactions_CaptureTransaction_Handler,
+		},
+		{
+			MethodName: "VoidTransaction",
+			Handler:    _Transactions_VoidTransaction_Handler,
+		},
+		{
+			MethodName: "ListStatusChanges",
+			Handler:    _Transactions_ListStatusChanges_Handler,
+		},
+		{
+			MethodName: "UpdateTransaction",
+			Handler:    _Transactions_UpdateTransaction_Handler,
+		},
+		{
+			MethodName: "ListTransactionRevisions",
+			Handler:    _Transactions_ListTransactionRevisions_Handler,
+		},
+		{
+			MethodName: "ExtendAccess",
+			Handler:    _Transactions_ExtendAccess_Handler,
+		},
+		{
+			MethodName: "ScheduleTransaction",
+			Handler:    _Transactions_ScheduleTransaction_Handler,
+		},
+		{
+			MethodName: "ListScheduledTransactions",
+			Handler:    _Transactions_ListScheduledTransactions_Handler,
+		},
+		{
+			MethodName: "CancelScheduledTransaction",
+			Handler:    _Transactions_CancelScheduledTransaction_Handler,
+		},
+		{
+			MethodName: "CreateSubscriptionPlan",
+			Handler:    _Transactions_CreateSubscriptionPlan_Handler,
+		},
+		{
+			MethodName: "SetSubscriptionPlanActive",
+			Handler:    _Transactions_SetSubscriptionPlanActive_Handler,
+		},
+		{
+			MethodName: "ListSubscriptionPlans",
+			Handler:    _Transactions_ListSubscriptionPlans_Handler,
+		},
+		{
+			MethodName: "Subscribe",
+			Handler:    _Transactions_Subscribe_Handler,
+		},
+		{
+			MethodName: "GetSubscription",
+			Handler:    _Transactions_GetSubscription_Handler,
+		},
+		{
+			MethodName: "ListSubscriptions",
+			Handler:    _Transactions_ListSubscriptions_Handler,
+		},
+		{
+			MethodName: "CancelSubscription",
+			Handler:    _Transactions_CancelSubscription_Handler,
+		},
+		{
+			MethodName: "PauseSubscription",
+			Handler:    _Transactions_PauseSubscription_Handler,
+		},
+		{
+			MethodName: "ResumeSubscription",
+			Handler:    _Transactions_ResumeSubscription_Handler,
+		},
+		{
+			MethodName: "ListSubscriptionEvents",
+			Handler:    _Transactions_ListSubscriptionEvents_Handler,
+		},
+		{
+			MethodName: "SetSpendingCap",
+			Handler:    _Transactions_SetSpendingCap_Handler,
+		},
+		{
+			MethodName: "ListSpendingCaps",
+			Handler:    _Transactions_ListSpendingCaps_Handler,
+		},
+		{
+			MethodName: "ClearSpendingCap",
+			Handler:    _Transactions_ClearSpendingCap_Handler,
+		},
+		{
+			MethodName: "GetRemainingBudget",
+			Handler:    _Transactions_GetRemainingBudget_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Transactions_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "CreateTenant",
+			Handler:    _Transactions_CreateTenant_Handler,
+		},
+		{
+			MethodName: "UpdateTenant",
+			Handler:    _Transactions_UpdateTenant_Handler,
+		},
+		{
+			MethodName: "ListTenants",
+			Handler:    _Transactions_ListTenants_Handler,
+		},
+		{
+			MethodName: "RotateTenantKey",
+			Handler:    _Transactions_RotateTenantKey_Handler,
+		},
+		{
+			MethodName: "SetCoin",
+			Handler:    _Transactions_SetCoin_Handler,
+		},
+		{
+			MethodName: "ListCoins",
+			Handler:    _Transactions_ListCoins_Handler,
+		},
+		{
+			MethodName: "CreatePlatform",
+			Handler:    _Transactions_CreatePlatform_Handler,
+		},
+		{
+			MethodName: "UpdatePlatform",
+			Handler:    _Transactions_UpdatePlatform_Handler,
+		},
+		{
+			MethodName: "GetPlatform",
+			Handler:    _Transactions_GetPlatform_Handler,
+		},
+		{
+			MethodName: "ListPlatforms",
+			Handler:    _Transactions_ListPlatforms_Handler,
+		},
+		{
+			MethodName: "DeletePlatform",
+			Handler:    _Transactions_DeletePlatform_Handler,
+		},
+		{
+			MethodName: "RotateWebhookSecret",
+			Handler:    _Transactions_RotateWebhookSecret_Handler,
+		},
+		{
+			MethodName: "ImportExchangeRates",
+			Handler:    _Transactions_ImportExchangeRates_Handler,
+		},
+		{
+			MethodName: "ListExchangeRates",
+			Handler:    _Transactions_ListExchangeRates_Handler,
+		},
+		{
+			MethodName: "GetTransactionTotals",
+			Handler:    _Transactions_GetTransactionTotals_Handler,
+		},
+		{
+			MethodName: "GetAccountBalance",
+			Handler:    _Transactions_GetAccountBalance_Handler,
+		},
+		{
+			MethodName: "GetAccountStatement",
+			Handler:    _Transactions_GetAccountStatement_Handler,
+		},
+		{
+			MethodName: "ReconcileSettlement",
+			Handler:    _Transactions_ReconcileSettlement_Handler,
+		},
+		{
+			MethodName: "ListReconciliationRuns",
+			Handler:    _Transactions_ListReconciliationRuns_Handler,
+		},
+		{
+			MethodName: "ListReconciliationItems",
+			Handler:    _Transactions_ListReconciliationItems_Handler,
+		},
+		{
+			MethodName: "ResolveReconciliationItem",
+			Handler:    _Transactions_ResolveReconciliationItem_Handler,
+		},
+		{
+			MethodName: "ListFraudReviews",
+			Handler:    _Transactions_ListFraudReviews_Handler,
+		},
+		{
+			MethodName: "ApproveFraudReview",
+			Handler:    _Transactions_ApproveFraudReview_Handler,
+		},
+		{
+			MethodName: "DenyFraudReview",
+			Handler:    _Transactions_DenyFraudReview_Handler,
+		},
+		{
+			MethodName: "EraseUser",
+			Handler:    _Transactions_EraseUser_Handler,
+		},
+		{
+			MethodName: "DeleteTransaction",
+			Handler:    _Transactions_DeleteTransaction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/transactions.proto",